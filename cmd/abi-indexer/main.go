@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/dipdup-net/abi-indexer/pkg/client"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+)
+
+var (
+	serverAddress string
+	output        string
+	timeout       int
+	limit         uint64
+	offset        uint64
+	order         string
+	matchAll      bool
+	token         string
+	batchSize     int
+	kind          string
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:           "abi-indexer",
+		Short:         "Queries ABI indexer over gRPC",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	getCmd = &cobra.Command{
+		Use:   "get <address>",
+		Short: "Prints metadata of the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(func(ctx context.Context, c *client.Client) ([]*client.Metadata, error) {
+				metadata, err := c.GetMetadata(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+				return []*client.Metadata{metadata}, nil
+			})
+		},
+	}
+
+	listCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Prints page of indexed metadata",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(func(ctx context.Context, c *client.Client) ([]*client.Metadata, error) {
+				sortOrder, err := parseOrder(order)
+				if err != nil {
+					return nil, err
+				}
+				return c.ListMetadata(ctx, limit, offset, sortOrder)
+			})
+		},
+	}
+
+	bySelectorCmd = &cobra.Command{
+		Use:   "by-selector <selector>...",
+		Short: "Prints metadata of contracts which contain methods with 4-byte selectors",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(func(ctx context.Context, c *client.Client) ([]*client.Metadata, error) {
+				sortOrder, err := parseOrder(order)
+				if err != nil {
+					return nil, err
+				}
+				return c.GetMetadataBySelectors(ctx, args, matchAll, limit, offset, sortOrder)
+			})
+		},
+	}
+
+	byTopicCmd = &cobra.Command{
+		Use:   "by-topic <topic>",
+		Short: "Prints metadata of contracts which contain event with topic",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(func(ctx context.Context, c *client.Client) ([]*client.Metadata, error) {
+				sortOrder, err := parseOrder(order)
+				if err != nil {
+					return nil, err
+				}
+				return c.GetMetadataByTopic(ctx, args[0], limit, offset, sortOrder)
+			})
+		},
+	}
+
+	importSignaturesCmd = &cobra.Command{
+		Use:   "import-signatures <file>",
+		Short: "Imports textual signatures, one per line, for reverse lookup of selectors. Use - to read standard input",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importSignatures(args[0])
+		},
+	}
+)
+
+func main() {
+	rootCmd.PersistentFlags().StringVarP(&serverAddress, "server", "s", "127.0.0.1:7778", "address of gRPC server")
+	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", outputTable, "output format: table or json")
+	rootCmd.PersistentFlags().IntVarP(&timeout, "timeout", "t", 10, "timeout of request in seconds")
+
+	for _, cmd := range []*cobra.Command{listCmd, bySelectorCmd, byTopicCmd} {
+		cmd.Flags().Uint64VarP(&limit, "limit", "l", 10, "count of returned contracts")
+		cmd.Flags().Uint64Var(&offset, "offset", 0, "count of skipped contracts")
+		cmd.Flags().StringVar(&order, "order", "asc", "sort order: asc or desc")
+	}
+	bySelectorCmd.Flags().BoolVar(&matchAll, "all", false, "contract should contain all selectors instead of any of them")
+	importSignaturesCmd.Flags().StringVar(&token, "token", os.Getenv("ABI_INDEXER_ADMIN_TOKEN"), "admin token of server. Default: ABI_INDEXER_ADMIN_TOKEN environment variable")
+	importSignaturesCmd.Flags().IntVar(&batchSize, "batch", 1000, "count of signatures sent by one request")
+	importSignaturesCmd.Flags().StringVar(&kind, "kind", "function", "kind of imported signatures: function or error")
+
+	rootCmd.AddCommand(getCmd, listCmd, bySelectorCmd, byTopicCmd, importSignaturesCmd)
+
+	if err := rootCmd.Execute(); err != nil {
+		rootCmd.PrintErrln("Error:", err)
+		os.Exit(1)
+	}
+}
+
+type request func(ctx context.Context, c *client.Client) ([]*client.Metadata, error)
+
+func run(req request) error {
+	printer, err := newPrinter(output, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	c, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	list, err := req(ctx, c)
+	if err != nil {
+		return err
+	}
+	return printer(list)
+}
+
+func connect(ctx context.Context) (*client.Client, error) {
+	c, err := client.New(ctx, client.Config{
+		ServerAddress: serverAddress,
+		Name:          "abi-indexer-cli",
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, serverAddress)
+	}
+	return c, nil
+}
+
+func parseOrder(value string) (generalPB.SortOrder, error) {
+	switch value {
+	case "asc":
+		return generalPB.SortOrder_ASC, nil
+	case "desc":
+		return generalPB.SortOrder_DESC, nil
+	default:
+		return generalPB.SortOrder_ASC, errors.Errorf("invalid sort order: %s", value)
+	}
+}
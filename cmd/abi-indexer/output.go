@@ -0,0 +1,122 @@
+package main
+
+import (
+	stdJSON "encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dipdup-net/abi-indexer/pkg/client"
+)
+
+// output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type printer func(list []*client.Metadata) error
+
+func newPrinter(format string, w io.Writer) (printer, error) {
+	switch format {
+	case outputTable:
+		return func(list []*client.Metadata) error {
+			return printTable(w, list)
+		}, nil
+	case outputJSON:
+		return func(list []*client.Metadata) error {
+			return printJSON(w, list)
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid output format: %s", format)
+	}
+}
+
+// metadataJSON - JSON view of metadata. ABI and schema are embedded as JSON instead of base64 of bytes.
+type metadataJSON struct {
+	Address       string             `json:"address"`
+	IsComplete    bool               `json:"is_complete"`
+	Interfaces    []string           `json:"interfaces,omitempty"`
+	Tags          []string           `json:"tags,omitempty"`
+	Hash          string             `json:"hash,omitempty"`
+	CodeHash      string             `json:"code_hash,omitempty"`
+	Creator       string             `json:"creator,omitempty"`
+	CreationTx    string             `json:"creation_tx,omitempty"`
+	CreationBlock uint64             `json:"creation_block,omitempty"`
+	Factory       string             `json:"factory,omitempty"`
+	InheritedFrom string             `json:"inherited_from,omitempty"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time         `json:"updated_at,omitempty"`
+	ABI           stdJSON.RawMessage `json:"abi,omitempty"`
+	JSONSchema    stdJSON.RawMessage `json:"json_schema,omitempty"`
+}
+
+func newMetadataJSON(m *client.Metadata) metadataJSON {
+	view := metadataJSON{
+		Address:       m.Address,
+		IsComplete:    m.IsComplete,
+		Interfaces:    m.Interfaces,
+		Tags:          m.Tags,
+		Hash:          m.Hash,
+		CodeHash:      m.CodeHash,
+		Creator:       m.Creator,
+		CreationTx:    m.CreationTx,
+		CreationBlock: m.CreationBlock,
+		Factory:       m.Factory,
+		InheritedFrom: m.InheritedFrom,
+	}
+	if !m.CreatedAt.IsZero() {
+		view.CreatedAt = &m.CreatedAt
+	}
+	if !m.UpdatedAt.IsZero() {
+		view.UpdatedAt = &m.UpdatedAt
+	}
+	if stdJSON.Valid(m.ABI) {
+		view.ABI = m.ABI
+	}
+	if stdJSON.Valid(m.JSONSchema) {
+		view.JSONSchema = m.JSONSchema
+	}
+	return view
+}
+
+func printJSON(w io.Writer, list []*client.Metadata) error {
+	views := make([]metadataJSON, len(list))
+	for i := range list {
+		views[i] = newMetadataJSON(list[i])
+	}
+
+	encoder := stdJSON.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(views)
+}
+
+func printTable(w io.Writer, list []*client.Metadata) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ADDRESS\tCOMPLETE\tINTERFACES\tTAGS\tUPDATED")
+	for _, m := range list {
+		var updatedAt string
+		if !m.UpdatedAt.IsZero() {
+			updatedAt = m.UpdatedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%t\t%s\t%s\t%s\n",
+			m.Address,
+			m.IsComplete,
+			orDash(strings.Join(m.Interfaces, ",")),
+			orDash(strings.Join(m.Tags, ",")),
+			orDash(updatedAt),
+		)
+	}
+	return tw.Flush()
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
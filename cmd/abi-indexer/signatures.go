@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dipdup-net/abi-indexer/pkg/client"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+)
+
+// importSignatures - sends signatures of the file to server by batches and prints total counts. Each batch is a separate request with its own timeout.
+func importSignatures(path string) error {
+	if token == "" {
+		return errors.New("admin token is required")
+	}
+	if batchSize <= 0 {
+		return errors.Errorf("invalid batch size: %d", batchSize)
+	}
+	signatureKind, err := parseKind(kind)
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	c, err := connect(connectCtx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	var (
+		total   = new(pb.ImportSignaturesResponse)
+		batch   = make([]string, 0, batchSize)
+		scanner = bufio.NewScanner(input)
+	)
+	for scanner.Scan() {
+		batch = append(batch, scanner.Text())
+		if len(batch) < batchSize {
+			continue
+		}
+		if err := sendSignatures(c, batch, signatureKind, total); err != nil {
+			return err
+		}
+		batch = batch[:0]
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(batch) > 0 {
+		if err := sendSignatures(c, batch, signatureKind, total); err != nil {
+			return err
+		}
+	}
+
+	for i := range total.Errors {
+		fmt.Fprintln(os.Stderr, total.Errors[i])
+	}
+	fmt.Printf("total: %d\ninserted: %d\nexisting: %d\ninvalid: %d\n", total.Total, total.Inserted, total.Existing, total.Invalid)
+	return nil
+}
+
+func sendSignatures(c *client.Client, batch []string, kind pb.SignatureKind, total *pb.ImportSignaturesResponse) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	response, err := c.API().ImportSignatures(ctx, &pb.ImportSignaturesRequest{
+		Signatures: batch,
+		Kind:       kind,
+	})
+	if err != nil {
+		return err
+	}
+	total.Total += response.Total
+	total.Inserted += response.Inserted
+	total.Existing += response.Existing
+	total.Invalid += response.Invalid
+	total.Errors = append(total.Errors, response.Errors...)
+	return nil
+}
+
+func parseKind(value string) (pb.SignatureKind, error) {
+	switch value {
+	case "function":
+		return pb.SignatureKind_FUNCTION, nil
+	case "error":
+		return pb.SignatureKind_ERROR, nil
+	default:
+		return pb.SignatureKind_FUNCTION, errors.Errorf("invalid signature kind: %s", value)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/dipdup-net/abi-indexer/internal/storage/postgres"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
 	"github.com/dipdup-net/go-lib/config"
@@ -12,6 +13,14 @@ type Config struct {
 	LogLevel      string          `yaml:"log_level" validate:"omitempty,oneof=debug trace info warn error fatal panic"`
 	Metadata      metadata.Config `yaml:"metadata"`
 	GRPC          grpc.Config     `yaml:"grpc"`
+	Storage       StorageConfig   `yaml:"storage"`
+}
+
+// StorageConfig - type of storage and settings of postgres storage
+type StorageConfig struct {
+	postgres.Config `yaml:",inline"`
+	// Type - `postgres` (default) or `memory`. Memory storage doesn't use database and loses data on exit.
+	Type string `yaml:"type" validate:"omitempty,oneof=postgres memory"`
 }
 
 // Substitute -
@@ -10,12 +10,12 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
-	"github.com/dipdup-net/abi-indexer/internal/storage/postgres"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 
 	"github.com/dipdup-net/go-lib/config"
+	"github.com/dipdup-net/go-lib/prometheus"
 )
 
 var (
@@ -60,20 +60,22 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	storage, err := postgres.Create(ctx, cfg.Database)
+	prometheusService := prometheus.NewService(cfg.Prometheus)
+
+	storage, err := openStorage(ctx, cfg, prometheusService)
 	if err != nil {
-		log.Panic().Err(err).Msg("postgres connection error")
+		log.Panic().Err(err).Msg("storage opening error")
 		cancel()
 		return
 	}
-	metadataIndexer, err := metadata.NewMetadata(cfg.Metadata, storage.Metadata, storage.Events, storage.Methods, storage.Transactable)
+	metadataIndexer, err := metadata.NewMetadata(cfg.Metadata, storage.Metadata, storage.Events, storage.Methods, storage.Candidates, storage.Signatures, storage.Transactable, prometheusService)
 	if err != nil {
 		log.Panic().Err(err).Msg("creating indexer")
 		cancel()
 		return
 	}
 
-	grpcModule, err := grpc.NewServer(cfg.GRPC.Server, storage.Metadata)
+	grpcModule, err := grpc.NewServer(cfg.GRPC.Server, storage.ReadMetadata, storage.ReadMethods, storage.ReadEvents, storage.Metadata, metadataIndexer, prometheusService)
 	if err != nil {
 		log.Panic().Err(err).Msg("creating grpc module")
 		cancel()
@@ -86,6 +88,7 @@ func main() {
 		return
 	}
 
+	prometheusService.Start()
 	metadataIndexer.Start(ctx)
 	grpcModule.Start(ctx)
 
@@ -102,7 +105,10 @@ func main() {
 		log.Panic().Err(err).Msg("closing grpc server")
 	}
 	if err := storage.Close(); err != nil {
-		log.Panic().Err(err).Msg("closing postgres connection")
+		log.Panic().Err(err).Msg("closing storage")
+	}
+	if err := prometheusService.Close(); err != nil {
+		log.Panic().Err(err).Msg("closing prometheus service")
 	}
 
 	close(signals)
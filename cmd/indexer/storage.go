@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/storage/memory"
+	"github.com/dipdup-net/abi-indexer/internal/storage/postgres"
+	"github.com/dipdup-net/go-lib/prometheus"
+)
+
+// storage - handles of storage used by modules
+type storage struct {
+	Transactable models.Transactable
+
+	Metadata   models.IMetadata
+	Methods    models.IMethod
+	Events     models.IEvent
+	Candidates models.ICandidate
+	Signatures models.ISignature
+
+	ReadMetadata models.IMetadata
+	ReadMethods  models.IMethod
+	ReadEvents   models.IEvent
+
+	Close func() error
+}
+
+// openStorage - opens storage of configured type
+func openStorage(ctx context.Context, cfg Config, metrics *prometheus.Service) (*storage, error) {
+	if cfg.Storage.Type == "memory" {
+		s := memory.New()
+		return &storage{
+			Transactable: s.Transactable,
+			Metadata:     s.Metadata,
+			Methods:      s.Methods,
+			Events:       s.Events,
+			Candidates:   s.Candidates,
+			Signatures:   s.Signatures,
+			ReadMetadata: s.ReadMetadata,
+			ReadMethods:  s.ReadMethods,
+			ReadEvents:   s.ReadEvents,
+			Close:        s.Close,
+		}, nil
+	}
+
+	s, err := postgres.Create(ctx, cfg.Database, cfg.Storage.Config, metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &storage{
+		Transactable: s.Transactable,
+		Metadata:     s.Metadata,
+		Methods:      s.Methods,
+		Events:       s.Events,
+		Candidates:   s.Candidates,
+		Signatures:   s.Signatures,
+		ReadMetadata: s.ReadMetadata,
+		ReadMethods:  s.ReadMethods,
+		ReadEvents:   s.ReadEvents,
+		Close:        s.Close,
+	}, nil
+}
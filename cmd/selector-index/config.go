@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/dipdup-net/abi-indexer/internal/storage/postgres"
+	"github.com/dipdup-net/go-lib/config"
+)
+
+// Config - part of indexer config which is used by exporter
+type Config struct {
+	config.Config `yaml:",inline"`
+	Storage       postgres.Config `yaml:"storage"`
+}
+
+// Substitute -
+func (c *Config) Substitute() error {
+	if err := c.Config.Substitute(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/storage/postgres"
+	"github.com/dipdup-net/abi-indexer/pkg/selectorindex"
+
+	"github.com/dipdup-net/go-lib/config"
+)
+
+const pageSize = 10000
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "selector-index",
+		Short: "Exports all known method selectors with their signatures to compact binary file",
+	}
+)
+
+func main() {
+	configPath := rootCmd.PersistentFlags().StringP("config", "c", "dipdup.yml", "path to YAML config file")
+	outputPath := rootCmd.PersistentFlags().StringP("output", "o", "selectors.idx", "path to output file")
+	if err := rootCmd.Execute(); err != nil {
+		log.Panic().Err(err).Msg("command line execute")
+		return
+	}
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{
+		Out:        os.Stdout,
+		TimeFormat: "2006-01-02 15:04:05",
+	})
+
+	var cfg Config
+	if err := config.Parse(*configPath, &cfg); err != nil {
+		log.Panic().Err(err).Msg("parsing config file")
+		return
+	}
+
+	ctx := context.Background()
+
+	strg, err := postgres.Create(ctx, cfg.Database, cfg.Storage, nil)
+	if err != nil {
+		log.Panic().Err(err).Msg("postgres connection error")
+		return
+	}
+	defer func() {
+		if err := strg.Close(); err != nil {
+			log.Err(err).Msg("closing postgres connection")
+		}
+	}()
+
+	count, err := export(ctx, strg.ReadMethods, *outputPath)
+	if err != nil {
+		log.Err(err).Msg("exporting selector index")
+		return
+	}
+	log.Info().Str("output", *outputPath).Int("pairs", count).Msg("selector index is exported")
+}
+
+func export(ctx context.Context, methods storage.IMethod, path string) (int, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	writer, err := selectorindex.NewWriter(file)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count          int
+		afterSelector  []byte
+		afterSignature string
+		afterKind      storage.SignatureKind
+	)
+	for {
+		page, err := methods.ListSelectorSignatures(ctx, afterSelector, afterSignature, afterKind, pageSize)
+		if err != nil {
+			return count, err
+		}
+		for i := range page {
+			// index maps selectors of functions, errors aren't exported
+			if page[i].Kind != storage.KindFunction {
+				continue
+			}
+			if err := writer.Add(page[i].Selector, page[i].Signature); err != nil {
+				return count, err
+			}
+		}
+		count += len(page)
+
+		if len(page) < pageSize {
+			break
+		}
+		afterSelector = page[len(page)-1].Selector
+		afterSignature = page[len(page)-1].Signature
+		afterKind = page[len(page)-1].Kind
+	}
+
+	if err := writer.Flush(); err != nil {
+		return count, err
+	}
+	return count, file.Sync()
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker - circuit breaker. After threshold of consecutive failures it's opened and requests are skipped during cooldown. After cooldown one probe request is allowed: its success closes the breaker, failure opens it again.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	failures  int
+	openUntil time.Time
+	mx        sync.Mutex
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// Allow - checks if request can be sent
+func (b *breaker) Allow() bool {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.openUntil.IsZero() {
+		return true
+	}
+	now := time.Now()
+	if now.Before(b.openUntil) {
+		return false
+	}
+	// half-open: only one probe per cooldown
+	b.openUntil = now.Add(b.cooldown)
+	return true
+}
+
+// Success - closes the breaker. It returns true if breaker was open.
+func (b *breaker) Success() bool {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	wasOpen := !b.openUntil.IsZero()
+	b.failures = 0
+	b.openUntil = time.Time{}
+	return wasOpen
+}
+
+// Failure - counts failure. It returns true if breaker was opened by the failure.
+func (b *breaker) Failure() bool {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+	b.openUntil = time.Now().Add(b.cooldown)
+	return b.failures == b.threshold
+}
@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// errors
+var (
+	ErrMiss = errors.New("cache miss")
+)
+
+// Backend - interface of external cache
+type Backend interface {
+	// Get - returns value by key. ErrMiss is returned if key is absent.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete - removes keys and returns count of removed ones
+	Delete(ctx context.Context, keys ...string) (int, error)
+	// DeletePrefix - removes all keys with the prefix and returns count of removed ones
+	DeletePrefix(ctx context.Context, prefix string) (int, error)
+	Close() error
+}
+
+// Broadcaster - backend which can notify other replicas about invalidated keys
+type Broadcaster interface {
+	Publish(ctx context.Context, message string) error
+	Subscribe(ctx context.Context) <-chan string
+}
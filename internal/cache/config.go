@@ -0,0 +1,34 @@
+package cache
+
+// Config - settings of metadata cache. In-process cache is used as the first level, shared Redis as the second one. Both are optional. Cache failures never fail requests: they are treated as misses.
+type Config struct {
+	// Chain - namespace of cache keys. It should be set if indexers of several chains share Redis.
+	Chain  string        `yaml:"chain" validate:"omitempty"`
+	Memory *MemoryConfig `yaml:"memory" validate:"omitempty"`
+	Redis  *RedisConfig  `yaml:"redis" validate:"omitempty"`
+	// TTL - count of seconds during which value cached in Redis is valid. Default: 300
+	TTL int `yaml:"ttl" validate:"omitempty,min=1"`
+	// FailureThreshold - count of consecutive failures after which Redis isn't requested during cooldown. Default: 5
+	FailureThreshold int `yaml:"failure_threshold" validate:"omitempty,min=1"`
+	// Cooldown - count of seconds during which failed Redis isn't requested. Default: 30
+	Cooldown int `yaml:"cooldown" validate:"omitempty,min=1"`
+}
+
+// MemoryConfig - settings of in-process cache
+type MemoryConfig struct {
+	// Size - maximum count of cached values. Default: 10000
+	Size int `yaml:"size" validate:"omitempty,min=1"`
+	// TTL - count of seconds during which cached value is valid. Default: 60
+	TTL int `yaml:"ttl" validate:"omitempty,min=1"`
+}
+
+// RedisConfig -
+type RedisConfig struct {
+	Address  string `yaml:"address" validate:"required"`
+	Password string `yaml:"password" validate:"omitempty"`
+	DB       int    `yaml:"db" validate:"omitempty,min=0"`
+	// Timeout - timeout of connection and commands in milliseconds. Default: 100
+	Timeout int `yaml:"timeout" validate:"omitempty,min=1"`
+	// Channel - Redis channel which is used to notify replicas about invalidated keys. Default: `abi_indexer:invalidate`
+	Channel string `yaml:"channel" validate:"omitempty"`
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Memory - in-process LRU cache with expiration. It never fails.
+type Memory struct {
+	size  int
+	ttl   time.Duration
+	items map[string]*list.Element
+	order *list.List
+	mx    sync.Mutex
+}
+
+type memoryItem struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemory -
+func NewMemory(cfg MemoryConfig) *Memory {
+	size := 10000
+	if cfg.Size > 0 {
+		size = cfg.Size
+	}
+	ttl := time.Minute
+	if cfg.TTL > 0 {
+		ttl = time.Duration(cfg.TTL) * time.Second
+	}
+	return &Memory{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[string]*list.Element),
+		order: list.New(),
+	}
+}
+
+// Get -
+func (m *Memory) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	element, ok := m.items[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	item := element.Value.(*memoryItem)
+	if time.Now().After(item.expires) {
+		m.remove(element)
+		return nil, ErrMiss
+	}
+	m.order.MoveToFront(element)
+	return item.value, nil
+}
+
+// Set - caches value. If ttl is 0 TTL of config is used.
+func (m *Memory) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 || ttl > m.ttl {
+		ttl = m.ttl
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if element, ok := m.items[key]; ok {
+		item := element.Value.(*memoryItem)
+		item.value = value
+		item.expires = time.Now().Add(ttl)
+		m.order.MoveToFront(element)
+		return nil
+	}
+
+	m.items[key] = m.order.PushFront(&memoryItem{
+		key:     key,
+		value:   value,
+		expires: time.Now().Add(ttl),
+	})
+	for m.order.Len() > m.size {
+		m.remove(m.order.Back())
+	}
+	return nil
+}
+
+// Delete -
+func (m *Memory) Delete(ctx context.Context, keys ...string) (int, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	var count int
+	for i := range keys {
+		if element, ok := m.items[keys[i]]; ok {
+			m.remove(element)
+			count++
+		}
+	}
+	return count, nil
+}
+
+// DeletePrefix -
+func (m *Memory) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	var count int
+	for key, element := range m.items {
+		if strings.HasPrefix(key, prefix) {
+			m.remove(element)
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Close -
+func (m *Memory) Close() error {
+	return nil
+}
+
+func (m *Memory) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.items, element.Value.(*memoryItem).key)
+}
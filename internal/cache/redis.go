@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultChannel = "abi_indexer:invalidate"
+	scanBatchSize  = 1000
+)
+
+// Redis - cache backend stored in Redis
+type Redis struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedis - creates Redis client. Connection is established lazily, so Redis may be unavailable on start.
+func NewRedis(cfg RedisConfig) *Redis {
+	timeout := 100 * time.Millisecond
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Millisecond
+	}
+	channel := defaultChannel
+	if cfg.Channel != "" {
+		channel = cfg.Channel
+	}
+	return &Redis{
+		channel: channel,
+		client: redis.NewClient(&redis.Options{
+			Addr:         cfg.Address,
+			Password:     cfg.Password,
+			DB:           cfg.DB,
+			DialTimeout:  timeout,
+			ReadTimeout:  timeout,
+			WriteTimeout: timeout,
+			MaxRetries:   -1,
+		}),
+	}
+}
+
+// Get -
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+// Set -
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Delete -
+func (r *Redis) Delete(ctx context.Context, keys ...string) (int, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	count, err := r.client.Del(ctx, keys...).Result()
+	return int(count), err
+}
+
+// DeletePrefix - removes keys with the prefix. Keys are found by SCAN, so Redis isn't blocked on large databases.
+func (r *Redis) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	var (
+		count  int
+		cursor uint64
+	)
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, prefix+"*", scanBatchSize).Result()
+		if err != nil {
+			return count, err
+		}
+		deleted, err := r.Delete(ctx, keys...)
+		count += deleted
+		if err != nil {
+			return count, err
+		}
+		if next == 0 {
+			return count, nil
+		}
+		cursor = next
+	}
+}
+
+// Publish - sends message to invalidation channel
+func (r *Redis) Publish(ctx context.Context, message string) error {
+	return r.client.Publish(ctx, r.channel, message).Err()
+}
+
+// Subscribe - returns messages of invalidation channel. Subscription is restored automatically if connection is lost. Channel is closed when context is cancelled.
+func (r *Redis) Subscribe(ctx context.Context) <-chan string {
+	pubsub := r.client.Subscribe(ctx, r.channel)
+	messages := make(chan string)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case messages <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return messages
+}
+
+// Close -
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricCacheAvailable = "abi_indexer_cache_available"
+	MetricCacheErrors    = "abi_indexer_cache_errors_total"
+	MetricCacheLookups   = "abi_indexer_cache_lookups_total"
+)
+
+// Resilient - wrapper of cache backend which never fails. Errors of backend are logged and treated as misses. If backend fails repeatedly it isn't requested during cooldown.
+type Resilient struct {
+	backend Backend
+	name    string
+	ttl     time.Duration
+	breaker *breaker
+	metrics *prometheus.Service
+}
+
+// NewResilient -
+func NewResilient(backend Backend, name string, cfg Config, metrics *prometheus.Service) *Resilient {
+	ttl := 5 * time.Minute
+	if cfg.TTL > 0 {
+		ttl = time.Duration(cfg.TTL) * time.Second
+	}
+	threshold := 5
+	if cfg.FailureThreshold > 0 {
+		threshold = cfg.FailureThreshold
+	}
+	cooldown := 30 * time.Second
+	if cfg.Cooldown > 0 {
+		cooldown = time.Duration(cfg.Cooldown) * time.Second
+	}
+
+	if metrics != nil {
+		metrics.RegisterGauge(MetricCacheAvailable, "1 if cache backend is available, 0 if requests to it are skipped after failures", "backend")
+		metrics.RegisterCounter(MetricCacheErrors, "count of failed requests to cache backend", "backend", "operation")
+		metrics.RegisterCounter(MetricCacheLookups, "count of cache lookups by result: hit, miss or skipped", "backend", "result")
+		metrics.SetGaugeValue(MetricCacheAvailable, map[string]string{"backend": name}, 1)
+	}
+
+	return &Resilient{
+		backend: backend,
+		name:    name,
+		ttl:     ttl,
+		breaker: newBreaker(threshold, cooldown),
+		metrics: metrics,
+	}
+}
+
+// Get - returns cached value. False is returned on miss or failure of backend.
+func (r *Resilient) Get(ctx context.Context, key string) ([]byte, bool) {
+	if !r.breaker.Allow() {
+		r.lookup("skipped")
+		return nil, false
+	}
+
+	value, err := r.backend.Get(ctx, key)
+	switch {
+	case err == nil:
+		r.success()
+		r.lookup("hit")
+		return value, true
+	case errors.Is(err, ErrMiss):
+		r.success()
+		r.lookup("miss")
+		return nil, false
+	default:
+		r.failure("get", err)
+		r.lookup("miss")
+		return nil, false
+	}
+}
+
+// Set - caches value with configured TTL. Failures are only logged.
+func (r *Resilient) Set(ctx context.Context, key string, value []byte) {
+	if !r.breaker.Allow() {
+		return
+	}
+	if err := r.backend.Set(ctx, key, value, r.ttl); err != nil {
+		r.failure("set", err)
+		return
+	}
+	r.success()
+}
+
+// Delete - removes keys from cache and returns count of removed ones. Failures are only logged: value is evicted by TTL then.
+func (r *Resilient) Delete(ctx context.Context, keys ...string) int {
+	if !r.breaker.Allow() {
+		return 0
+	}
+	count, err := r.backend.Delete(ctx, keys...)
+	if err != nil {
+		r.failure("delete", err)
+		return count
+	}
+	r.success()
+	return count
+}
+
+// DeletePrefix - removes all keys with the prefix and returns count of removed ones. Failures are returned, because caller should know that cache wasn't purged.
+func (r *Resilient) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	count, err := r.backend.DeletePrefix(ctx, prefix)
+	if err != nil {
+		r.failure("delete", err)
+		return count, err
+	}
+	r.success()
+	return count, nil
+}
+
+// Publish - notifies other replicas if backend supports it. Failures are only logged.
+func (r *Resilient) Publish(ctx context.Context, message string) {
+	broadcaster, ok := r.backend.(Broadcaster)
+	if !ok || !r.breaker.Allow() {
+		return
+	}
+	if err := broadcaster.Publish(ctx, message); err != nil {
+		r.failure("publish", err)
+		return
+	}
+	r.success()
+}
+
+// Subscribe - returns messages of other replicas. Nil channel is returned if backend doesn't support it.
+func (r *Resilient) Subscribe(ctx context.Context) <-chan string {
+	if broadcaster, ok := r.backend.(Broadcaster); ok {
+		return broadcaster.Subscribe(ctx)
+	}
+	return nil
+}
+
+// Close -
+func (r *Resilient) Close() error {
+	return r.backend.Close()
+}
+
+func (r *Resilient) success() {
+	if r.breaker.Success() {
+		log.Info().Str("backend", r.name).Msg("cache is available again")
+		r.available(1)
+	}
+}
+
+func (r *Resilient) failure(operation string, err error) {
+	log.Warn().Err(err).Str("backend", r.name).Str("operation", operation).Msg("cache request failed")
+	if r.metrics != nil {
+		r.metrics.IncrementCounter(MetricCacheErrors, map[string]string{"backend": r.name, "operation": operation})
+	}
+	if r.breaker.Failure() {
+		log.Warn().Str("backend", r.name).Dur("cooldown", r.breaker.cooldown).Msg("cache is unavailable: requests are skipped during cooldown")
+		r.available(0)
+	}
+}
+
+func (r *Resilient) available(value float64) {
+	if r.metrics != nil {
+		r.metrics.SetGaugeValue(MetricCacheAvailable, map[string]string{"backend": r.name}, value)
+	}
+}
+
+func (r *Resilient) lookup(result string) {
+	if r.metrics != nil {
+		r.metrics.IncrementCounter(MetricCacheLookups, map[string]string{"backend": r.name, "result": result})
+	}
+}
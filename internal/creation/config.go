@@ -0,0 +1,9 @@
+package creation
+
+// Config - settings of contract creation resolver. Creator and transaction are received from Etherscan-compatible API. Creation block and time, factory and bytecode hash are received from node if it's set.
+type Config struct {
+	BaseURL string `yaml:"base_url" validate:"required,url"`
+	APIKey  string `yaml:"api_key" validate:"omitempty"`
+	Timeout int    `yaml:"timeout" validate:"omitempty,min=1"`
+	NodeURL string `yaml:"node_url" validate:"omitempty,url"`
+}
@@ -0,0 +1,33 @@
+package creation
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// errors
+var (
+	ErrNotFound = errors.New("contract creation not found")
+)
+
+// Creation - information about contract deployment
+type Creation struct {
+	Creator   string
+	TxHash    string
+	Timestamp time.Time
+	// Block - number of block which contains creation transaction. It's zero if node isn't configured.
+	Block uint64
+	// Factory - address of contract which deployed the contract. It's empty if contract was deployed by EOA or node isn't configured.
+	Factory string
+	// CodeHash - keccak256 of runtime bytecode of the contract. It's empty if node isn't configured.
+	CodeHash []byte
+}
+
+// Resolver - receives information about contract deployment
+type Resolver interface {
+	Resolve(ctx context.Context, address string) (*Creation, error)
+	io.Closer
+}
@@ -0,0 +1,157 @@
+package creation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// Etherscan - resolver of contract creation by `getcontractcreation` method of Etherscan-compatible API
+type Etherscan struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+	node    *ethclient.Client
+}
+
+// NewEtherscan -
+func NewEtherscan(ctx context.Context, cfg Config) (*Etherscan, error) {
+	timeout := time.Second * 10
+	if cfg.Timeout > 0 {
+		timeout = time.Second * time.Duration(cfg.Timeout)
+	}
+
+	resolver := &Etherscan{
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		apiKey:  cfg.APIKey,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+
+	if cfg.NodeURL != "" {
+		node, err := ethclient.DialContext(ctx, cfg.NodeURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "node connection")
+		}
+		resolver.node = node
+	}
+
+	return resolver, nil
+}
+
+type etherscanResponse struct {
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Result  jsoniter.RawMessage `json:"result"`
+}
+
+type etherscanCreation struct {
+	ContractAddress string `json:"contractAddress"`
+	ContractCreator string `json:"contractCreator"`
+	TxHash          string `json:"txHash"`
+}
+
+// Resolve -
+func (e *Etherscan) Resolve(ctx context.Context, address string) (*Creation, error) {
+	params := url.Values{}
+	params.Set("module", "contract")
+	params.Set("action", "getcontractcreation")
+	params.Set("contractaddresses", address)
+	if e.apiKey != "" {
+		params.Set("apikey", e.apiKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.baseURL+"/api?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("invalid status code of contract creation request: %d", resp.StatusCode)
+	}
+
+	var response etherscanResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	if response.Status != "1" {
+		return nil, errors.Wrap(ErrNotFound, response.Message)
+	}
+
+	var result []etherscanCreation
+	if err := json.Unmarshal(response.Result, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+
+	creation := &Creation{
+		Creator: strings.ToLower(result[0].ContractCreator),
+		TxHash:  strings.ToLower(result[0].TxHash),
+	}
+
+	if e.node != nil {
+		block, timestamp, err := e.block(ctx, creation.TxHash)
+		if err != nil {
+			return nil, errors.Wrap(err, "receiving creation time")
+		}
+		creation.Block = block
+		creation.Timestamp = timestamp
+
+		code, err := e.node.CodeAt(ctx, common.HexToAddress(creation.Creator), nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "receiving creator code")
+		}
+		if len(code) > 0 {
+			creation.Factory = creation.Creator
+		}
+
+		runtime, err := e.node.CodeAt(ctx, common.HexToAddress(address), nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "receiving contract code")
+		}
+		if len(runtime) > 0 {
+			creation.CodeHash = crypto.Keccak256(runtime)
+		}
+	}
+
+	return creation, nil
+}
+
+// block - returns number and time of block which contains transaction
+func (e *Etherscan) block(ctx context.Context, txHash string) (uint64, time.Time, error) {
+	receipt, err := e.node.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	header, err := e.node.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return receipt.BlockNumber.Uint64(), time.Unix(int64(header.Time), 0).UTC(), nil
+}
+
+// Close -
+func (e *Etherscan) Close() error {
+	if e.node != nil {
+		e.node.Close()
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LatencyBuckets - exponential buckets from 0.1 ms to 6 s. Used prometheus client doesn't support native histograms, so buckets are tuned to the observed range of request and query durations.
+var LatencyBuckets = prometheus.ExponentialBuckets(0.0001, 2.5, 13)
+
+// NewLatencyHistogram - creates and registers histogram of durations in seconds with LatencyBuckets. It's registered in default registry which is exposed by prometheus service.
+func NewLatencyHistogram(name, help string, labels ...string) *prometheus.HistogramVec {
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    name,
+		Help:    help,
+		Buckets: LatencyBuckets,
+	}, labels)
+	prometheus.MustRegister(vec)
+	return vec
+}
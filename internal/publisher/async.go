@@ -0,0 +1,83 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dipdup-net/go-lib/prometheus"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// metric names
+const (
+	MetricPublishFailures = "abi_indexer_publish_failures_total"
+)
+
+// Async - best-effort wrapper of publisher. Events are buffered and published in background, so caller is never blocked. If buffer is full event is dropped.
+type Async struct {
+	publisher Publisher
+	events    chan Event
+	metrics   *prometheus.Service
+	wg        *sync.WaitGroup
+}
+
+// NewAsync -
+func NewAsync(publisher Publisher, bufferSize int, metrics *prometheus.Service) *Async {
+	if bufferSize <= 0 {
+		bufferSize = 1024
+	}
+	if metrics != nil {
+		metrics.RegisterCounter(MetricPublishFailures, "count of metadata events which were not published to message broker", "reason")
+	}
+	return &Async{
+		publisher: publisher,
+		events:    make(chan Event, bufferSize),
+		metrics:   metrics,
+		wg:        new(sync.WaitGroup),
+	}
+}
+
+// Start -
+func (a *Async) Start(ctx context.Context) {
+	a.wg.Add(1)
+	go a.listen(ctx)
+}
+
+func (a *Async) listen(ctx context.Context) {
+	defer a.wg.Done()
+
+	for event := range a.events {
+		if err := a.publisher.Publish(ctx, event); err != nil {
+			log.Err(err).Str("address", event.Address).Msg("publishing metadata event")
+			a.fail("error")
+		}
+	}
+}
+
+// Publish - enqueues event. It never blocks.
+func (a *Async) Publish(event Event) {
+	select {
+	case a.events <- event:
+	default:
+		log.Warn().Str("address", event.Address).Msg("publisher buffer is full: metadata event was dropped")
+		a.fail("overflow")
+	}
+}
+
+func (a *Async) fail(reason string) {
+	if a.metrics != nil {
+		a.metrics.IncrementCounter(MetricPublishFailures, map[string]string{
+			"reason": reason,
+		})
+	}
+}
+
+// Close - publishes buffered events and closes publisher
+func (a *Async) Close() error {
+	close(a.events)
+	a.wg.Wait()
+	return a.publisher.Close()
+}
@@ -0,0 +1,40 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+// NATS -
+type NATS struct {
+	conn  *nats.Conn
+	topic string
+}
+
+// NewNATS -
+func NewNATS(url, topic string) (*NATS, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to NATS")
+	}
+	return &NATS{
+		conn:  conn,
+		topic: topic,
+	}, nil
+}
+
+// Publish -
+func (n *NATS) Publish(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return n.conn.Publish(n.topic, data)
+}
+
+// Close -
+func (n *NATS) Close() error {
+	return n.conn.Drain()
+}
@@ -0,0 +1,57 @@
+package publisher
+
+import (
+	"context"
+	stdJSON "encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Publisher - interface of external message broker which receives metadata events
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+	Close() error
+}
+
+// EventType -
+type EventType string
+
+// event types. Indexer doesn't delete metadata, so there is no delete event.
+const (
+	EventTypeCreate EventType = "create"
+	EventTypeUpdate EventType = "update"
+)
+
+// Event - message which is published to broker. ABI and JSON schema are embedded as JSON values, not as encoded bytes.
+type Event struct {
+	Type       EventType          `json:"type"`
+	Address    string             `json:"address"`
+	Metadata   stdJSON.RawMessage `json:"metadata,omitempty"`
+	JSONSchema stdJSON.RawMessage `json:"json_schema,omitempty"`
+}
+
+// Type -
+type Type string
+
+// types
+const (
+	NATSType Type = "nats"
+)
+
+// Config -
+type Config struct {
+	Type       Type   `yaml:"type" validate:"required,oneof=nats"`
+	URL        string `yaml:"url" validate:"required"`
+	Topic      string `yaml:"topic" validate:"required"`
+	BufferSize int    `yaml:"buffer_size" validate:"omitempty,min=1"`
+}
+
+// Factory -
+func Factory(cfg Config) (Publisher, error) {
+	switch cfg.Type {
+	case NATSType:
+		return NewNATS(cfg.URL, cfg.Topic)
+	default:
+		return nil, errors.Errorf("invalid publisher type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,30 @@
+package publisher
+
+import (
+	"testing"
+)
+
+func TestEventJSON(t *testing.T) {
+	event := Event{
+		Type:       EventTypeCreate,
+		Address:    "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		Metadata:   []byte(`[{"type":"function","name":"foo","inputs":[],"outputs":[]}]`),
+		JSONSchema: []byte(`{"type":"object"}`),
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"type":"create","address":"0x5fbdb2315678afecb367f032d93f642f64180aa3","metadata":[{"type":"function","name":"foo","inputs":[],"outputs":[]}],"json_schema":{"type":"object"}}`
+	if string(data) != want {
+		t.Fatalf("event = %s, want %s", data, want)
+	}
+
+	data, err = json.Marshal(Event{Type: EventTypeUpdate, Address: event.Address})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"type":"update","address":"0x5fbdb2315678afecb367f032d93f642f64180aa3"}`; string(data) != want {
+		t.Fatalf("event without ABI = %s, want %s", data, want)
+	}
+}
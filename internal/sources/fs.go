@@ -6,40 +6,66 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
 )
 
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
 // FileSystemConfig -
 type FileSystemConfig struct {
 	Dir string `yaml:"dir" validate:"required,dir"`
+	// Chain - if it's set ABI files are read from `<dir>/<chain>/<address>.json`, otherwise from `<dir>/<address>.json`
+	Chain string `yaml:"chain" validate:"omitempty"`
+	// Watch - count of seconds between checks of directory for new files. New files are indexed without waiting for the next full listing. 0 - disabled
+	Watch int `yaml:"watch" validate:"omitempty,min=0"`
 }
 
-// FileSystem -
+// FileSystem - ABI source which reads ABI JSON files from local directory. It's suitable for air-gapped setups without internet access.
 type FileSystem struct {
-	root string
+	root  string
+	watch time.Duration
 }
 
 // NewFileSystem -
-func NewFileSystem(rootDir string) *FileSystem {
-	rootDir = filepath.Clean(rootDir)
+func NewFileSystem(cfg FileSystemConfig) *FileSystem {
+	root := filepath.Clean(cfg.Dir)
+	if cfg.Chain != "" {
+		root = filepath.Join(root, cfg.Chain)
+	}
 	return &FileSystem{
-		root: rootDir,
+		root:  root,
+		watch: time.Duration(cfg.Watch) * time.Second,
 	}
 }
 
-// Get -
+// Get - reads file of the contract. Address is case-insensitive: if file with exact name doesn't exist, file which name differs in case only is read.
 func (fs *FileSystem) Get(ctx context.Context, contract string) ([]byte, error) {
 	filePath := filepath.Join(fs.root, fmt.Sprintf("%s.json", contract))
 	data, err := os.ReadFile(filePath)
+	if err == nil {
+		return data, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(fs.root)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, ErrNotFound
-		}
 		return nil, err
 	}
-	return data, nil
+	for i := range entries {
+		if name, ok := fs.contract(entries[i]); ok && strings.EqualFold(name, contract) {
+			return os.ReadFile(filepath.Join(fs.root, entries[i].Name()))
+		}
+	}
+	return nil, ErrNotFound
 }
 
-// List -
+// List - returns contracts which have valid ABI file. Malformed files are logged and skipped.
 func (fs *FileSystem) List(ctx context.Context) ([]string, error) {
 	entries, err := os.ReadDir(fs.root)
 	if err != nil {
@@ -48,17 +74,92 @@ func (fs *FileSystem) List(ctx context.Context) ([]string, error) {
 
 	result := make([]string, 0)
 	for i := range entries {
-		if entries[i].IsDir() {
+		contract, ok := fs.contract(entries[i])
+		if !ok {
 			continue
 		}
-
-		info, err := entries[i].Info()
-		if err != nil {
-			return nil, err
+		if err := fs.validate(contract); err != nil {
+			log.Warn().Err(err).Str("file", entries[i].Name()).Msg("malformed ABI file is skipped")
+			continue
 		}
-		parts := strings.Split(info.Name(), ".")
-		result = append(result, parts[0])
+		result = append(result, contract)
 	}
 
 	return result, nil
 }
+
+// Watch - periodically checks directory and sends contracts of new valid files to channel. Channel is nil if watching is disabled and it's closed when ctx is done.
+func (fs *FileSystem) Watch(ctx context.Context) <-chan string {
+	if fs.watch == 0 {
+		return nil
+	}
+
+	known := make(map[string]struct{})
+	if entries, err := os.ReadDir(fs.root); err == nil {
+		for i := range entries {
+			if contract, ok := fs.contract(entries[i]); ok {
+				known[contract] = struct{}{}
+			}
+		}
+	}
+
+	output := make(chan string, 1024)
+	go func() {
+		defer close(output)
+
+		ticker := time.NewTicker(fs.watch)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				entries, err := os.ReadDir(fs.root)
+				if err != nil {
+					log.Err(err).Str("dir", fs.root).Msg("watching ABI directory")
+					continue
+				}
+				for i := range entries {
+					contract, ok := fs.contract(entries[i])
+					if !ok {
+						continue
+					}
+					if _, ok := known[contract]; ok {
+						continue
+					}
+					// malformed file isn't marked as known, so it's checked again after it's fixed
+					if err := fs.validate(contract); err != nil {
+						log.Warn().Err(err).Str("file", entries[i].Name()).Msg("malformed ABI file is skipped")
+						continue
+					}
+					known[contract] = struct{}{}
+
+					select {
+					case <-ctx.Done():
+						return
+					case output <- contract:
+					}
+				}
+			}
+		}
+	}()
+	return output
+}
+
+func (fs *FileSystem) contract(entry os.DirEntry) (string, bool) {
+	if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+		return "", false
+	}
+	return strings.TrimSuffix(entry.Name(), ".json"), true
+}
+
+// validate - checks that file of the contract contains valid ABI JSON
+func (fs *FileSystem) validate(contract string) error {
+	data, err := os.ReadFile(filepath.Join(fs.root, fmt.Sprintf("%s.json", contract)))
+	if err != nil {
+		return err
+	}
+	var contractABI abi.ABI
+	return json.Unmarshal(data, &contractABI)
+}
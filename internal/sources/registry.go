@@ -0,0 +1,188 @@
+package sources
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Registry - ABI source which requests configured sources in order. Metadata is received from the first source which has it.
+type Registry struct {
+	types   []Type
+	sources []Source
+}
+
+// NewRegistry - creates sources of types in passed order. Each type can be used once.
+func NewRegistry(types []Type, params FactoryParams) (*Registry, error) {
+	if len(types) == 0 {
+		return nil, errors.New("at least one ABI source should be set")
+	}
+
+	registry := &Registry{
+		types:   make([]Type, 0, len(types)),
+		sources: make([]Source, 0, len(types)),
+	}
+	for _, typ := range types {
+		for i := range registry.types {
+			if registry.types[i] == typ {
+				return nil, errors.Errorf("duplicate ABI source: %s", typ)
+			}
+		}
+		src, err := Factory(typ, params)
+		if err != nil {
+			return nil, err
+		}
+		registry.types = append(registry.types, typ)
+		registry.sources = append(registry.sources, src)
+	}
+	return registry, nil
+}
+
+// Get - requests sources in order until one of them returns metadata. If no source has metadata, error of the last failed source is returned or ErrNotFound if all of them don't have it.
+func (r *Registry) Get(ctx context.Context, contract string) ([]byte, error) {
+	result, err := r.Find(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	return result.Metadata, nil
+}
+
+// Find - works as Get and also returns type of source which has metadata
+func (r *Registry) Find(ctx context.Context, contract string) (Result, error) {
+	var lastErr error
+	for i := range r.sources {
+		result, err := r.get(ctx, i, contract)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return Result{}, ctx.Err()
+		}
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		log.Warn().Err(err).Str("source", string(r.types[i])).Str("address", contract).Msg("receiving metadata from source")
+		lastErr = err
+	}
+	if lastErr != nil {
+		return Result{}, lastErr
+	}
+	return Result{}, ErrNotFound
+}
+
+// FindAll - requests all sources and returns metadata of each source which has it in order of sources. Errors are returned as by Get if no source has metadata.
+func (r *Registry) FindAll(ctx context.Context, contract string) ([]Result, error) {
+	var (
+		results = make([]Result, 0, len(r.sources))
+		lastErr error
+	)
+	for i := range r.sources {
+		result, err := r.get(ctx, i, contract)
+		if err == nil {
+			results = append(results, result)
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		log.Warn().Err(err).Str("source", string(r.types[i])).Str("address", contract).Msg("receiving metadata from source")
+		lastErr = err
+	}
+	if len(results) > 0 {
+		return results, nil
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, ErrNotFound
+}
+
+// get - requests source with index i. Compilation details are received if source provides them.
+func (r *Registry) get(ctx context.Context, i int, contract string) (Result, error) {
+	result := Result{Source: r.types[i]}
+	var err error
+	if compiled, ok := r.sources[i].(Compiled); ok {
+		result.Metadata, result.Compilation, err = compiled.GetCompiled(ctx, contract)
+	} else {
+		result.Metadata, err = r.sources[i].Get(ctx, contract)
+	}
+	return result, err
+}
+
+// Types - returns types of sources in order of request
+func (r *Registry) Types() []Type {
+	return r.types
+}
+
+// List - returns contracts of all sources without duplicates. Failed sources are skipped, error is returned only if all of them failed.
+func (r *Registry) List(ctx context.Context) ([]string, error) {
+	var (
+		result   = make([]string, 0)
+		seen     = make(map[string]struct{})
+		firstErr error
+		failed   int
+	)
+	for i := range r.sources {
+		contracts, err := r.sources[i].List(ctx)
+		if err != nil {
+			log.Warn().Err(err).Str("source", string(r.types[i])).Msg("listing contracts of source")
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+			continue
+		}
+		for j := range contracts {
+			if _, ok := seen[contracts[j]]; ok {
+				continue
+			}
+			seen[contracts[j]] = struct{}{}
+			result = append(result, contracts[j])
+		}
+	}
+	if failed == len(r.sources) {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+// Watch - merges new contracts of sources which implement Watcher. Channel is nil if none of them watches.
+func (r *Registry) Watch(ctx context.Context) <-chan string {
+	channels := make([]<-chan string, 0)
+	for i := range r.sources {
+		if watcher, ok := r.sources[i].(Watcher); ok {
+			if ch := watcher.Watch(ctx); ch != nil {
+				channels = append(channels, ch)
+			}
+		}
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	output := make(chan string)
+	var wg sync.WaitGroup
+	for i := range channels {
+		wg.Add(1)
+		go func(ch <-chan string) {
+			defer wg.Done()
+			for contract := range ch {
+				select {
+				case <-ctx.Done():
+					return
+				case output <- contract:
+				}
+			}
+		}(channels[i])
+	}
+	go func() {
+		wg.Wait()
+		close(output)
+	}()
+	return output
+}
@@ -17,6 +17,26 @@ type Source interface {
 	List(ctx context.Context) ([]string, error)
 }
 
+// Watcher - source which sends new contracts without waiting for the next listing
+type Watcher interface {
+	Watch(ctx context.Context) <-chan string
+}
+
+// Compiled - source which also knows how the contract was compiled
+type Compiled interface {
+	GetCompiled(ctx context.Context, contract string) ([]byte, *Compilation, error)
+}
+
+// Compilation - compiler settings and license of the contract reported by source
+type Compilation struct {
+	CompilerVersion  string
+	OptimizerEnabled bool
+	OptimizerRuns    uint64
+	EVMVersion       string
+	// License - SPDX identifier of license of the source file which declares the contract, e.g. `MIT`
+	License string
+}
+
 // Data -
 type Data struct {
 	Contract string
@@ -30,8 +50,18 @@ type Type string
 const (
 	FSType       Type = "fs"
 	SourcifyType Type = "sourcify"
+	// ManualType - label of ABI put by caller of API. It isn't a real source and can't be configured.
+	ManualType Type = "manual"
 )
 
+// Result - ABI received from source
+type Result struct {
+	Source   Type
+	Metadata []byte
+	// Compilation - compilation details of the contract. It's nil if source doesn't provide them.
+	Compilation *Compilation
+}
+
 // FactoryParams -
 type FactoryParams struct {
 	Sourcify *SourcifyConfig
@@ -46,7 +76,7 @@ func Factory(typ Type, params FactoryParams) (Source, error) {
 		if params.FS == nil {
 			return nil, errors.New("you have to set 'fs_abi_source' section for file system ABI source")
 		}
-		abiSource = NewFileSystem(params.FS.Dir)
+		abiSource = NewFileSystem(*params.FS)
 	case SourcifyType:
 		if params.Sourcify == nil {
 			return nil, errors.New("you have to set 'sourcify' section for Sourcify as ABI source")
@@ -7,11 +7,13 @@ import (
 	"time"
 
 	sourcify "github.com/dipdup-net/sourcify-api"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // SourcifyConfig -
 type SourcifyConfig struct {
 	BaseURL string `yaml:"base_url" validate:"required,url"`
+	// Timeout - timeout of request to Sourcify in seconds. 0 - unlimited
 	Timeout int    `yaml:"timeout" validate:"required,min=0"`
 	ChainID uint64 `yaml:"chain_id" validate:"required,min=1"`
 }
@@ -36,24 +38,60 @@ func NewSourcify(cfg *SourcifyConfig) *Sourcify {
 
 // Get -
 func (s *Sourcify) Get(ctx context.Context, contract string) ([]byte, error) {
+	data, _, err := s.GetCompiled(ctx, contract)
+	return data, err
+}
+
+// GetCompiled - returns ABI and compilation details of the contract from its metadata file
+func (s *Sourcify) GetCompiled(ctx context.Context, contract string) ([]byte, *Compilation, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if common.IsHexAddress(contract) {
+		contract = common.HexToAddress(contract).Hex()
+	}
 	fileTree, err := s.api.GetFiles(ctx, s.chainID, contract)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for i := range fileTree.Files {
 		if ext := filepath.Ext(fileTree.Files[i].Name); ext == ".json" {
 			metadata, err := sourcify.ParseMetadata(fileTree.Files[i].Content)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			return metadata.Output.ABI, nil
+			return metadata.Output.ABI, compilation(metadata), nil
+		}
+	}
+	return nil, nil, ErrNotFound
+}
+
+func compilation(metadata *sourcify.Metadata) *Compilation {
+	if metadata.Compiler.Version == "" {
+		return nil
+	}
+	result := &Compilation{
+		CompilerVersion:  metadata.Compiler.Version,
+		OptimizerEnabled: metadata.Settings.Optimizer.Enabled,
+		EVMVersion:       metadata.Settings.EvmVersion,
+	}
+	if metadata.Settings.Optimizer.Runs > 0 {
+		result.OptimizerRuns = uint64(metadata.Settings.Optimizer.Runs)
+	}
+	// license is declared per source file, the contract is declared in file of compilation target
+	for file := range metadata.Settings.CompilationTarget {
+		if source, ok := metadata.Sources[file]; ok {
+			result.License = source.License
 		}
 	}
-	return nil, ErrNotFound
+	return result
 }
 
 // List -
 func (s *Sourcify) List(ctx context.Context) ([]string, error) {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
 	contracts, err := s.api.GetContractAddresses(ctx, s.chainID)
 	if err != nil {
 		return nil, err
@@ -65,3 +103,11 @@ func (s *Sourcify) List(ctx context.Context) ([]string, error) {
 
 	return addresses, nil
 }
+
+// withTimeout - bounds request by configured timeout. Shorter deadline of ctx is respected, so request is cancelled when caller gives up.
+func (s *Sourcify) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.timeout)
+}
@@ -0,0 +1,88 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newStalledServer - returns server which doesn't respond until request is cancelled. Cancelled requests are sent to channel.
+func newStalledServer(t *testing.T) (*httptest.Server, <-chan struct{}) {
+	t.Helper()
+	cancelled := make(chan struct{}, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		cancelled <- struct{}{}
+	}))
+	t.Cleanup(server.Close)
+	return server, cancelled
+}
+
+func TestSourcifyTimeout(t *testing.T) {
+	server, cancelled := newStalledServer(t)
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		ctx     time.Duration
+	}{
+		{"source timeout", 50 * time.Millisecond, time.Minute},
+		{"shorter deadline of caller", time.Minute, 50 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewSourcify(&SourcifyConfig{BaseURL: server.URL, ChainID: 1})
+			source.timeout = tt.timeout
+
+			requests := map[string]func(ctx context.Context) error{
+				"get": func(ctx context.Context) error {
+					_, err := source.Get(ctx, "0x5fbdb2315678afecb367f032d93f642f64180aa3")
+					return err
+				},
+				"list": func(ctx context.Context) error {
+					_, err := source.List(ctx)
+					return err
+				},
+			}
+			for name, request := range requests {
+				ctx, cancel := context.WithTimeout(context.Background(), tt.ctx)
+				start := time.Now()
+				err := request(ctx)
+				cancel()
+				if err == nil {
+					t.Fatalf("%s: error isn't returned by stalled source", name)
+				}
+				if elapsed := time.Since(start); elapsed > time.Second {
+					t.Fatalf("%s: request took %s", name, elapsed)
+				}
+				select {
+				case <-cancelled:
+				case <-time.After(time.Second):
+					t.Fatalf("%s: request isn't cancelled on server", name)
+				}
+			}
+		})
+	}
+}
+
+func TestSourcifyWithTimeout(t *testing.T) {
+	source := NewSourcify(&SourcifyConfig{BaseURL: "http://localhost", ChainID: 1})
+
+	ctx, cancel := source.withTimeout(context.Background())
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("deadline is set for unlimited timeout")
+	}
+
+	source.timeout = time.Minute
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Second)
+	defer cancelParent()
+	ctx, cancel = source.withTimeout(parent)
+	defer cancel()
+	want, _ := parent.Deadline()
+	if deadline, ok := ctx.Deadline(); !ok || !deadline.Equal(want) {
+		t.Fatalf("deadline = %s, want deadline of caller %s", deadline, want)
+	}
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+// ICandidate -
+type ICandidate interface {
+	storage.Table[*Candidate]
+
+	GetByContract(ctx context.Context, contract string) ([]*Candidate, error)
+	Upsert(ctx context.Context, candidates ...*Candidate) error
+}
+
+// Candidate - ABI of the contract received from one source. Sources may return different ABI for the same contract, preferred one is stored in metadata.
+type Candidate struct {
+	// nolint
+	tableName struct{} `pg:"abi_candidates"`
+
+	ID uint64
+
+	// Contract - address of contract in lower case
+	Contract string `pg:",notnull"`
+	// Source - type of source or `manual` for ABI put by API
+	Source    string `pg:",notnull"`
+	Metadata  []byte
+	UpdatedAt time.Time
+	// Hash - sha256 of ABI. It's computed on every write.
+	Hash []byte
+}
+
+// TableName -
+func (Candidate) TableName() string {
+	return "abi_candidates"
+}
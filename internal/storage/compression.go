@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// CompressABI - gzips ABI JSON
+func CompressABI(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressABI - ungzips ABI JSON compressed by CompressABI
+func DecompressABI(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// testABI - returns ABI JSON with count of functions which resembles ABI of real contracts
+func testABI(count int) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `{"inputs":[{"internalType":"address","name":"account%d","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"method%d","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}`, i, i)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes()
+}
+
+func TestCompressABI(t *testing.T) {
+	for _, data := range [][]byte{{}, []byte(`[]`), testABI(1), testABI(500)} {
+		compressed, err := CompressABI(data)
+		if err != nil {
+			t.Fatalf("CompressABI: %v", err)
+		}
+		decompressed, err := DecompressABI(compressed)
+		if err != nil {
+			t.Fatalf("DecompressABI: %v", err)
+		}
+		if !bytes.Equal(decompressed, data) {
+			t.Fatalf("decompressed ABI of %d bytes differs from original", len(data))
+		}
+	}
+
+	if _, err := DecompressABI([]byte(`[{"type":"function"}]`)); err == nil {
+		t.Fatal("error isn't returned for data which isn't gzipped")
+	}
+}
+
+var benchSizes = []struct {
+	name  string
+	count int
+}{
+	{"small", 10},
+	{"medium", 100},
+	{"large", 1000},
+}
+
+func BenchmarkCompressABI(b *testing.B) {
+	for _, size := range benchSizes {
+		data := testABI(size.count)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := CompressABI(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecompressABI(b *testing.B) {
+	for _, size := range benchSizes {
+		data := testABI(size.count)
+		compressed, err := CompressABI(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := DecompressABI(compressed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package storage
+
+import "context"
+
+type methodKey struct{}
+
+// WithMethod - stores name of the API method which initiated storage calls to context
+func WithMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// MethodFromContext - returns name of the API method stored by WithMethod or empty string
+func MethodFromContext(ctx context.Context) string {
+	if method, ok := ctx.Value(methodKey{}).(string); ok {
+		return method
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+// IError -
+type IError interface {
+	storage.Table[*Error]
+}
+
+// Error - custom error declared in ABI. Its selector shares 4-byte space with method selectors, so the same selector can belong to a method and an error.
+type Error struct {
+	// nolint
+	tableName struct{} `pg:"errors"`
+
+	ID uint64
+
+	Name        string
+	Signature   string
+	SignatureID []byte
+	MetadataID  uint64
+
+	Metadata *Metadata `pg:",rel:has-one"`
+}
+
+// TableName -
+func (Error) TableName() string {
+	return "errors"
+}
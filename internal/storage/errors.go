@@ -0,0 +1,6 @@
+package storage
+
+import "github.com/pkg/errors"
+
+// ErrNotFound - requested entity is not found in storage
+var ErrNotFound = errors.New("not found")
@@ -1,10 +1,27 @@
 package storage
 
-import "github.com/dipdup-net/indexer-sdk/pkg/storage"
+import (
+	"context"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
 
 // IEvent -
 type IEvent interface {
 	storage.Table[*Event]
+
+	GetByTopics(ctx context.Context, topics [][]byte, limit uint64) ([]TopicEvent, error)
+}
+
+// TopicEvent - event matched by topic with address of contract which declares it
+type TopicEvent struct {
+	SignatureID []byte
+	Contract    string
+	Name        string
+	Signature   string
+	Anonymous   bool
+	// Indexed - types of indexed parameters in order of topics. It's nil if structure of event wasn't saved.
+	Indexed []string `pg:",array"`
 }
 
 // Event -
@@ -19,6 +36,8 @@ type Event struct {
 	SignatureID []byte
 	MetadataID  uint64
 	Anonymous   bool `pg:"default:false"`
+	// Indexed - types of indexed parameters in order of topics, e.g. `address` for `topic1` of `Transfer(address,address,uint256)`. It's nil for events stored before their structure was saved.
+	Indexed []string `pg:",array"`
 
 	Metadata *Metadata `pg:",rel:has-one"`
 }
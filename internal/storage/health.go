@@ -0,0 +1,8 @@
+package storage
+
+import "context"
+
+// Pinger - storage which can check that its database is reachable
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// sizes of benchmark dataset
+const (
+	benchContracts       = 1000
+	benchCommonMethods   = 5
+	benchUniqueMethods   = 15
+	benchEventsPerABI    = 5
+	benchErrorsPerABI    = 2
+	benchABISize         = 4096
+	benchSharedSignature = "common0()"
+)
+
+func benchSelector(kind string, i int) []byte {
+	return []byte(fmt.Sprintf("%s%04d", kind, i))
+}
+
+// newBenchStorage - returns storage of contracts which share some methods and declare own events and errors
+func newBenchStorage(b *testing.B) *Storage {
+	b.Helper()
+	ctx := context.Background()
+	s := New()
+	abi := bytes.Repeat([]byte{' '}, benchABISize)
+	for i := 0; i < benchContracts; i++ {
+		events := make([]*models.Event, benchEventsPerABI)
+		for j := range events {
+			events[j] = &models.Event{
+				Name:        fmt.Sprintf("Event%d", j),
+				Signature:   fmt.Sprintf("Event%d(address,uint256)", j),
+				SignatureID: benchSelector("e", i*benchEventsPerABI+j),
+				Indexed:     []string{"address"},
+			}
+		}
+		abiErrors := make([]*models.Error, benchErrorsPerABI)
+		for j := range abiErrors {
+			abiErrors[j] = &models.Error{
+				Name:        fmt.Sprintf("Error%d", j),
+				Signature:   fmt.Sprintf("Error%d()", j),
+				SignatureID: benchSelector("r", i*benchErrorsPerABI+j),
+			}
+		}
+		id := saveContract(b, s, &models.Metadata{
+			Contract:   fmt.Sprintf("0x%040x", i+1),
+			Metadata:   abi,
+			IsComplete: true,
+		}, events, abiErrors)
+
+		for j := 0; j < benchCommonMethods+benchUniqueMethods; j++ {
+			method := &models.Method{MetadataID: id}
+			if j < benchCommonMethods {
+				method.Signature = fmt.Sprintf("common%d()", j)
+				method.SignatureID = benchSelector("c", j)
+			} else {
+				method.Signature = fmt.Sprintf("method%d_%d()", i, j)
+				method.SignatureID = benchSelector("m", i*benchUniqueMethods+j)
+			}
+			if err := s.Methods.Save(ctx, method); err != nil {
+				b.Fatalf("save method: %v", err)
+			}
+		}
+	}
+	return s
+}
+
+func BenchmarkGetByAddress(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	address := fmt.Sprintf("0x%040X", benchContracts/2)
+
+	for _, bench := range []struct {
+		name    string
+		columns []string
+	}{
+		{"all columns", nil},
+		{"without ABI", []string{"id", "contract"}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := s.Metadata.GetByAddress(ctx, address, bench.columns...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetByMethod(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByMethod(ctx, benchSharedSignature, models.MetadataFilter{}, 10, 0, storage.SortOrderDesc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByTopic(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	topic := hexutil.Encode(benchSelector("e", benchContracts))
+	indexed := []models.IndexedTopic{{Position: 1, Types: []string{"address"}}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByTopic(ctx, topic, indexed, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByTopics(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	topics := make([][]byte, 10)
+	for i := range topics {
+		topics[i] = benchSelector("e", i*benchEventsPerABI*50)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Events.GetByTopics(ctx, topics, 10); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByErrorSelector(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	selector := benchSelector("r", benchContracts)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByErrorSelector(ctx, selector, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetBySelectors(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	selectors := [][]byte{benchSelector("c", 0), benchSelector("c", 1), benchSelector("m", benchUniqueMethods+1)}
+
+	for name, match := range map[string]models.MatchType{"any": models.MatchAny, "all": models.MatchAll} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if response, err := s.Metadata.GetBySelectors(ctx, selectors, match, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+					b.Fatal(err)
+				} else if len(response) == 0 {
+					b.Fatal("nothing is found")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParallelReads(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			address := fmt.Sprintf("0x%040x", i%benchContracts+1)
+			if _, err := s.Metadata.GetByAddress(ctx, address); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// Candidates -
+type Candidates struct {
+	*Table[*storage.Candidate]
+}
+
+// GetByContract - returns all ABI candidates of the contract
+func (c *Candidates) GetByContract(ctx context.Context, contract string) ([]*storage.Candidate, error) {
+	var candidates []*storage.Candidate
+	c.store.read(func() {
+		candidates = values(c.rows, func(candidate *storage.Candidate) bool {
+			return candidate.Contract == contract
+		})
+	})
+	return candidates, nil
+}
+
+// Upsert - saves candidates replacing ABI of the same contract and source
+func (c *Candidates) Upsert(ctx context.Context, candidates ...*storage.Candidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+
+	return c.store.write(func() error {
+		existing := make(map[[2]string]uint64, len(c.rows.byID))
+		for id, candidate := range c.rows.byID {
+			existing[[2]string{candidate.Contract, candidate.Source}] = id
+		}
+
+		rows := make([]*storage.Candidate, len(candidates))
+		for i := range candidates {
+			hash := sha256.Sum256(candidates[i].Metadata)
+			candidates[i].Hash = hash[:]
+			candidates[i].UpdatedAt = now
+
+			if id, ok := existing[[2]string{candidates[i].Contract, candidates[i].Source}]; ok {
+				candidates[i].ID = id
+			} else {
+				candidates[i].ID = c.rows.nextID()
+				existing[[2]string{candidates[i].Contract, candidates[i].Source}] = candidates[i].ID
+			}
+			rows[i] = copyOf(candidates[i]).(*storage.Candidate)
+		}
+
+		c.store.apply(func() {
+			for i := range rows {
+				c.rows.byID[rows[i].ID] = rows[i]
+			}
+		})
+		return nil
+	})
+}
@@ -0,0 +1,316 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+)
+
+// errors
+var (
+	ErrDuplicate = errors.New("duplicate key")
+)
+
+// Storage - in-memory realization of storage interfaces. Data is kept in maps and is lost on exit. Queries scan tables instead of indexes, so it suits single-node, ephemeral and demo deployments with small datasets.
+type Storage struct {
+	Transactable models.Transactable
+
+	Metadata   models.IMetadata
+	Methods    models.IMethod
+	Events     models.IEvent
+	Errors     models.IError
+	Candidates models.ICandidate
+	Signatures models.ISignature
+
+	// ReadMetadata, ReadMethods and ReadEvents - the same handles as Metadata, Methods and Events. There is no replica of memory storage.
+	ReadMetadata models.IMetadata
+	ReadMethods  models.IMethod
+	ReadEvents   models.IEvent
+}
+
+// New - creates empty storage
+func New() *Storage {
+	s := newStore()
+	metadata := &Metadata{newTable(s, s.metadata)}
+	methods := &Methods{newTable(s, s.methods)}
+	events := &Events{newTable(s, s.events)}
+	return &Storage{
+		Transactable: &Transactable{s},
+
+		Metadata:   metadata,
+		Methods:    methods,
+		Events:     events,
+		Errors:     &Errors{newTable(s, s.errors)},
+		Candidates: &Candidates{newTable(s, s.candidates)},
+		Signatures: &Signatures{newTable(s, s.signatures)},
+
+		ReadMetadata: metadata,
+		ReadMethods:  methods,
+		ReadEvents:   events,
+	}
+}
+
+// Close - does nothing. Data is released with storage.
+func (s *Storage) Close() error {
+	return nil
+}
+
+// rows - rows of one table by id
+type rows[M any] struct {
+	byID   map[uint64]M
+	lastID uint64
+}
+
+func newRows[M any]() *rows[M] {
+	return &rows[M]{
+		byID: make(map[uint64]M),
+	}
+}
+
+// nextID - reserves id of new row. It's called by writer only.
+func (r *rows[M]) nextID() uint64 {
+	r.lastID++
+	return r.lastID
+}
+
+// store - tables of storage. Readers hold read lock of mu. Writers hold writer lock during the whole write, so conditions checked by writer are valid until the write is applied, and hold write lock of mu only while tables are changed. Transaction holds writer lock from begin to close.
+type store struct {
+	mu     sync.RWMutex
+	writer sync.Mutex
+
+	metadata   *rows[*models.Metadata]
+	contracts  map[string]uint64
+	methods    *rows[*models.Method]
+	events     *rows[*models.Event]
+	errors     *rows[*models.Error]
+	candidates *rows[*models.Candidate]
+	signatures *rows[*models.Signature]
+}
+
+func newStore() *store {
+	return &store{
+		metadata:   newRows[*models.Metadata](),
+		contracts:  make(map[string]uint64),
+		methods:    newRows[*models.Method](),
+		events:     newRows[*models.Event](),
+		errors:     newRows[*models.Error](),
+		candidates: newRows[*models.Candidate](),
+		signatures: newRows[*models.Signature](),
+	}
+}
+
+// read - calls fn under read lock
+func (s *store) read(fn func()) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn()
+}
+
+// write - calls fn as the only writer. fn should change tables by apply.
+func (s *store) write(fn func() error) error {
+	s.writer.Lock()
+	defer s.writer.Unlock()
+	return fn()
+}
+
+// apply - changes tables under write lock. It's called by writer only.
+func (s *store) apply(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn()
+}
+
+// prepareInsert - calls insert hook of model, assigns id to it and returns its copy which should be put to table. It's called by writer only.
+func (s *store) prepareInsert(ctx context.Context, model any) (any, error) {
+	if hook, ok := model.(interface {
+		BeforeInsert(ctx context.Context) (context.Context, error)
+	}); ok {
+		if _, err := hook.BeforeInsert(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	switch m := model.(type) {
+	case *models.Metadata:
+		if _, ok := s.contracts[m.Contract]; ok {
+			return nil, errors.Wrapf(ErrDuplicate, "metadata of %s", m.Contract)
+		}
+		m.ID = s.metadata.nextID()
+	case *models.Method:
+		m.ID = s.methods.nextID()
+	case *models.Event:
+		m.ID = s.events.nextID()
+	case *models.Error:
+		m.ID = s.errors.nextID()
+	case *models.Candidate:
+		m.ID = s.candidates.nextID()
+	case *models.Signature:
+		m.ID = s.signatures.nextID()
+	default:
+		return nil, errors.Errorf("unknown model: %T", model)
+	}
+	return copyOf(model), nil
+}
+
+// prepareUpdate - calls update hook of model and returns its copy which should replace row of table. It's called by writer only.
+func (s *store) prepareUpdate(ctx context.Context, model any) (any, error) {
+	if hook, ok := model.(interface {
+		BeforeUpdate(ctx context.Context) (context.Context, error)
+	}); ok {
+		if _, err := hook.BeforeUpdate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := model.(storage.Model); !ok {
+		return nil, errors.Errorf("unknown model: %T", model)
+	}
+	return copyOf(model), nil
+}
+
+// put - stores copy returned by prepareInsert. It's called under write lock.
+func (s *store) put(row any) {
+	switch m := row.(type) {
+	case *models.Metadata:
+		s.metadata.byID[m.ID] = m
+		s.contracts[m.Contract] = m.ID
+	case *models.Method:
+		s.methods.byID[m.ID] = m
+	case *models.Event:
+		s.events.byID[m.ID] = m
+	case *models.Error:
+		s.errors.byID[m.ID] = m
+	case *models.Candidate:
+		s.candidates.byID[m.ID] = m
+	case *models.Signature:
+		s.signatures.byID[m.ID] = m
+	}
+}
+
+// replace - replaces row by copy returned by prepareUpdate. Missing rows aren't inserted. Tags and code status of metadata are kept like columns excluded from update by postgres storage. It's called under write lock.
+func (s *store) replace(row any) {
+	switch m := row.(type) {
+	case *models.Metadata:
+		current, ok := s.metadata.byID[m.ID]
+		if !ok {
+			return
+		}
+		m.Tags = current.Tags
+		m.CodeStatus = current.CodeStatus
+		m.CodeCheckedAt = current.CodeCheckedAt
+		if current.Contract != m.Contract {
+			delete(s.contracts, current.Contract)
+			s.contracts[m.Contract] = m.ID
+		}
+		s.metadata.byID[m.ID] = m
+	case *models.Method:
+		replaceRow(s.methods, m.ID, m)
+	case *models.Event:
+		replaceRow(s.events, m.ID, m)
+	case *models.Error:
+		replaceRow(s.errors, m.ID, m)
+	case *models.Candidate:
+		replaceRow(s.candidates, m.ID, m)
+	case *models.Signature:
+		replaceRow(s.signatures, m.ID, m)
+	}
+}
+
+func replaceRow[M any](r *rows[M], id uint64, m M) {
+	if _, ok := r.byID[id]; ok {
+		r.byID[id] = m
+	}
+}
+
+// deleteContent - deletes methods, events and errors of metadata. It's called under write lock.
+func (s *store) deleteContent(metadataID uint64) {
+	for id, m := range s.methods.byID {
+		if m.MetadataID == metadataID {
+			delete(s.methods.byID, id)
+		}
+	}
+	for id, e := range s.events.byID {
+		if e.MetadataID == metadataID {
+			delete(s.events.byID, id)
+		}
+	}
+	for id, e := range s.errors.byID {
+		if e.MetadataID == metadataID {
+			delete(s.errors.byID, id)
+		}
+	}
+}
+
+// copyOf - returns shallow copy of model without loaded relations, so rows of storage aren't changed by callers
+func copyOf(model any) any {
+	switch m := model.(type) {
+	case *models.Metadata:
+		c := *m
+		return &c
+	case *models.Method:
+		c := *m
+		c.Metadata = nil
+		return &c
+	case *models.Event:
+		c := *m
+		c.Metadata = nil
+		return &c
+	case *models.Error:
+		c := *m
+		c.Metadata = nil
+		return &c
+	case *models.Candidate:
+		c := *m
+		return &c
+	case *models.Signature:
+		c := *m
+		return &c
+	}
+	return model
+}
+
+// values - returns copies of rows matching filter sorted by id in ascending order. Nil filter matches all rows.
+func values[M any](r *rows[M], filter func(M) bool) []M {
+	result := make([]M, 0)
+	for _, row := range r.byID {
+		if filter == nil || filter(row) {
+			result = append(result, copyOf(row).(M))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return rowID(result[i]) < rowID(result[j])
+	})
+	return result
+}
+
+// paginate - returns page of items sorted by id ascending. Descending order reverses items. Zero limit means 10 like pagination of postgres storage.
+func paginate[M any](items []M, limit, offset uint64, order storage.SortOrder) []M {
+	if order == storage.SortOrderDesc {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	return cut(items, pageLimit(limit), offset)
+}
+
+// cut - returns items from offset up to limit
+func cut[M any](items []M, limit, offset uint64) []M {
+	if offset >= uint64(len(items)) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit < uint64(len(items)) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return 10
+	}
+	return limit
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// saveContract - saves metadata of contract with its events and errors and returns id of metadata
+func saveContract(t testing.TB, s *Storage, m *models.Metadata, events []*models.Event, abiErrors []*models.Error) uint64 {
+	t.Helper()
+	ctx := context.Background()
+	if err := s.Metadata.Save(ctx, m); err != nil {
+		t.Fatalf("save metadata of %s: %v", m.Contract, err)
+	}
+	for i := range events {
+		events[i].MetadataID = m.ID
+		if err := s.Events.Save(ctx, events[i]); err != nil {
+			t.Fatalf("save event %s: %v", events[i].Signature, err)
+		}
+	}
+	for i := range abiErrors {
+		abiErrors[i].MetadataID = m.ID
+		if err := s.Errors.Save(ctx, abiErrors[i]); err != nil {
+			t.Fatalf("save error %s: %v", abiErrors[i].Signature, err)
+		}
+	}
+	return m.ID
+}
@@ -0,0 +1,10 @@
+package memory
+
+import (
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// Errors -
+type Errors struct {
+	*Table[*storage.Error]
+}
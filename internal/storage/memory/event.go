@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// Events -
+type Events struct {
+	*Table[*storage.Event]
+}
+
+// GetByTopics - returns events matching any of topics. Up to limit events are returned for each topic. Result is ordered by topic.
+func (e *Events) GetByTopics(ctx context.Context, topics [][]byte, limit uint64) ([]storage.TopicEvent, error) {
+	requested := make(map[string]struct{}, len(topics))
+	for i := range topics {
+		requested[string(topics[i])] = struct{}{}
+	}
+
+	var events []*storage.Event
+	response := make([]storage.TopicEvent, 0)
+	e.store.read(func() {
+		events = values(e.rows, func(event *storage.Event) bool {
+			_, ok := requested[string(event.SignatureID)]
+			return ok
+		})
+		sort.SliceStable(events, func(i, j int) bool {
+			return bytes.Compare(events[i].SignatureID, events[j].SignatureID) < 0
+		})
+
+		counts := make(map[string]uint64, len(requested))
+		for _, event := range events {
+			metadata, ok := e.store.metadata.byID[event.MetadataID]
+			if !ok || counts[string(event.SignatureID)] >= limit {
+				continue
+			}
+			counts[string(event.SignatureID)]++
+			response = append(response, storage.TopicEvent{
+				SignatureID: event.SignatureID,
+				Contract:    metadata.Contract,
+				Name:        event.Name,
+				Signature:   event.Signature,
+				Anonymous:   event.Anonymous,
+				Indexed:     event.Indexed,
+			})
+		}
+	})
+	return response, nil
+}
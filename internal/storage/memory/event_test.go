@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+func TestGetByTopicsIndexed(t *testing.T) {
+	var (
+		transfer = []byte{0xdd, 0xf2, 0x52, 0xad}
+		deposit  = []byte{0xe1, 0xff, 0xfc, 0xc4}
+		legacy   = []byte{0x8c, 0x5b, 0xe1, 0xe5}
+	)
+	s := New()
+	saveContract(t, s, &models.Metadata{Contract: "0x5fbdb2315678afecb367f032d93f642f64180aa3"}, []*models.Event{
+		{Name: "Transfer", Signature: "Transfer(address,address,uint256)", SignatureID: transfer, Indexed: []string{"address", "address"}},
+		{Name: "Deposit", Signature: "Deposit(address,uint256)", SignatureID: deposit, Indexed: []string{}},
+		{Name: "Approval", Signature: "Approval(address,address,uint256)", SignatureID: legacy},
+	}, nil)
+
+	events, err := s.Events.GetByTopics(context.Background(), [][]byte{transfer, deposit, legacy}, 10)
+	if err != nil {
+		t.Fatalf("GetByTopics: %v", err)
+	}
+	want := map[string][]string{
+		"Transfer": {"address", "address"},
+		"Deposit":  {},
+		"Approval": nil,
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	for i := range events {
+		indexed, ok := want[events[i].Name]
+		if !ok {
+			t.Fatalf("unexpected event %s", events[i].Name)
+		}
+		if !reflect.DeepEqual(events[i].Indexed, indexed) {
+			t.Fatalf("%s: indexed = %#v, want %#v", events[i].Name, events[i].Indexed, indexed)
+		}
+	}
+}
@@ -0,0 +1,608 @@
+package memory
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// Metadata -
+type Metadata struct {
+	*Table[*models.Metadata]
+}
+
+// GetByAddress - returns metadata of contract. Address is case-insensitive. If columns are passed, ABI and JSON schema are returned only if they are listed.
+func (m *Metadata) GetByAddress(ctx context.Context, address string, columns ...string) (*models.Metadata, error) {
+	var response *models.Metadata
+	m.store.read(func() {
+		if id, ok := m.store.contracts[strings.ToLower(address)]; ok {
+			response = project(m.rows.byID[id], columns)
+		}
+	})
+	if response == nil {
+		return nil, errors.Wrap(models.ErrNotFound, address)
+	}
+	return response, nil
+}
+
+// GetByMethod -
+func (m *Metadata) GetByMethod(ctx context.Context, signature string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		ids := make(map[uint64]struct{})
+		for _, method := range m.store.methods.byID {
+			if method.Signature == signature {
+				ids[method.MetadataID] = struct{}{}
+			}
+		}
+		response = paginate(m.byIDs(ids, filter), limit, offset, order)
+	})
+	return response, nil
+}
+
+// GetByTopic - returns metadata which declares event with the topic. If indexed conditions are passed, event should have indexed parameters of matching types.
+func (m *Metadata) GetByTopic(ctx context.Context, topic string, indexed []models.IndexedTopic, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	// hex-encoded topic is decoded, other values are compared as raw bytes
+	value := []byte(topic)
+	if decoded, err := hexutil.Decode(topic); err == nil {
+		value = decoded
+	}
+
+	var response []*models.Metadata
+	m.store.read(func() {
+		ids := make(map[uint64]struct{})
+		for _, event := range m.store.events.byID {
+			if string(event.SignatureID) == string(value) && matchesIndexed(event, indexed) {
+				ids[event.MetadataID] = struct{}{}
+			}
+		}
+		response = paginate(m.byIDs(ids, filter), limit, offset, order)
+	})
+	return response, nil
+}
+
+// matchesIndexed - checks indexed parameters of event like postgres storage. Positions are counted from 1. Parameters of dynamic types match any type because their values are hashed.
+func matchesIndexed(event *models.Event, indexed []models.IndexedTopic) bool {
+	for i := range indexed {
+		pos := indexed[i].Position - 1
+		if pos < 0 || pos >= len(event.Indexed) {
+			return false
+		}
+		typ := event.Indexed[pos]
+		if typ == "string" || typ == "bytes" || strings.HasSuffix(typ, "]") || strings.HasPrefix(typ, "(") {
+			continue
+		}
+		if !contains(indexed[i].Types, typ) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetByErrorSelector - returns metadata which declares custom error with the selector
+func (m *Metadata) GetByErrorSelector(ctx context.Context, selector []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		ids := make(map[uint64]struct{})
+		for _, abiError := range m.store.errors.byID {
+			if string(abiError.SignatureID) == string(selector) {
+				ids[abiError.MetadataID] = struct{}{}
+			}
+		}
+		response = paginate(m.byIDs(ids, filter), limit, offset, order)
+	})
+	return response, nil
+}
+
+// GetByCodeHash - returns metadata of contracts with the same runtime bytecode hash
+func (m *Metadata) GetByCodeHash(ctx context.Context, hash []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return m.list(filter, limit, offset, order, func(row *models.Metadata) bool {
+		return len(row.CodeHash) > 0 && string(row.CodeHash) == string(hash)
+	}), nil
+}
+
+// GetBySelectors -
+func (m *Metadata) GetBySelectors(ctx context.Context, selectors [][]byte, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	requested := make(map[string]struct{}, len(selectors))
+	for i := range selectors {
+		requested[string(selectors[i])] = struct{}{}
+	}
+
+	var response []*models.Metadata
+	m.store.read(func() {
+		found := make(map[uint64]map[string]struct{})
+		for _, method := range m.store.methods.byID {
+			if _, ok := requested[string(method.SignatureID)]; !ok {
+				continue
+			}
+			if found[method.MetadataID] == nil {
+				found[method.MetadataID] = make(map[string]struct{})
+			}
+			found[method.MetadataID][string(method.SignatureID)] = struct{}{}
+		}
+
+		ids := make(map[uint64]struct{}, len(found))
+		for id, matched := range found {
+			if match != models.MatchAll || len(matched) == len(selectors) {
+				ids[id] = struct{}{}
+			}
+		}
+		response = paginate(m.byIDs(ids, filter), limit, offset, order)
+	})
+	return response, nil
+}
+
+// ListByFilter - returns metadata matching filter. If columns are passed, ABI and JSON schema are returned only if they are listed.
+func (m *Metadata) ListByFilter(ctx context.Context, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder, columns ...string) ([]*models.Metadata, error) {
+	response := m.list(filter, limit, offset, order, nil)
+	for i := range response {
+		response[i] = project(response[i], columns)
+	}
+	return response, nil
+}
+
+// ListWithoutInterfaces - returns metadata with id greater than lastID which interfaces were never detected. Proxy classification is always set in memory storage.
+func (m *Metadata) ListWithoutInterfaces(ctx context.Context, lastID, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			return row.Interfaces == nil && row.ID > lastID
+		})
+	})
+	return cut(response, limit, 0), nil
+}
+
+// Sample - returns random set of n metadata. Method is ignored: sampling is always exact.
+func (m *Metadata) Sample(ctx context.Context, n uint64, method models.SampleMethod) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, nil)
+	})
+	rand.Shuffle(len(response), func(i, j int) {
+		response[i], response[j] = response[j], response[i]
+	})
+	return cut(response, n, 0), nil
+}
+
+// GetByTags - returns metadata marked by any or all of tags
+func (m *Metadata) GetByTags(ctx context.Context, tags []string, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return m.list(filter, limit, offset, order, func(row *models.Metadata) bool {
+		for i := range tags {
+			has := contains(row.Tags, tags[i])
+			if match == models.MatchAll && !has {
+				return false
+			}
+			if match != models.MatchAll && has {
+				return true
+			}
+		}
+		return match == models.MatchAll
+	}), nil
+}
+
+// AddTags - adds tags to the contract and returns its tags sorted alphabetically. Write time and hash of the entry are kept.
+func (m *Metadata) AddTags(ctx context.Context, address string, tags []string) ([]string, error) {
+	return m.setTags(address, func(current []string) []string {
+		result := make([]string, 0, len(current)+len(tags))
+		for _, tag := range append(append([]string{}, current...), tags...) {
+			if !contains(result, tag) {
+				result = append(result, tag)
+			}
+		}
+		return result
+	})
+}
+
+// RemoveTags - removes tags from the contract and returns its remaining tags. Write time and hash of the entry are kept.
+func (m *Metadata) RemoveTags(ctx context.Context, address string, tags []string) ([]string, error) {
+	return m.setTags(address, func(current []string) []string {
+		result := make([]string, 0, len(current))
+		for i := range current {
+			if !contains(tags, current[i]) {
+				result = append(result, current[i])
+			}
+		}
+		return result
+	})
+}
+
+func (m *Metadata) setTags(address string, change func(current []string) []string) ([]string, error) {
+	var tags []string
+	err := m.store.write(func() error {
+		id, ok := m.store.contracts[strings.ToLower(address)]
+		if !ok {
+			return errors.Wrap(models.ErrNotFound, address)
+		}
+		tags = change(m.rows.byID[id].Tags)
+		sort.Strings(tags)
+
+		m.store.apply(func() {
+			row := copyOf(m.rows.byID[id]).(*models.Metadata)
+			row.Tags = tags
+			m.rows.byID[id] = row
+		})
+		return nil
+	})
+	return tags, err
+}
+
+// ListTags - returns all tags with count of marked contracts. The most used tags are returned first.
+func (m *Metadata) ListTags(ctx context.Context) ([]models.TagCount, error) {
+	counts := make(map[string]uint64)
+	m.store.read(func() {
+		for _, row := range m.rows.byID {
+			for i := range row.Tags {
+				counts[row.Tags[i]]++
+			}
+		}
+	})
+
+	response := make([]models.TagCount, 0, len(counts))
+	for tag, count := range counts {
+		response = append(response, models.TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(response, func(i, j int) bool {
+		if response[i].Count != response[j].Count {
+			return response[i].Count > response[j].Count
+		}
+		return response[i].Tag < response[j].Tag
+	})
+	return response, nil
+}
+
+// similarCandidates - max count of contracts sharing selectors or topics with requested one for which similarity is computed. Candidates with the most shared entries are chosen.
+const similarCandidates = 1000
+
+// FindSimilar - returns contracts with the same fingerprint as model first and then contracts with similarity not less than minSimilarity ordered by similarity. The model itself is excluded. ABI and JSON schema aren't returned.
+func (m *Metadata) FindSimilar(ctx context.Context, model *models.Metadata, minSimilarity float64, limit uint64) ([]models.SimilarMetadata, error) {
+	response := make([]models.SimilarMetadata, 0)
+	if len(model.Fingerprint) == 0 {
+		return response, nil
+	}
+	limit = pageLimit(limit)
+
+	m.store.read(func() {
+		exact := values(m.rows, func(row *models.Metadata) bool {
+			return row.ID != model.ID && string(row.Fingerprint) == string(model.Fingerprint)
+		})
+		found := make(map[uint64]struct{}, len(exact))
+		for _, row := range cut(exact, limit, 0) {
+			response = append(response, models.SimilarMetadata{
+				Metadata:   project(row, models.MetadataLightColumns),
+				Similarity: 1,
+			})
+			found[row.ID] = struct{}{}
+		}
+		if uint64(len(response)) >= limit || minSimilarity >= 1 {
+			return
+		}
+
+		entries := m.store.interfaceEntries()
+		target := entries[model.ID]
+		if len(target) == 0 {
+			return
+		}
+
+		type score struct {
+			id         uint64
+			common     int
+			similarity float64
+		}
+		candidates := make([]score, 0)
+		for id, set := range entries {
+			if id == model.ID {
+				continue
+			}
+			var common int
+			for entry := range set {
+				if _, ok := target[entry]; ok {
+					common++
+				}
+			}
+			if common > 0 && float64(common) >= minSimilarity*float64(len(target)) {
+				candidates = append(candidates, score{
+					id:         id,
+					common:     common,
+					similarity: float64(common) / float64(len(set)+len(target)-common),
+				})
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].common != candidates[j].common {
+				return candidates[i].common > candidates[j].common
+			}
+			return candidates[i].id < candidates[j].id
+		})
+		candidates = cut(candidates, similarCandidates, 0)
+		sort.Slice(candidates, func(i, j int) bool {
+			if candidates[i].similarity != candidates[j].similarity {
+				return candidates[i].similarity > candidates[j].similarity
+			}
+			return candidates[i].id < candidates[j].id
+		})
+
+		for i := range candidates {
+			if uint64(len(response)) >= limit {
+				break
+			}
+			if _, ok := found[candidates[i].id]; ok || candidates[i].similarity < minSimilarity {
+				continue
+			}
+			if row, ok := m.rows.byID[candidates[i].id]; ok {
+				response = append(response, models.SimilarMetadata{
+					Metadata:   project(row, models.MetadataLightColumns),
+					Similarity: candidates[i].similarity,
+				})
+			}
+		}
+	})
+	return response, nil
+}
+
+// interfaceEntries - returns sets of method selectors and event topics by metadata id. Selectors and topics are prefixed like entries of fingerprint, so they don't collide. It's called under read lock.
+func (s *store) interfaceEntries() map[uint64]map[string]struct{} {
+	entries := make(map[uint64]map[string]struct{})
+	add := func(id uint64, prefix byte, value []byte) {
+		if entries[id] == nil {
+			entries[id] = make(map[string]struct{})
+		}
+		entries[id][string(append([]byte{prefix}, value...))] = struct{}{}
+	}
+	for _, method := range s.methods.byID {
+		add(method.MetadataID, 0x01, method.SignatureID)
+	}
+	for _, event := range s.events.byID {
+		add(event.MetadataID, 0x02, event.SignatureID)
+	}
+	return entries
+}
+
+// ListByBlockRange - returns metadata of contracts created between blocks from and to inclusively ordered by creation block. If to is zero the range isn't bounded. Contracts with unknown creation block are excluded.
+func (m *Metadata) ListByBlockRange(ctx context.Context, from, to uint64, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			return row.CreationBlock > 0 && row.CreationBlock >= from && (to == 0 || row.CreationBlock <= to) && matches(row, filter)
+		})
+	})
+	return sortByKey(response, func(row *models.Metadata) (int64, bool) {
+		return int64(row.CreationBlock), true
+	}, limit, offset, order), nil
+}
+
+// GetByCreator - returns metadata of contracts deployed by creator ordered by creation time. Contracts with unknown creation time are returned last.
+func (m *Metadata) GetByCreator(ctx context.Context, creator string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	creator = strings.ToLower(creator)
+
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			return row.Creator != "" && row.Creator == creator && matches(row, filter)
+		})
+	})
+	return sortByKey(response, func(row *models.Metadata) (int64, bool) {
+		return row.CreatedAt.UnixNano(), !row.CreatedAt.IsZero()
+	}, limit, offset, order), nil
+}
+
+// ListByFactory - returns metadata of contracts deployed by factory
+func (m *Metadata) ListByFactory(ctx context.Context, factory string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	factory = strings.ToLower(factory)
+	return m.list(filter, limit, offset, order, func(row *models.Metadata) bool {
+		return row.Factory != "" && row.Factory == factory
+	}), nil
+}
+
+// ListStale - returns metadata which wasn't checked by background refresh since the time. Entries which were never checked are compared by write time. The oldest entries are returned first.
+func (m *Metadata) ListStale(ctx context.Context, before time.Time, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			checked := checkedAt(row)
+			return checked.IsZero() || checked.Before(before)
+		})
+	})
+	sort.SliceStable(response, func(i, j int) bool {
+		return checkedAt(response[i]).Before(checkedAt(response[j]))
+	})
+	if limit == 0 {
+		return response, nil
+	}
+	return cut(response, limit, 0), nil
+}
+
+func checkedAt(row *models.Metadata) time.Time {
+	if row.RefreshedAt.IsZero() {
+		return row.UpdatedAt
+	}
+	return row.RefreshedAt
+}
+
+// MarkRefreshed - sets time of the last background refresh and fetch attempt. Write time and hash of the entry are kept.
+func (m *Metadata) MarkRefreshed(ctx context.Context, id uint64, at time.Time) error {
+	return m.store.write(func() error {
+		current, ok := m.rows.byID[id]
+		if !ok {
+			return nil
+		}
+		row := copyOf(current).(*models.Metadata)
+		row.RefreshedAt = at
+		row.LastFetchAttempt = at
+		m.store.apply(func() { m.rows.byID[id] = row })
+		return nil
+	})
+}
+
+// ListCodeUnchecked - returns metadata which code was never checked or was checked before the time, the least recently checked first. If createdBefore is set, contracts created at or after the block are skipped, contracts with unknown creation block are returned. ABI and JSON schema aren't returned.
+func (m *Metadata) ListCodeUnchecked(ctx context.Context, before time.Time, createdBefore, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			if createdBefore > 0 && row.CreationBlock >= createdBefore {
+				return false
+			}
+			return row.CodeCheckedAt.IsZero() || row.CodeCheckedAt.Before(before)
+		})
+	})
+	sort.SliceStable(response, func(i, j int) bool {
+		return response[i].CodeCheckedAt.Before(response[j].CodeCheckedAt)
+	})
+	if limit > 0 {
+		response = cut(response, limit, 0)
+	}
+	for i := range response {
+		response[i] = project(response[i], models.MetadataLightColumns)
+	}
+	return response, nil
+}
+
+// MarkCodeStatus - sets result and time of the last check of contract code. Write time and hash of the entry are kept.
+func (m *Metadata) MarkCodeStatus(ctx context.Context, id uint64, status models.CodeStatus, at time.Time) error {
+	return m.store.write(func() error {
+		current, ok := m.rows.byID[id]
+		if !ok {
+			return nil
+		}
+		row := copyOf(current).(*models.Metadata)
+		row.CodeStatus = status
+		row.CodeCheckedAt = at
+		m.store.apply(func() { m.rows.byID[id] = row })
+		return nil
+	})
+}
+
+// ListIncomplete - returns metadata without full ABI ordered by time of the last fetch attempt. Entries which were never attempted since tracking started are compared by write time. ABI and JSON schema aren't returned.
+func (m *Metadata) ListIncomplete(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			return !row.IsComplete
+		})
+	})
+	response = sortByKey(response, func(row *models.Metadata) (int64, bool) {
+		attempt := row.LastFetchAttempt
+		if attempt.IsZero() {
+			attempt = row.UpdatedAt
+		}
+		return attempt.UnixNano(), true
+	}, limit, offset, order)
+	for i := range response {
+		response[i] = project(response[i], models.MetadataLightColumns)
+	}
+	return response, nil
+}
+
+// LastID - returns the greatest id of metadata. Zero is returned if table is empty.
+func (m *Metadata) LastID(ctx context.Context) (uint64, error) {
+	var id uint64
+	m.store.read(func() {
+		for rowID := range m.rows.byID {
+			if rowID > id {
+				id = rowID
+			}
+		}
+	})
+	return id, nil
+}
+
+// LatestUpdate - returns write time of the most recently written metadata. Zero time is returned if table is empty.
+func (m *Metadata) LatestUpdate(ctx context.Context) (time.Time, error) {
+	var latest time.Time
+	m.store.read(func() {
+		for _, row := range m.rows.byID {
+			if row.UpdatedAt.After(latest) {
+				latest = row.UpdatedAt
+			}
+		}
+	})
+	return latest, nil
+}
+
+// list - returns page of metadata matching filter and condition sorted by id. Nil condition matches all metadata.
+func (m *Metadata) list(filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder, condition func(*models.Metadata) bool) []*models.Metadata {
+	var response []*models.Metadata
+	m.store.read(func() {
+		response = values(m.rows, func(row *models.Metadata) bool {
+			return (condition == nil || condition(row)) && matches(row, filter)
+		})
+	})
+	return paginate(response, limit, offset, order)
+}
+
+// byIDs - returns copies of metadata with ids matching filter sorted by id. It's called under read lock.
+func (m *Metadata) byIDs(ids map[uint64]struct{}, filter models.MetadataFilter) []*models.Metadata {
+	return values(m.rows, func(row *models.Metadata) bool {
+		_, ok := ids[row.ID]
+		return ok && matches(row, filter)
+	})
+}
+
+// matches - checks metadata satisfies filter like filter of postgres storage
+func matches(row *models.Metadata, filter models.MetadataFilter) bool {
+	switch {
+	case filter.OnlyComplete && !row.IsComplete:
+		return false
+	case !filter.UpdatedSince.IsZero() && row.UpdatedAt.Before(filter.UpdatedSince):
+		return false
+	case filter.AfterID > 0 && row.ID <= filter.AfterID:
+		return false
+	case filter.BeforeID > 0 && row.ID >= filter.BeforeID:
+		return false
+	case filter.CompilerVersion != "" && !strings.HasPrefix(row.CompilerVersion, filter.CompilerVersion):
+		return false
+	case filter.IsProxy != nil && row.IsProxy != *filter.IsProxy:
+		return false
+	case filter.WithoutDestroyed && row.CodeStatus == models.CodeStatusDestroyed:
+		return false
+	}
+	return true
+}
+
+// sortByKey - returns page of metadata sorted by key like sortByKey of postgres storage: metadata with equal keys are sorted by id in the same direction. Metadata without key are returned last in both directions.
+func sortByKey(items []*models.Metadata, key func(*models.Metadata) (int64, bool), limit, offset uint64, order storage.SortOrder) []*models.Metadata {
+	desc := order == storage.SortOrderDesc
+	sort.Slice(items, func(i, j int) bool {
+		ki, iok := key(items[i])
+		kj, jok := key(items[j])
+		switch {
+		case iok != jok:
+			return iok
+		case !iok || ki == kj:
+			return (items[i].ID < items[j].ID) != desc
+		default:
+			return (ki < kj) != desc
+		}
+	})
+	return cut(items, pageLimit(limit), offset)
+}
+
+// project - returns copy of metadata. If columns are passed, ABI and JSON schema are kept only if they are listed like columns selected by postgres storage.
+func project(row *models.Metadata, columns []string) *models.Metadata {
+	c := copyOf(row).(*models.Metadata)
+	if len(columns) == 0 {
+		return c
+	}
+	if !contains(columns, models.ColumnMetadata) {
+		c.Metadata = nil
+	}
+	if !contains(columns, models.ColumnJSONSchema) {
+		c.JSONSchema = nil
+	}
+	return c
+}
+
+func contains(list []string, value string) bool {
+	for i := range list {
+		if list[i] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func TestGetByErrorSelector(t *testing.T) {
+	var (
+		unauthorized = []byte{0x82, 0xb4, 0x29, 0x00}
+		insufficient = []byte{0xcf, 0x47, 0x91, 0x81}
+	)
+	s := New()
+	first := saveContract(t, s, &models.Metadata{Contract: "0x0000000000000000000000000000000000000001", IsComplete: true}, nil, []*models.Error{
+		{Name: "Unauthorized", Signature: "Unauthorized()", SignatureID: unauthorized},
+		{Name: "InsufficientBalance", Signature: "InsufficientBalance(uint256,uint256)", SignatureID: insufficient},
+	})
+	second := saveContract(t, s, &models.Metadata{Contract: "0x0000000000000000000000000000000000000002"}, nil, []*models.Error{
+		{Name: "Unauthorized", Signature: "Unauthorized()", SignatureID: unauthorized},
+	})
+	// method with the same selector isn't matched by error lookup
+	if err := s.Methods.Save(context.Background(), &models.Method{Name: "foo", Signature: "foo()", SignatureID: insufficient, MetadataID: second}); err != nil {
+		t.Fatalf("save method: %v", err)
+	}
+	saveContract(t, s, &models.Metadata{Contract: "0x0000000000000000000000000000000000000003"}, nil, nil)
+
+	tests := []struct {
+		name     string
+		selector []byte
+		filter   models.MetadataFilter
+		limit    uint64
+		offset   uint64
+		order    storage.SortOrder
+		want     []uint64
+	}{
+		{"shared error", unauthorized, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc, []uint64{first, second}},
+		{"descending order", unauthorized, models.MetadataFilter{}, 10, 0, storage.SortOrderDesc, []uint64{second, first}},
+		{"page", unauthorized, models.MetadataFilter{}, 1, 1, storage.SortOrderAsc, []uint64{second}},
+		{"filter", unauthorized, models.MetadataFilter{OnlyComplete: true}, 10, 0, storage.SortOrderAsc, []uint64{first}},
+		{"error isn't confused with method", insufficient, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc, []uint64{first}},
+		{"unknown selector", []byte{0x08, 0xc3, 0x79, 0xa0}, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc, []uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := s.Metadata.GetByErrorSelector(context.Background(), tt.selector, tt.filter, tt.limit, tt.offset, tt.order)
+			if err != nil {
+				t.Fatalf("GetByErrorSelector: %v", err)
+			}
+			assertIDs(t, response, tt.want)
+		})
+	}
+}
+
+func assertIDs(t *testing.T, response []*models.Metadata, want []uint64) {
+	t.Helper()
+	if len(response) != len(want) {
+		t.Fatalf("got %d contracts, want %v", len(response), want)
+	}
+	for i := range want {
+		if response[i].ID != want[i] {
+			ids := make([]uint64, len(response))
+			for j := range response {
+				ids[j] = response[j].ID
+			}
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	// metadata 2, 4 and 5 have equal key, metadata 3 doesn't have key
+	keys := map[uint64]int64{1: 20, 2: 10, 4: 10, 5: 10, 6: 30}
+	key := func(row *models.Metadata) (int64, bool) {
+		value, ok := keys[row.ID]
+		return value, ok
+	}
+	items := func() []*models.Metadata {
+		response := make([]*models.Metadata, 0, 6)
+		for _, id := range []uint64{5, 3, 1, 6, 2, 4} {
+			response = append(response, &models.Metadata{ID: id})
+		}
+		return response
+	}
+
+	tests := []struct {
+		name   string
+		limit  uint64
+		offset uint64
+		order  storage.SortOrder
+		want   []uint64
+	}{
+		{"ascending", 10, 0, storage.SortOrderAsc, []uint64{2, 4, 5, 1, 6, 3}},
+		{"descending", 10, 0, storage.SortOrderDesc, []uint64{6, 1, 5, 4, 2, 3}},
+		{"first page", 2, 0, storage.SortOrderAsc, []uint64{2, 4}},
+		{"second page", 2, 2, storage.SortOrderAsc, []uint64{5, 1}},
+		{"last page", 2, 4, storage.SortOrderAsc, []uint64{6, 3}},
+		{"page of descending order", 2, 1, storage.SortOrderDesc, []uint64{1, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertIDs(t, sortByKey(items(), key, tt.limit, tt.offset, tt.order), tt.want)
+		})
+	}
+}
+
+func TestListByBlockRangeTiebreaker(t *testing.T) {
+	s := New()
+	for i, block := range []uint64{200, 100, 100, 100, 300} {
+		saveContract(t, s, &models.Metadata{Contract: fmt.Sprintf("0x%040x", i+1), CreationBlock: block}, nil, nil)
+	}
+
+	// pages don't skip or repeat contracts of the same block
+	var ids []uint64
+	for offset := uint64(0); offset < 5; offset += 2 {
+		response, err := s.Metadata.ListByBlockRange(context.Background(), 0, 0, models.MetadataFilter{}, 2, offset, storage.SortOrderDesc)
+		if err != nil {
+			t.Fatalf("ListByBlockRange: %v", err)
+		}
+		for i := range response {
+			ids = append(ids, response[i].ID)
+		}
+	}
+	want := []uint64{5, 1, 4, 3, 2}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// Methods -
+type Methods struct {
+	*Table[*storage.Method]
+}
+
+// ListSelectorSignatures - returns distinct triples of selector, signature and kind of indexed functions and errors and imported signatures ordered by selector, signature and kind. Triples after (afterSelector, afterSignature, afterKind) are returned.
+func (m *Methods) ListSelectorSignatures(ctx context.Context, afterSelector []byte, afterSignature string, afterKind storage.SignatureKind, limit uint64) ([]storage.SelectorSignature, error) {
+	after := storage.SelectorSignature{
+		Selector:  afterSelector,
+		Signature: afterSignature,
+		Kind:      afterKind,
+	}
+
+	var response []storage.SelectorSignature
+	m.store.read(func() {
+		response = m.store.selectorSignatures()
+	})
+
+	start := sort.Search(len(response), func(i int) bool {
+		return compareSelectorSignatures(response[i], after) > 0
+	})
+	return cut(response[start:], limit, 0), nil
+}
+
+// CoverageStats - counts distinct selectors, topics and signatures of lookup tables
+func (m *Methods) CoverageStats(ctx context.Context) (storage.CoverageStats, error) {
+	var stats storage.CoverageStats
+	m.store.read(func() {
+		signatures := make(map[string]map[string]struct{})
+		texts := make(map[string]struct{})
+		add := func(selector []byte, signature string) {
+			if signatures[string(selector)] == nil {
+				signatures[string(selector)] = make(map[string]struct{})
+			}
+			signatures[string(selector)][signature] = struct{}{}
+			texts[signature] = struct{}{}
+		}
+		for _, method := range m.store.methods.byID {
+			add(method.SignatureID, method.Signature)
+		}
+		for _, abiError := range m.store.errors.byID {
+			add(abiError.SignatureID, abiError.Signature)
+		}
+		for _, signature := range m.store.signatures.byID {
+			add(signature.Selector, signature.Signature)
+		}
+
+		topics := make(map[string]struct{})
+		for _, event := range m.store.events.byID {
+			topics[string(event.SignatureID)] = struct{}{}
+			texts[event.Signature] = struct{}{}
+		}
+
+		stats.Selectors = uint64(len(signatures))
+		for _, set := range signatures {
+			if len(set) > 1 {
+				stats.CollidingSelectors++
+			}
+		}
+		stats.Topics = uint64(len(topics))
+		stats.Signatures = uint64(len(texts))
+	})
+	return stats, nil
+}
+
+// SelectorsByMetadata - returns selectors of methods of metadata. Only `metadata_id` and `signature_id` are filled.
+func (m *Methods) SelectorsByMetadata(ctx context.Context, metadataIDs []uint64) ([]*storage.Method, error) {
+	ids := make(map[uint64]struct{}, len(metadataIDs))
+	for i := range metadataIDs {
+		ids[metadataIDs[i]] = struct{}{}
+	}
+
+	var methods []*storage.Method
+	m.store.read(func() {
+		methods = values(m.rows, func(method *storage.Method) bool {
+			_, ok := ids[method.MetadataID]
+			return ok
+		})
+	})
+	sort.SliceStable(methods, func(i, j int) bool {
+		if methods[i].MetadataID != methods[j].MetadataID {
+			return methods[i].MetadataID < methods[j].MetadataID
+		}
+		return bytes.Compare(methods[i].SignatureID, methods[j].SignatureID) < 0
+	})
+
+	response := make([]*storage.Method, len(methods))
+	for i := range methods {
+		response[i] = &storage.Method{
+			MetadataID:  methods[i].MetadataID,
+			SignatureID: methods[i].SignatureID,
+		}
+	}
+	return response, nil
+}
+
+// selectorSignatures - returns distinct triples of all functions, errors and imported signatures sorted by selector, signature and kind. It's called under read lock.
+func (s *store) selectorSignatures() []storage.SelectorSignature {
+	type key struct {
+		selector  string
+		signature string
+		kind      storage.SignatureKind
+	}
+	set := make(map[key]struct{})
+	for _, method := range s.methods.byID {
+		set[key{string(method.SignatureID), method.Signature, storage.KindFunction}] = struct{}{}
+	}
+	for _, abiError := range s.errors.byID {
+		set[key{string(abiError.SignatureID), abiError.Signature, storage.KindError}] = struct{}{}
+	}
+	for _, signature := range s.signatures.byID {
+		set[key{string(signature.Selector), signature.Signature, signature.Kind}] = struct{}{}
+	}
+
+	response := make([]storage.SelectorSignature, 0, len(set))
+	for k := range set {
+		response = append(response, storage.SelectorSignature{
+			Selector:  []byte(k.selector),
+			Signature: k.signature,
+			Kind:      k.kind,
+		})
+	}
+	sort.Slice(response, func(i, j int) bool {
+		return compareSelectorSignatures(response[i], response[j]) < 0
+	})
+	return response
+}
+
+func compareSelectorSignatures(a, b storage.SelectorSignature) int {
+	if cmp := bytes.Compare(a.Selector, b.Selector); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case a.Signature < b.Signature:
+		return -1
+	case a.Signature > b.Signature:
+		return 1
+	case a.Kind < b.Kind:
+		return -1
+	case a.Kind > b.Kind:
+		return 1
+	}
+	return 0
+}
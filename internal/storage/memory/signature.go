@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+// Signatures -
+type Signatures struct {
+	*Table[*storage.Signature]
+}
+
+// Import - inserts signatures which are unknown yet and returns count of inserted ones. Signatures already imported with the same kind or declared by functions or errors of indexed contracts are skipped.
+func (s *Signatures) Import(ctx context.Context, signatures []*storage.Signature) (int, error) {
+	if len(signatures) == 0 {
+		return 0, nil
+	}
+
+	var inserted int
+	err := s.store.write(func() error {
+		type key struct {
+			signature string
+			kind      storage.SignatureKind
+		}
+		known := make(map[key]struct{})
+		for _, signature := range s.rows.byID {
+			known[key{signature.Signature, signature.Kind}] = struct{}{}
+		}
+		declared := make(map[[3]string]struct{})
+		for _, method := range s.store.methods.byID {
+			declared[[3]string{string(method.SignatureID), method.Signature, string(storage.KindFunction)}] = struct{}{}
+		}
+		for _, abiError := range s.store.errors.byID {
+			declared[[3]string{string(abiError.SignatureID), abiError.Signature, string(storage.KindError)}] = struct{}{}
+		}
+
+		rows := make([]*storage.Signature, 0, len(signatures))
+		for i := range signatures {
+			row := copyOf(signatures[i]).(*storage.Signature)
+			if row.Kind == "" {
+				row.Kind = storage.KindFunction
+			}
+			k := key{row.Signature, row.Kind}
+			if _, ok := known[k]; ok {
+				continue
+			}
+			if _, ok := declared[[3]string{string(row.Selector), row.Signature, string(row.Kind)}]; ok {
+				continue
+			}
+			known[k] = struct{}{}
+			row.ID = s.rows.nextID()
+			rows = append(rows, row)
+		}
+
+		s.store.apply(func() {
+			for i := range rows {
+				s.rows.byID[rows[i].ID] = rows[i]
+			}
+		})
+		inserted = len(rows)
+		return nil
+	})
+	return inserted, err
+}
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+)
+
+// Table - in-memory realization of storage.Table interface
+type Table[M storage.Model] struct {
+	store *store
+	rows  *rows[M]
+}
+
+func newTable[M storage.Model](s *store, r *rows[M]) *Table[M] {
+	return &Table[M]{s, r}
+}
+
+// Save - inserts row to table and sets its id
+func (t *Table[M]) Save(ctx context.Context, m M) error {
+	return t.store.write(func() error {
+		row, err := t.store.prepareInsert(ctx, m)
+		if err != nil {
+			return err
+		}
+		t.store.apply(func() { t.store.put(row) })
+		return nil
+	})
+}
+
+// Update - replaces table row by id
+func (t *Table[M]) Update(ctx context.Context, m M) error {
+	return t.store.write(func() error {
+		row, err := t.store.prepareUpdate(ctx, m)
+		if err != nil {
+			return err
+		}
+		t.store.apply(func() { t.store.replace(row) })
+		return nil
+	})
+}
+
+// List - returns array of rows
+func (t *Table[M]) List(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]M, error) {
+	var result []M
+	t.store.read(func() {
+		result = paginate(values(t.rows, nil), limit, offset, order)
+	})
+	return result, nil
+}
+
+// GetByID - returns row by id
+func (t *Table[M]) GetByID(ctx context.Context, id uint64) (m M, err error) {
+	t.store.read(func() {
+		row, ok := t.rows.byID[id]
+		if !ok {
+			err = errors.Wrapf(models.ErrNotFound, "%s %d", m.TableName(), id)
+			return
+		}
+		m = copyOf(row).(M)
+	})
+	return
+}
+
+// CursorList - returns array of rows by cursor pagination
+func (t *Table[M]) CursorList(ctx context.Context, id, limit uint64, order storage.SortOrder, cmp storage.Comparator) ([]M, error) {
+	var result []M
+	t.store.read(func() {
+		result = values(t.rows, func(row M) bool {
+			return id == 0 || compare(rowID(row), id, cmp)
+		})
+		result = paginate(result, limit, 0, order)
+	})
+	return result, nil
+}
+
+// Ping - does nothing. Memory storage is always reachable.
+func (t *Table[M]) Ping(ctx context.Context) error {
+	return nil
+}
+
+// IsNoRows - checks errors is storage.ErrNotFound
+func (t *Table[M]) IsNoRows(err error) bool {
+	return errors.Is(err, models.ErrNotFound)
+}
+
+func compare(value, id uint64, cmp storage.Comparator) bool {
+	switch cmp {
+	case storage.ComparatorEq:
+		return value == id
+	case storage.ComparatorNeq:
+		return value != id
+	case storage.ComparatorLt:
+		return value < id
+	case storage.ComparatorLte:
+		return value <= id
+	case storage.ComparatorGt:
+		return value > id
+	case storage.ComparatorGte:
+		return value >= id
+	}
+	return false
+}
+
+// rowID - returns id of row of any table
+func rowID[M any](row M) uint64 {
+	switch m := any(row).(type) {
+	case *models.Metadata:
+		return m.ID
+	case *models.Method:
+		return m.ID
+	case *models.Event:
+		return m.ID
+	case *models.Error:
+		return m.ID
+	case *models.Candidate:
+		return m.ID
+	case *models.Signature:
+		return m.ID
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+var (
+	errClosedTx = errors.New("transaction is closed")
+)
+
+// Transactable - realization of storage.Transactable interface for memory storage
+type Transactable struct {
+	store *store
+}
+
+// BeginTransaction - opens transaction. Transactions and other writes are serialized: the next writer waits until transaction is closed.
+func (t *Transactable) BeginTransaction(ctx context.Context) (storage.Transaction, error) {
+	t.store.writer.Lock()
+	return &Transaction{store: t.store}, nil
+}
+
+// Transaction - buffers writes and applies them at once on flush, so readers never observe part of transaction
+type Transaction struct {
+	store   *store
+	pending []func()
+	closed  bool
+}
+
+// Flush - applies buffered writes
+func (t *Transaction) Flush(ctx context.Context) error {
+	if t.closed {
+		return errClosedTx
+	}
+	t.store.apply(func() {
+		for i := range t.pending {
+			t.pending[i]()
+		}
+	})
+	t.pending = nil
+	return nil
+}
+
+// Add - sets id of model and buffers its insert
+func (t *Transaction) Add(ctx context.Context, model any) error {
+	if t.closed {
+		return errClosedTx
+	}
+	row, err := t.store.prepareInsert(ctx, model)
+	if err != nil {
+		return err
+	}
+	t.pending = append(t.pending, func() { t.store.put(row) })
+	return nil
+}
+
+// Update - buffers replace of row by id
+func (t *Transaction) Update(ctx context.Context, model any) error {
+	if t.closed {
+		return errClosedTx
+	}
+	row, err := t.store.prepareUpdate(ctx, model)
+	if err != nil {
+		return err
+	}
+	t.pending = append(t.pending, func() { t.store.replace(row) })
+	return nil
+}
+
+// BulkSave - sets ids of models and buffers their inserts
+func (t *Transaction) BulkSave(ctx context.Context, models []any) error {
+	for i := range models {
+		if err := t.Add(ctx, models[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exec - raw queries aren't supported by memory storage
+func (t *Transaction) Exec(ctx context.Context, query string, params ...any) (int, error) {
+	return 0, errors.Errorf("raw queries aren't supported by memory storage: %s", query)
+}
+
+// LockHash - checks hash of metadata. Other writers wait until transaction is closed, so hash can't be changed before flush.
+func (t *Transaction) LockHash(ctx context.Context, id uint64, hash []byte) (bool, error) {
+	if t.closed {
+		return false, errClosedTx
+	}
+	var locked bool
+	t.store.read(func() {
+		if m, ok := t.store.metadata.byID[id]; ok {
+			locked = bytes.Equal(m.Hash, hash)
+		}
+	})
+	return locked, nil
+}
+
+// DeleteContent - buffers delete of methods, events and errors of metadata
+func (t *Transaction) DeleteContent(ctx context.Context, metadataID uint64) error {
+	if t.closed {
+		return errClosedTx
+	}
+	t.pending = append(t.pending, func() { t.store.deleteContent(metadataID) })
+	return nil
+}
+
+// Rollback - drops buffered writes
+func (t *Transaction) Rollback(ctx context.Context) error {
+	if t.closed {
+		return errClosedTx
+	}
+	t.pending = nil
+	return nil
+}
+
+// Close - drops writes which weren't flushed and lets the next writer in
+func (t *Transaction) Close(ctx context.Context) error {
+	if t.closed {
+		return errClosedTx
+	}
+	t.pending = nil
+	t.closed = true
+	t.store.writer.Unlock()
+	return nil
+}
+
+// HandleError -
+func (t *Transaction) HandleError(ctx context.Context, err error) error {
+	processorErr := errors.Wrap(err, "transaction error")
+	if err := t.Rollback(ctx); err != nil {
+		return errors.Wrap(processorErr, errors.Wrap(err, "rollback").Error())
+	}
+	return processorErr
+}
@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"sort"
+	"time"
 
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
@@ -10,9 +14,114 @@ import (
 type IMetadata interface {
 	storage.Table[*Metadata]
 
-	GetByAddress(ctx context.Context, address string) (*Metadata, error)
-	GetByMethod(ctx context.Context, signature string, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
-	GetByTopic(ctx context.Context, topic string, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetByAddress(ctx context.Context, address string, columns ...string) (*Metadata, error)
+	GetByMethod(ctx context.Context, signature string, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetByTopic(ctx context.Context, topic string, indexed []IndexedTopic, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetByErrorSelector(ctx context.Context, selector []byte, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetByCodeHash(ctx context.Context, hash []byte, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetBySelectors(ctx context.Context, selectors [][]byte, match MatchType, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	ListByFilter(ctx context.Context, filter MetadataFilter, limit, offset uint64, order storage.SortOrder, columns ...string) ([]*Metadata, error)
+	ListWithoutInterfaces(ctx context.Context, lastID, limit uint64) ([]*Metadata, error)
+	Sample(ctx context.Context, n uint64, method SampleMethod) ([]*Metadata, error)
+	GetByTags(ctx context.Context, tags []string, match MatchType, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	AddTags(ctx context.Context, address string, tags []string) ([]string, error)
+	RemoveTags(ctx context.Context, address string, tags []string) ([]string, error)
+	ListTags(ctx context.Context) ([]TagCount, error)
+	FindSimilar(ctx context.Context, model *Metadata, minSimilarity float64, limit uint64) ([]SimilarMetadata, error)
+	ListByBlockRange(ctx context.Context, from, to uint64, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	GetByCreator(ctx context.Context, creator string, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	ListByFactory(ctx context.Context, factory string, filter MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	ListStale(ctx context.Context, before time.Time, limit uint64) ([]*Metadata, error)
+	ListIncomplete(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*Metadata, error)
+	MarkRefreshed(ctx context.Context, id uint64, at time.Time) error
+	ListCodeUnchecked(ctx context.Context, before time.Time, createdBefore, limit uint64) ([]*Metadata, error)
+	MarkCodeStatus(ctx context.Context, id uint64, status CodeStatus, at time.Time) error
+	LastID(ctx context.Context) (uint64, error)
+	LatestUpdate(ctx context.Context) (time.Time, error)
+}
+
+// MetadataFilter - conditions which are applied to metadata in list and search requests
+type MetadataFilter struct {
+	OnlyComplete bool
+	// UpdatedSince - if it's not zero only metadata written at or after the time are returned
+	UpdatedSince time.Time
+	// AfterID and BeforeID - exclusive bounds of metadata id. Zero means the bound isn't set.
+	AfterID  uint64
+	BeforeID uint64
+	// CompilerVersion - if it's not empty only metadata of contracts compiled by version with the prefix are returned, e.g. `0.8.` or `0.8.19+commit.7dd6d404`
+	CompilerVersion string
+	// IsProxy - if it's set only proxies (true) or only contracts which aren't classified as proxies (false) are returned. Contracts which weren't classified yet are treated as not proxies.
+	IsProxy *bool
+	// WithoutDestroyed - contracts which code was found empty by code check are excluded. Contracts which weren't checked yet are kept.
+	WithoutDestroyed bool
+}
+
+// IndexedTopic - condition on value of indexed event parameter. Event matches if type of parameter at position is one of types or it's dynamic type which value is hashed.
+type IndexedTopic struct {
+	// Position - index of topic: 1, 2 or 3
+	Position int
+	Types    []string
+}
+
+// TagCount - count of contracts marked by the tag
+type TagCount struct {
+	Tag   string
+	Count uint64
+}
+
+// SimilarMetadata - metadata which shares interface with requested contract
+type SimilarMetadata struct {
+	Metadata *Metadata
+	// Similarity - Jaccard index of sets of method selectors and event topics of two contracts: from 0 to 1, where 1 means the same fingerprint
+	Similarity float64
+}
+
+// MatchType - how multiple values of filter should be matched
+type MatchType int
+
+// match types
+const (
+	MatchAny MatchType = iota
+	MatchAll
+)
+
+// SampleMethod - how random set of metadata is selected
+type SampleMethod string
+
+// sample methods
+const (
+	// SampleApproximate - fast sampling of random table pages. Result may be less than requested count and rows of the same page are likely to be returned together.
+	SampleApproximate SampleMethod = "approximate"
+	// SampleExact - uniform sampling of the whole table. It scans the full table, so it's slow on large tables.
+	SampleExact SampleMethod = "exact"
+)
+
+// CodeStatus - result of the last check of contract code by node
+type CodeStatus string
+
+// code statuses
+const (
+	// CodeStatusUnchecked - code of the contract wasn't checked yet
+	CodeStatusUnchecked CodeStatus = ""
+	// CodeStatusDeployed - node returned non-empty code of the contract
+	CodeStatusDeployed CodeStatus = "deployed"
+	// CodeStatusDestroyed - node returned empty code of the contract, e.g. it was self-destructed
+	CodeStatusDestroyed CodeStatus = "destroyed"
+)
+
+// large columns of metadata table
+const (
+	ColumnMetadata   = "metadata"
+	ColumnJSONSchema = "json_schema"
+	// ColumnAbiCompressed - flag of gzipped ABI. Storage selects it along with ABI.
+	ColumnAbiCompressed = "abi_compressed"
+)
+
+// MetadataLightColumns - columns of metadata table except large ABI and JSON schema. It should be updated on adding new fields to Metadata.
+var MetadataLightColumns = []string{
+	"id", "contract", "is_complete", "interfaces", "updated_at", "hash", "creator", "creation_tx", "created_at", "factory", "refreshed_at", "code_hash", "creation_block", "tags", "fingerprint", "source",
+	"compiler_version", "optimizer_enabled", "optimizer_runs", "evm_version", "license", "last_fetch_attempt", "abi_compressed", "is_proxy",
+	"code_status", "code_checked_at",
 }
 
 // Metadata -
@@ -22,12 +131,143 @@ type Metadata struct {
 
 	ID uint64
 
+	// Contract - address of contract in lower case. It's checksummed on output.
 	Contract   string `pg:",unique:metadata_contract,notnull"`
 	Metadata   []byte
 	JSONSchema []byte
+	// IsComplete - ABI declares at least one method or event. ABI with only constructor, fallback, receive or errors is incomplete.
+	IsComplete bool     `pg:"default:false,use_zero"`
+	Interfaces []string `pg:",array"`
+	UpdatedAt  time.Time
+	// Hash - sha256 of entry content. It's computed on every write.
+	Hash []byte
+
+	// Creator, CreationTx, CreatedAt and CreationBlock - information about contract deployment. They are filled if creation resolver is configured, creation time and block are filled only if it has node.
+	Creator       string
+	CreationTx    string
+	CreatedAt     time.Time
+	CreationBlock uint64
+	// Factory - address of contract which deployed the contract
+	Factory string
+	// RefreshedAt - time of the last check of the contract by background refresh. It's not changed on refresh if ABI is the same.
+	RefreshedAt time.Time
+	// CodeHash - keccak256 of runtime bytecode of the contract. It's filled if creation resolver with node is configured.
+	CodeHash []byte
+	// Tags - labels set by curators, e.g. `dex` or `bridge`. They are changed only by AddTags and RemoveTags and are kept on writes of ABI.
+	Tags []string `pg:",array"`
+	// Fingerprint - sha256 of sorted set of method selectors and event topics. Contracts with the same fingerprint have the same interface. It's empty for incomplete metadata.
+	Fingerprint []byte
+	// Source - type of source which returned stored ABI or `manual` if it was put by API. It's empty for contracts indexed before sources were recorded.
+	Source string
+
+	// CompilerVersion, OptimizerEnabled, OptimizerRuns, EVMVersion and License - compilation details and SPDX license of the contract. They are filled only if source of ABI provides them, e.g. Sourcify, and are null otherwise.
+	CompilerVersion  string
+	OptimizerEnabled bool
+	OptimizerRuns    uint64
+	EVMVersion       string `pg:"evm_version"`
+	License          string
+
+	// LastFetchAttempt - time of the last request of ABI of the contract to sources. On ingest it's the time of the request which returned stored ABI: contract isn't saved if request fails, so there is no row to record attempt in. Background refresh sets it after each attempt to refetch stored contract, including failures of sources, too large and invalid ABI, so contracts which sources fail to return repeatedly can be deprioritized.
+	LastFetchAttempt time.Time
+
+	// IsProxy - contract is classified as proxy by its ABI: it declares methods or events of standard proxies, e.g. `implementation()` or `Upgraded(address)`. It's classified on ingest and by interfaces detection.
+	IsProxy bool `pg:"is_proxy,use_zero"`
+
+	// CodeStatus and CodeCheckedAt - result and time of the last check of contract code by background code check. They are changed only by MarkCodeStatus and are kept on writes of ABI.
+	CodeStatus    CodeStatus
+	CodeCheckedAt time.Time
+
+	// ABICompressed - ABI is stored gzipped. It's decompressed on read, so it's always false in models returned by storage. Rows written before compression was enabled keep raw ABI.
+	ABICompressed bool `pg:"abi_compressed,use_zero"`
 }
 
 // TableName -
 func (Metadata) TableName() string {
 	return "metadata"
 }
+
+// BeforeInsert -
+func (m *Metadata) BeforeInsert(ctx context.Context) (context.Context, error) {
+	m.touch()
+	return ctx, nil
+}
+
+// BeforeUpdate -
+func (m *Metadata) BeforeUpdate(ctx context.Context) (context.Context, error) {
+	m.touch()
+	return ctx, nil
+}
+
+// AfterScan - decompresses ABI which is stored gzipped
+func (m *Metadata) AfterScan(ctx context.Context) error {
+	if !m.ABICompressed {
+		return nil
+	}
+	if len(m.Metadata) > 0 {
+		data, err := DecompressABI(m.Metadata)
+		if err != nil {
+			return err
+		}
+		m.Metadata = data
+	}
+	m.ABICompressed = false
+	return nil
+}
+
+func (m *Metadata) touch() {
+	m.UpdatedAt = time.Now().UTC()
+	// hash of compressed entry is computed from raw ABI before compression
+	if !m.ABICompressed {
+		m.Hash = m.ContentHash()
+	}
+}
+
+// ContentHash - computes sha256 of ABI, JSON schema and detected interfaces
+func (m *Metadata) ContentHash() []byte {
+	h := sha256.New()
+	h.Write(m.Metadata)
+	h.Write([]byte{0})
+	h.Write(m.JSONSchema)
+	for i := range m.Interfaces {
+		h.Write([]byte{0})
+		h.Write([]byte(m.Interfaces[i]))
+	}
+	return h.Sum(nil)
+}
+
+// entry prefixes of fingerprint which separate selectors from topics
+const (
+	fingerprintMethod byte = 0x01
+	fingerprintEvent  byte = 0x02
+)
+
+// Fingerprint - computes fingerprint of contract by selectors of its methods and topics of its events. Duplicates are ignored and order doesn't matter. Nil is returned if both sets are empty.
+func Fingerprint(selectors, topics [][]byte) []byte {
+	entries := make([][]byte, 0, len(selectors)+len(topics))
+	entries = appendFingerprintEntries(entries, fingerprintMethod, selectors)
+	entries = appendFingerprintEntries(entries, fingerprintEvent, topics)
+	if len(entries) == 0 {
+		return nil
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i], entries[j]) < 0
+	})
+
+	h := sha256.New()
+	for i := range entries {
+		if i > 0 && bytes.Equal(entries[i], entries[i-1]) {
+			continue
+		}
+		h.Write(entries[i])
+	}
+	return h.Sum(nil)
+}
+
+func appendFingerprintEntries(entries [][]byte, prefix byte, values [][]byte) [][]byte {
+	for i := range values {
+		entry := make([]byte, 0, len(values[i])+1)
+		entry = append(entry, prefix)
+		entries = append(entries, append(entry, values[i]...))
+	}
+	return entries
+}
@@ -1,10 +1,37 @@
 package storage
 
-import "github.com/dipdup-net/indexer-sdk/pkg/storage"
+import (
+	"context"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
 
 // IMethod -
 type IMethod interface {
 	storage.Table[*Method]
+
+	ListSelectorSignatures(ctx context.Context, afterSelector []byte, afterSignature string, afterKind SignatureKind, limit uint64) ([]SelectorSignature, error)
+	SelectorsByMetadata(ctx context.Context, metadataIDs []uint64) ([]*Method, error)
+	CoverageStats(ctx context.Context) (CoverageStats, error)
+}
+
+// CoverageStats - size of reverse lookup corpus: indexed functions, errors and events and imported signatures
+type CoverageStats struct {
+	// Selectors - count of distinct 4-byte selectors of functions and errors
+	Selectors uint64
+	// Topics - count of distinct event topics
+	Topics uint64
+	// Signatures - count of distinct text signatures of functions, errors and events
+	Signatures uint64
+	// CollidingSelectors - count of selectors which have more than one distinct signature
+	CollidingSelectors uint64
+}
+
+// SelectorSignature - pair of selector and its text signature with kind of entry: function or error
+type SelectorSignature struct {
+	Selector  []byte `pg:"signature_id"`
+	Signature string
+	Kind      SignatureKind
 }
 
 // Method -
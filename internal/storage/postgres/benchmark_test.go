@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// sizes of benchmark dataset. They are the same as in benchmarks of memory storage, so results can be compared.
+const (
+	benchContracts       = 1000
+	benchCommonMethods   = 5
+	benchUniqueMethods   = 15
+	benchEventsPerABI    = 5
+	benchErrorsPerABI    = 2
+	benchABISize         = 4096
+	benchSharedSignature = "common0()"
+)
+
+func benchSelector(kind string, i int) []byte {
+	return []byte(fmt.Sprintf("%s%04d", kind, i))
+}
+
+type benchStorage struct {
+	*Storage
+
+	prefix string
+}
+
+func (s *benchStorage) address(i int) string {
+	return fmt.Sprintf("%s%016x", s.prefix, i+1)
+}
+
+// newBenchStorage - seeds test database with contracts which share some methods and declare own events and errors. Benchmark is skipped if test database isn't configured. Contracts have addresses with prefix of the run and are deleted after benchmark.
+func newBenchStorage(b *testing.B) *benchStorage {
+	b.Helper()
+	s := &benchStorage{
+		Storage: newTestStorage(b, Config{}),
+		prefix:  fmt.Sprintf("0x%024x", time.Now().UnixNano()),
+	}
+	ctx := context.Background()
+	b.Cleanup(func() {
+		pattern := s.prefix + "%"
+		for _, query := range []string{
+			`DELETE FROM methods WHERE metadata_id IN (SELECT id FROM metadata WHERE contract LIKE ?)`,
+			`DELETE FROM events WHERE metadata_id IN (SELECT id FROM metadata WHERE contract LIKE ?)`,
+			`DELETE FROM errors WHERE metadata_id IN (SELECT id FROM metadata WHERE contract LIKE ?)`,
+			`DELETE FROM metadata WHERE contract LIKE ?`,
+		} {
+			if _, err := s.DB().ExecContext(ctx, query, pattern); err != nil {
+				b.Errorf("deleting benchmark rows: %v", err)
+			}
+		}
+	})
+
+	tx, err := s.Transactable.BeginTransaction(ctx)
+	if err != nil {
+		b.Fatalf("BeginTransaction: %v", err)
+	}
+	defer func() {
+		if err := tx.Close(ctx); err != nil {
+			b.Errorf("closing transaction: %v", err)
+		}
+	}()
+
+	abi := bytes.Repeat([]byte{' '}, benchABISize)
+	for i := 0; i < benchContracts; i++ {
+		model := &models.Metadata{
+			Contract:   s.address(i),
+			Metadata:   abi,
+			IsComplete: true,
+		}
+		if err := tx.Add(ctx, model); err != nil {
+			b.Fatalf("save metadata: %v", err)
+		}
+
+		data := make([]any, 0, benchCommonMethods+benchUniqueMethods)
+		for j := 0; j < benchCommonMethods+benchUniqueMethods; j++ {
+			method := &models.Method{MetadataID: model.ID}
+			if j < benchCommonMethods {
+				method.Signature = fmt.Sprintf("common%d()", j)
+				method.SignatureID = benchSelector("c", j)
+			} else {
+				method.Signature = fmt.Sprintf("method%d_%d()", i, j)
+				method.SignatureID = benchSelector("m", i*benchUniqueMethods+j)
+			}
+			data = append(data, method)
+		}
+		for j := 0; j < benchEventsPerABI; j++ {
+			data = append(data, &models.Event{
+				MetadataID:  model.ID,
+				Name:        fmt.Sprintf("Event%d", j),
+				Signature:   fmt.Sprintf("Event%d(address,uint256)", j),
+				SignatureID: benchSelector("e", i*benchEventsPerABI+j),
+				Indexed:     []string{"address"},
+			})
+		}
+		for j := 0; j < benchErrorsPerABI; j++ {
+			data = append(data, &models.Error{
+				MetadataID:  model.ID,
+				Name:        fmt.Sprintf("Error%d", j),
+				Signature:   fmt.Sprintf("Error%d()", j),
+				SignatureID: benchSelector("r", i*benchErrorsPerABI+j),
+			})
+		}
+		for j := range data {
+			if err := tx.Add(ctx, data[j]); err != nil {
+				b.Fatalf("save content: %v", err)
+			}
+		}
+	}
+	if err := tx.Flush(ctx); err != nil {
+		b.Fatalf("Flush: %v", err)
+	}
+	return s
+}
+
+func BenchmarkGetByAddress(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	address := s.address(benchContracts / 2)
+
+	for _, bench := range []struct {
+		name    string
+		columns []string
+	}{
+		{"all columns", nil},
+		{"without ABI", []string{"id", "contract"}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := s.Metadata.GetByAddress(ctx, address, bench.columns...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetByMethod(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByMethod(ctx, benchSharedSignature, models.MetadataFilter{}, 10, 0, storage.SortOrderDesc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByTopic(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	topic := hexutil.Encode(benchSelector("e", benchContracts))
+	indexed := []models.IndexedTopic{{Position: 1, Types: []string{"address"}}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByTopic(ctx, topic, indexed, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByTopics(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	topics := make([][]byte, 10)
+	for i := range topics {
+		topics[i] = benchSelector("e", i*benchEventsPerABI*50)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Events.GetByTopics(ctx, topics, 10); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetByErrorSelector(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	selector := benchSelector("r", benchContracts)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if response, err := s.Metadata.GetByErrorSelector(ctx, selector, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+			b.Fatal(err)
+		} else if len(response) == 0 {
+			b.Fatal("nothing is found")
+		}
+	}
+}
+
+func BenchmarkGetBySelectors(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+	selectors := [][]byte{benchSelector("c", 0), benchSelector("c", 1), benchSelector("m", benchUniqueMethods+1)}
+
+	for name, match := range map[string]models.MatchType{"any": models.MatchAny, "all": models.MatchAll} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if response, err := s.Metadata.GetBySelectors(ctx, selectors, match, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc); err != nil {
+					b.Fatal(err)
+				} else if len(response) == 0 {
+					b.Fatal("nothing is found")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParallelReads(b *testing.B) {
+	s := newBenchStorage(b)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			if _, err := s.Metadata.GetByAddress(ctx, s.address(i%benchContracts)); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/go-pg/pg/v10"
+)
+
+// Candidates -
+type Candidates struct {
+	*Table[*storage.Candidate]
+}
+
+// NewCandidates -
+func NewCandidates(db *pg.DB) *Candidates {
+	return &Candidates{
+		Table: NewTable[*storage.Candidate](db),
+	}
+}
+
+// GetByContract - returns all ABI candidates of the contract
+func (c *Candidates) GetByContract(ctx context.Context, contract string) ([]*storage.Candidate, error) {
+	var candidates []*storage.Candidate
+	err := c.DB().ModelContext(ctx, &candidates).
+		Where("contract = ?", contract).
+		Order("id asc").
+		Select(&candidates)
+	return candidates, err
+}
+
+// Upsert - saves candidates replacing ABI of the same contract and source
+func (c *Candidates) Upsert(ctx context.Context, candidates ...*storage.Candidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	for i := range candidates {
+		hash := sha256.Sum256(candidates[i].Metadata)
+		candidates[i].Hash = hash[:]
+		candidates[i].UpdatedAt = now
+	}
+	_, err := c.DB().ModelContext(ctx, &candidates).
+		OnConflict("(contract, source) DO UPDATE").
+		Set("metadata = EXCLUDED.metadata").
+		Set("hash = EXCLUDED.hash").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("id").
+		Insert()
+	return err
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import "github.com/dipdup-net/go-lib/config"
+
+// Config - storage settings which are not covered by database connection config
+type Config struct {
+	SlowQueryThreshold int              `yaml:"slow_query_threshold" validate:"omitempty,min=0"`
+	Pool               *PoolConfig      `yaml:"pool" validate:"omitempty"`
+	Replica            *config.Database `yaml:"replica" validate:"omitempty"`
+	// PreparedStatements - count of prepared copies of each hot path query (metadata by address, method signature and topic). Each copy holds connection of pool. 0 - queries aren't prepared.
+	PreparedStatements int `yaml:"prepared_statements" validate:"omitempty,min=0"`
+	// Retry - retries of read queries of gRPC server after transient errors. Reads fail on the first error if it's not set.
+	Retry *RetryConfig `yaml:"retry" validate:"omitempty"`
+	// CompressABI - ABI of metadata is written gzipped. It's decompressed on read regardless of the setting.
+	CompressABI bool `yaml:"compress_abi"`
+}
+
+// RetryConfig - settings of retries of read queries. Backoff is in milliseconds. Zero value means default.
+type RetryConfig struct {
+	// MaxAttempts - maximum count of query attempts including the first one. Default: 3
+	MaxAttempts int `yaml:"max_attempts" validate:"omitempty,min=1"`
+	// InitialBackoff - delay before the first retry. It's doubled on every retry. Default: 50
+	InitialBackoff int `yaml:"initial_backoff" validate:"omitempty,min=1"`
+	// MaxBackoff - maximum delay between retries. Default: 1000
+	MaxBackoff int `yaml:"max_backoff" validate:"omitempty,min=1"`
+}
+
+// PoolConfig - connection pool settings. Durations are in seconds. Zero value means default of driver.
+type PoolConfig struct {
+	MaxConnections        int `yaml:"max_connections" validate:"omitempty,min=1"`
+	MinIdleConnections    int `yaml:"min_idle_connections" validate:"omitempty,min=0"`
+	MaxConnectionLifetime int `yaml:"max_connection_lifetime" validate:"omitempty,min=0"`
+	AcquireTimeout        int `yaml:"acquire_timeout" validate:"omitempty,min=0"`
+	IdleTimeout           int `yaml:"idle_timeout" validate:"omitempty,min=0"`
+}
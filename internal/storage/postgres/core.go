@@ -2,86 +2,323 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	models "github.com/dipdup-net/abi-indexer/internal/storage"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/go-lib/prometheus"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
-	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
 	"github.com/go-pg/pg/v10"
 	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
 )
 
 // Storage -
 type Storage struct {
-	*postgres.Storage
+	Transactable models.Transactable
 
 	Metadata models.IMetadata
 	Methods  models.IMethod
 	Events   models.IEvent
+	Errors   models.IError
+	// Candidates - ABI of contracts received from each source. It's written only if candidates are enabled in metadata config.
+	Candidates models.ICandidate
+	// Signatures - textual signatures imported without ABI
+	Signatures models.ISignature
+
+	// ReadMetadata, ReadMethods and ReadEvents - read-only handles. They use read replica if it's configured and primary otherwise. Their queries are retried after transient errors if retry is configured.
+	ReadMetadata models.IMetadata
+	ReadMethods  models.IMethod
+	ReadEvents   models.IEvent
+
+	db       *pg.DB
+	replica  *pg.DB
+	prepared []*preparedQueries
+	wg       *sync.WaitGroup
 }
 
 // Create -
-func Create(ctx context.Context, cfg config.Database) (*Storage, error) {
-	strg, err := postgres.Create(ctx, cfg, initDatabase)
+func Create(ctx context.Context, cfg config.Database, storageCfg Config, metrics *prometheus.Service) (*Storage, error) {
+	opts, err := options(cfg, storageCfg)
 	if err != nil {
-		return nil, nil
+		return nil, err
+	}
+	hooks := []pg.QueryHook{&logQueryHook{}}
+	if storageCfg.SlowQueryThreshold > 0 {
+		hooks = append(hooks, newSlowQueryHook(storageCfg.SlowQueryThreshold, metrics))
+	}
+	if metrics != nil {
+		hooks = append(hooks, newDurationHook())
+	}
+
+	db := connect(ctx, opts, hooks)
+
+	if err := initDatabase(ctx, db); err != nil {
+		return nil, err
+	}
+
+	metadata := NewMetadata(db, storageCfg.CompressABI)
+	strg := &Storage{
+		Transactable: NewTransactable(db, storageCfg.CompressABI),
+		Metadata:     metadata,
+		Events:       NewEvents(db),
+		Errors:       NewErrors(db),
+		Methods:      NewMethods(db),
+		Candidates:   NewCandidates(db),
+		Signatures:   NewSignatures(db),
+		db:           db,
+		wg:           new(sync.WaitGroup),
+	}
+	strg.usePrepared(metadata, storageCfg.PreparedStatements, metrics)
+	strg.ReadMetadata = strg.Metadata
+	strg.ReadMethods = strg.Methods
+	strg.ReadEvents = strg.Events
+
+	if storageCfg.Replica != nil {
+		replicaOpts, err := options(*storageCfg.Replica, storageCfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "replica")
+		}
+		strg.replica = connect(ctx, replicaOpts, hooks)
+		replicaMetadata := NewMetadata(strg.replica, storageCfg.CompressABI)
+		strg.usePrepared(replicaMetadata, storageCfg.PreparedStatements, metrics)
+		strg.ReadMetadata = replicaMetadata
+		strg.ReadMethods = NewMethods(strg.replica)
+		strg.ReadEvents = NewEvents(strg.replica)
+	}
+
+	if storageCfg.Retry != nil {
+		r := newRetrier(*storageCfg.Retry, metrics)
+		strg.ReadMetadata = &retryMetadata{strg.ReadMetadata, r}
+		strg.ReadMethods = &retryMethods{strg.ReadMethods, r}
+		strg.ReadEvents = &retryEvents{strg.ReadEvents, r}
+	}
+
+	if metrics != nil {
+		strg.wg.Add(1)
+		go strg.reportPoolStats(ctx, metrics)
+	}
+
+	return strg, nil
+}
+
+func connect(ctx context.Context, opts *pg.Options, hooks []pg.QueryHook) *pg.DB {
+	db := pg.Connect(opts)
+	database.Wait(ctx, db, time.Second*5)
+	for i := range hooks {
+		db.AddQueryHook(hooks[i])
+	}
+	return db
+}
+
+func options(cfg config.Database, storageCfg Config) (*pg.Options, error) {
+	if cfg.Kind != config.DBKindPostgres {
+		return nil, errors.Wrap(database.ErrUnsupportedDatabaseType, cfg.Kind)
+	}
+
+	var opts *pg.Options
+	if cfg.Path != "" {
+		parsed, err := pg.ParseURL(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		opts = parsed
+	} else {
+		opts = &pg.Options{
+			Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			User:     cfg.User,
+			Password: cfg.Password,
+			Database: cfg.Database,
+		}
 	}
 
-	return &Storage{
-		Storage:  strg,
-		Metadata: NewMetadata(strg.Connection()),
-		Events:   NewEvents(strg.Connection()),
-		Methods:  NewMethods(strg.Connection()),
-	}, nil
+	if pool := storageCfg.Pool; pool != nil {
+		opts.PoolSize = pool.MaxConnections
+		opts.MinIdleConns = pool.MinIdleConnections
+		opts.MaxConnAge = time.Duration(pool.MaxConnectionLifetime) * time.Second
+		opts.PoolTimeout = time.Duration(pool.AcquireTimeout) * time.Second
+		opts.IdleTimeout = time.Duration(pool.IdleTimeout) * time.Second
+	}
+	return opts, nil
+}
+
+// usePrepared - enables prepared statements of hot paths if size is positive. Statements are prepared on first use, so they are created after migrations.
+func (s *Storage) usePrepared(metadata *Metadata, size int, metrics *prometheus.Service) {
+	if size <= 0 {
+		return
+	}
+	if metrics != nil && len(s.prepared) == 0 {
+		metrics.RegisterCounter(MetricPreparedQueries, "count of hot path storage queries by query and execution kind", "query", "result")
+	}
+	metadata.prepared = newPreparedQueries(metadata.DB(), size, metrics)
+	s.prepared = append(s.prepared, metadata.prepared)
 }
 
-func initDatabase(ctx context.Context, conn *database.PgGo) error {
-	if _, err := conn.DB().ExecContext(ctx, "create role posgrest_anon nologin"); err != nil {
+// Close - closes storage
+func (s *Storage) Close() error {
+	for i := range s.prepared {
+		s.prepared[i].Close()
+	}
+	if err := s.db.Close(); err != nil {
+		return err
+	}
+	if s.replica != nil {
+		if err := s.replica.Close(); err != nil {
+			return err
+		}
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// DB - returns Postgres connection
+func (s *Storage) DB() *pg.DB {
+	return s.db
+}
+
+func initDatabase(ctx context.Context, db *pg.DB) error {
+	if _, err := db.ExecContext(ctx, "create role posgrest_anon nologin"); err != nil {
 		if !strings.Contains(err.Error(), "already exists") {
-			if err := conn.Close(); err != nil {
+			if err := db.Close(); err != nil {
 				return err
 			}
 			return err
 		}
 	}
 
-	if _, err := conn.DB().ExecContext(ctx, "grant usage on schema public to posgrest_anon;"); err != nil {
-		if err := conn.Close(); err != nil {
+	if _, err := db.ExecContext(ctx, "grant usage on schema public to posgrest_anon;"); err != nil {
+		if err := db.Close(); err != nil {
 			return err
 		}
 		return err
 	}
 
 	for _, data := range []storage.Model{
-		&models.Metadata{}, &models.Method{}, &models.Event{},
+		&models.Metadata{}, &models.Method{}, &models.Event{}, &models.Error{}, &models.Candidate{}, &models.Signature{},
 	} {
-		if err := conn.DB().WithContext(ctx).Model(data).CreateTable(&orm.CreateTableOptions{
+		if err := db.WithContext(ctx).Model(data).CreateTable(&orm.CreateTableOptions{
 			IfNotExists: true,
 		}); err != nil {
-			if err := conn.Close(); err != nil {
+			if err := db.Close(); err != nil {
 				return err
 			}
 			return err
 		}
 
-		if _, err := conn.DB().
+		if _, err := db.
 			WithParam("SCHEMA", pg.Ident("public")).
 			WithParam("NAME", pg.Ident(data.TableName())).
 			ModelContext(ctx, data).
 			Exec("grant select on ?SCHEMA.?NAME to posgrest_anon;"); err != nil {
-			if err := conn.Close(); err != nil {
+			if err := db.Close(); err != nil {
 				return err
 			}
 			return err
 		}
 	}
-	return createIndices(ctx, conn)
+	if err := migrate(ctx, db); err != nil {
+		return err
+	}
+	return createIndices(ctx, db)
 }
 
-func createIndices(ctx context.Context, conn *database.PgGo) error {
-	return conn.DB().RunInTransaction(ctx, func(tx *pg.Tx) error {
+// migrations - queries which add new columns to tables created by previous versions. Each query should be idempotent. Data migrations which scan whole tables are executed once: they are guarded by their record in `schema_migrations`.
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS schema_migrations (name text PRIMARY KEY, applied_at timestamptz NOT NULL DEFAULT now())`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS is_complete boolean`,
+	`UPDATE metadata SET is_complete = (EXISTS (SELECT 1 FROM methods WHERE methods.metadata_id = metadata.id) OR EXISTS (SELECT 1 FROM events WHERE events.metadata_id = metadata.id)) WHERE is_complete IS NULL`,
+	`ALTER TABLE metadata ALTER COLUMN is_complete SET DEFAULT false`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS interfaces text[]`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS updated_at timestamptz`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS hash bytea`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS creator text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS creation_tx text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS created_at timestamptz`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS factory text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS refreshed_at timestamptz`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS code_hash bytea`,
+	// rows were backfilled as complete by length of ABI, so constructor-only ABI was complete unlike ABI of the same kind indexed later
+	`DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'is_complete_entries') THEN
+			UPDATE metadata SET is_complete = (EXISTS (SELECT 1 FROM methods WHERE methods.metadata_id = metadata.id) OR EXISTS (SELECT 1 FROM events WHERE events.metadata_id = metadata.id));
+			INSERT INTO schema_migrations (name) VALUES ('is_complete_entries');
+		END IF;
+	END $$`,
+	// overloaded methods and events were stored with names suffixed by index, e.g. `foo0`
+	`DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'overloaded_names') THEN
+			UPDATE methods SET name = split_part(signature, '(', 1) WHERE name <> split_part(signature, '(', 1);
+			UPDATE events SET name = split_part(signature, '(', 1) WHERE name <> split_part(signature, '(', 1);
+			INSERT INTO schema_migrations (name) VALUES ('overloaded_names');
+		END IF;
+	END $$`,
+	`ALTER TABLE events ADD COLUMN IF NOT EXISTS indexed text[]`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS creation_block bigint`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS tags text[]`,
+	// contracts were stored in case returned by source. One row is kept per address: complete one, then the most recently updated one. Other variants are deleted with their methods, events and errors, so lower case doesn't violate unique constraint.
+	`DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'lowercase_contracts') THEN
+			CREATE TEMPORARY TABLE duplicate_contracts ON COMMIT DROP AS
+				SELECT id FROM (
+					SELECT id, row_number() OVER (
+						PARTITION BY lower(contract)
+						ORDER BY is_complete DESC NULLS LAST, updated_at DESC NULLS LAST, id DESC
+					) AS position FROM metadata
+				) AS variants WHERE position > 1;
+			DELETE FROM methods WHERE metadata_id IN (SELECT id FROM duplicate_contracts);
+			DELETE FROM events WHERE metadata_id IN (SELECT id FROM duplicate_contracts);
+			DELETE FROM errors WHERE metadata_id IN (SELECT id FROM duplicate_contracts);
+			DELETE FROM metadata WHERE id IN (SELECT id FROM duplicate_contracts);
+			UPDATE metadata SET contract = lower(contract) WHERE contract <> lower(contract);
+			INSERT INTO schema_migrations (name) VALUES ('lowercase_contracts');
+		END IF;
+	END $$`,
+	// fingerprints of stored contracts are computed once when the column is added, the same way as by storage.Fingerprint
+	`DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'metadata' AND column_name = 'fingerprint') THEN
+			ALTER TABLE metadata ADD COLUMN fingerprint bytea;
+			UPDATE metadata SET fingerprint = fp.value FROM (
+				SELECT metadata_id, sha256(string_agg(entry, ''::bytea ORDER BY entry)) AS value FROM (
+					SELECT metadata_id, '\x01'::bytea || signature_id AS entry FROM methods WHERE metadata_id IS NOT NULL
+					UNION
+					SELECT metadata_id, '\x02'::bytea || signature_id AS entry FROM events WHERE metadata_id IS NOT NULL
+				) AS entries GROUP BY metadata_id
+			) AS fp WHERE metadata.id = fp.metadata_id;
+		END IF;
+	END $$`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS source text`,
+	// signatures were unique regardless of kind
+	`ALTER TABLE signatures ADD COLUMN IF NOT EXISTS kind text NOT NULL DEFAULT 'function'`,
+	`ALTER TABLE signatures DROP CONSTRAINT IF EXISTS signatures_signature_key`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS compiler_version text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS optimizer_enabled boolean`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS optimizer_runs bigint`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS evm_version text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS license text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS last_fetch_attempt timestamptz`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS abi_compressed boolean`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS is_proxy boolean`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS code_status text`,
+	`ALTER TABLE metadata ADD COLUMN IF NOT EXISTS code_checked_at timestamptz`,
+}
+
+func migrate(ctx context.Context, db *pg.DB) error {
+	return db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		for _, query := range migrations {
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func createIndices(ctx context.Context, db *pg.DB) error {
+	return db.RunInTransaction(ctx, func(tx *pg.Tx) error {
 		// Methods
 		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS methods_metadata_id ON methods (metadata_id)`); err != nil {
 			return err
@@ -89,6 +326,64 @@ func createIndices(ctx context.Context, conn *database.PgGo) error {
 		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS methods_signature_id ON methods (signature_id)`); err != nil {
 			return err
 		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS methods_signature_id_signature ON methods (signature_id, signature)`); err != nil {
+			return err
+		}
+
+		// Metadata
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_interfaces ON metadata USING GIN (interfaces)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_creator_created_at ON metadata (creator, created_at)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_factory ON metadata (factory) WHERE factory IS NOT NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_refreshed_at ON metadata ((coalesce(refreshed_at, updated_at)))`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_code_hash ON metadata (code_hash) WHERE code_hash IS NOT NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_creation_block ON metadata (creation_block, id) WHERE creation_block IS NOT NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_tags ON metadata USING GIN (tags)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_updated_at ON metadata (updated_at)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_fingerprint ON metadata (fingerprint) WHERE fingerprint IS NOT NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_compiler_version ON metadata (compiler_version text_pattern_ops) WHERE compiler_version IS NOT NULL`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_proxy ON metadata (id) WHERE is_proxy = true`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_code_checked_at ON metadata (code_checked_at NULLS FIRST, id)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_destroyed ON metadata (id) WHERE code_status = 'destroyed'`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS metadata_incomplete ON metadata ((coalesce(last_fetch_attempt, updated_at)), id) WHERE is_complete = false`); err != nil {
+			return err
+		}
+
+		// Errors
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS errors_metadata_id ON errors (metadata_id)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS errors_signature_id ON errors (signature_id)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS errors_signature_id_signature ON errors (signature_id, signature)`); err != nil {
+			return err
+		}
 
 		// Events
 		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS events_metadata_id ON events (metadata_id)`); err != nil {
@@ -98,6 +393,19 @@ func createIndices(ctx context.Context, conn *database.PgGo) error {
 			return err
 		}
 
+		// Signatures
+		if _, err := tx.ExecContext(ctx, `CREATE UNIQUE INDEX IF NOT EXISTS signatures_signature_kind ON signatures (signature, kind)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS signatures_selector_signature ON signatures (selector, signature)`); err != nil {
+			return err
+		}
+
+		// Candidates
+		if _, err := tx.ExecContext(ctx, `CREATE UNIQUE INDEX IF NOT EXISTS abi_candidates_contract_source ON abi_candidates (contract, source)`); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/go-pg/pg/v10"
+)
+
+// Errors -
+type Errors struct {
+	*Table[*storage.Error]
+}
+
+// NewErrors -
+func NewErrors(db *pg.DB) *Errors {
+	return &Errors{
+		Table: NewTable[*storage.Error](db),
+	}
+}
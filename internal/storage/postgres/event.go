@@ -1,19 +1,38 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/dipdup-net/abi-indexer/internal/storage"
-	"github.com/dipdup-net/go-lib/database"
-	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/go-pg/pg/v10"
 )
 
 // Events -
 type Events struct {
-	*postgres.Table[*storage.Event]
+	*Table[*storage.Event]
 }
 
 // NewEvents -
-func NewEvents(db *database.PgGo) *Events {
+func NewEvents(db *pg.DB) *Events {
 	return &Events{
-		Table: postgres.NewTable[*storage.Event](db),
+		Table: NewTable[*storage.Event](db),
 	}
 }
+
+// GetByTopics - returns events matching any of topics by single query. Up to limit events are returned for each topic. Result is ordered by topic.
+func (e *Events) GetByTopics(ctx context.Context, topics [][]byte, limit uint64) ([]storage.TopicEvent, error) {
+	var response []storage.TopicEvent
+	_, err := e.DB().QueryContext(ctx, &response, `
+		SELECT signature_id, contract, name, signature, anonymous, indexed FROM (
+			SELECT events.signature_id, metadata.contract, events.name, events.signature, events.anonymous, events.indexed,
+				row_number() OVER (PARTITION BY events.signature_id ORDER BY events.id) AS rn
+			FROM events
+			JOIN metadata ON metadata.id = events.metadata_id
+			WHERE events.signature_id IN (?)
+		) AS matches
+		WHERE rn <= ?
+		ORDER BY signature_id, rn`,
+		pg.In(topics), limit,
+	)
+	return response, err
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/metrics"
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/go-pg/pg/v10"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricSlowQueries   = "abi_indexer_slow_queries_total"
+	MetricQueryDuration = "abi_indexer_storage_query_duration_seconds"
+)
+
+type logQueryHook struct{}
+
+// BeforeQuery -
+func (h *logQueryHook) BeforeQuery(ctx context.Context, event *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+// AfterQuery -
+func (h *logQueryHook) AfterQuery(ctx context.Context, event *pg.QueryEvent) error {
+	if zerolog.GlobalLevel() > zerolog.TraceLevel {
+		return nil
+	}
+
+	query, err := event.FormattedQuery()
+	if err != nil {
+		log.Trace().Err(err).Msg("formatting query")
+		return nil
+	}
+
+	if event.Err != nil {
+		log.Trace().Msgf("[%d ms] %s : %s", time.Since(event.StartTime).Milliseconds(), event.Err.Error(), string(query))
+	} else {
+		log.Trace().Msgf("[%d ms] %d rows | %s", time.Since(event.StartTime).Milliseconds(), event.Result.RowsReturned(), string(query))
+	}
+	return nil
+}
+
+type slowQueryHook struct {
+	threshold time.Duration
+	metrics   *prometheus.Service
+}
+
+func newSlowQueryHook(thresholdMs int, metrics *prometheus.Service) *slowQueryHook {
+	if metrics != nil {
+		metrics.RegisterCounter(MetricSlowQueries, "count of storage queries exceeded slow query threshold", "method")
+	}
+	return &slowQueryHook{
+		threshold: time.Duration(thresholdMs) * time.Millisecond,
+		metrics:   metrics,
+	}
+}
+
+// BeforeQuery -
+func (h *slowQueryHook) BeforeQuery(ctx context.Context, event *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+// AfterQuery -
+func (h *slowQueryHook) AfterQuery(ctx context.Context, event *pg.QueryEvent) error {
+	duration := time.Since(event.StartTime)
+	if duration < h.threshold {
+		return nil
+	}
+
+	method := models.MethodFromContext(ctx)
+	// error of hook is returned as error of query, so query which can't be formatted for log isn't failed
+	query, err := event.FormattedQuery()
+	if err != nil {
+		log.Err(err).Str("method", method).Msg("formatting slow query")
+		return nil
+	}
+
+	if h.metrics != nil {
+		h.metrics.IncrementCounter(MetricSlowQueries, map[string]string{
+			"method": method,
+		})
+	}
+	log.Warn().
+		Str("method", method).
+		Int64("duration_ms", duration.Milliseconds()).
+		Bytes("query", query).
+		Msg("slow query")
+	return nil
+}
+
+type durationHook struct {
+	histogram *prom.HistogramVec
+}
+
+func newDurationHook() *durationHook {
+	return &durationHook{
+		histogram: metrics.NewLatencyHistogram(MetricQueryDuration, "duration of storage queries by API method which initiated the query", "method"),
+	}
+}
+
+// BeforeQuery -
+func (h *durationHook) BeforeQuery(ctx context.Context, event *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+// AfterQuery -
+func (h *durationHook) AfterQuery(ctx context.Context, event *pg.QueryEvent) error {
+	h.histogram.WithLabelValues(models.MethodFromContext(ctx)).Observe(time.Since(event.StartTime).Seconds())
+	return nil
+}
@@ -2,43 +2,106 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	models "github.com/dipdup-net/abi-indexer/internal/storage"
-	"github.com/dipdup-net/go-lib/database"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
 )
 
 // Metadata -
 type Metadata struct {
-	*postgres.Table[*models.Metadata]
+	*Table[*models.Metadata]
+
+	// prepared - prepared statements of hot paths. Nil if they are disabled.
+	prepared *preparedQueries
+	// compress - ABI is written gzipped
+	compress bool
 }
 
-// NewMetadata -
-func NewMetadata(db *database.PgGo) *Metadata {
+// NewMetadata - creates metadata table. If compress is set, ABI is written gzipped.
+func NewMetadata(db *pg.DB, compress bool) *Metadata {
 	return &Metadata{
-		Table: postgres.NewTable[*models.Metadata](db),
+		Table:    NewTable[*models.Metadata](db),
+		compress: compress,
+	}
+}
+
+// Save - inserts metadata and returns id. ABI is compressed like by transaction.
+func (m *Metadata) Save(ctx context.Context, model *models.Metadata) error {
+	return withCompressedABI(m.compress, model, func() error {
+		return m.Table.Save(ctx, model)
+	})
+}
+
+// Update - updates metadata by primary key. ABI is compressed like by transaction.
+func (m *Metadata) Update(ctx context.Context, model *models.Metadata) error {
+	return withCompressedABI(m.compress, model, func() error {
+		return m.Table.Update(ctx, model)
+	})
+}
+
+// projection - returns columns which should be selected for requested ones. Compression flag is selected along with ABI, otherwise gzipped ABI isn't decompressed on scan.
+func projection(columns []string) []string {
+	for i := range columns {
+		if columns[i] == models.ColumnAbiCompressed {
+			return columns
+		}
 	}
+	for i := range columns {
+		if columns[i] == models.ColumnMetadata {
+			return append(columns[:len(columns):len(columns)], models.ColumnAbiCompressed)
+		}
+	}
+	return columns
 }
 
-// GetByAddress -
-func (m *Metadata) GetByAddress(ctx context.Context, address string) (*models.Metadata, error) {
+// GetByAddress - returns metadata of contract. Address is case-insensitive. If columns are passed only they are selected.
+func (m *Metadata) GetByAddress(ctx context.Context, address string, columns ...string) (*models.Metadata, error) {
 	var response models.Metadata
-	err := m.DB().ModelContext(ctx, &response).Where("contract = ?", address).First()
-	return &response, err
+	query := m.DB().ModelContext(ctx, &response).Where("contract = ?", strings.ToLower(address))
+	if len(columns) > 0 {
+		query.Column(projection(columns)...)
+	}
+
+	var err error
+	if prepared := m.prepared.metadataByAddress(); prepared != nil && len(columns) == 0 {
+		err = prepared.QueryOne(ctx, &response, query.First, strings.ToLower(address))
+	} else {
+		err = query.First()
+	}
+	if err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, errors.Wrap(models.ErrNotFound, address)
+		}
+		return nil, err
+	}
+	return &response, nil
 }
 
 // GetByMethod -
-func (m *Metadata) GetByMethod(ctx context.Context, signature string, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+func (m *Metadata) GetByMethod(ctx context.Context, signature string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
 	var methods []*models.Method
 	query := m.DB().ModelContext(ctx, &methods).
 		Relation("Metadata").
 		Where("signature = ?", signature).
 		Where("metadata_id is not null")
 
+	applyFilter(query, filter)
 	postgres.Pagination(query, limit, offset, order)
 
-	if err := query.Select(); err != nil {
+	var err error
+	if prepared := m.prepared.metadataByMethod(filter, order); prepared != nil {
+		err = prepared.Query(ctx, &methods, func() error { return query.Select() }, signature, pageLimit(limit), offset)
+	} else {
+		err = query.Select()
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -49,17 +112,32 @@ func (m *Metadata) GetByMethod(ctx context.Context, signature string, limit, off
 	return response, nil
 }
 
-// GetByTopic -
-func (m *Metadata) GetByTopic(ctx context.Context, topic string, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+// GetByTopic - returns metadata which declares event with the topic. If indexed conditions are passed, event should have indexed parameters of matching types.
+func (m *Metadata) GetByTopic(ctx context.Context, topic string, indexed []models.IndexedTopic, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
 	var events []*models.Event
 	query := m.DB().ModelContext(ctx, &events).
 		Relation("Metadata").
 		Where("signature_id = ?", topic).
 		Where("metadata_id is not null")
 
+	for i := range indexed {
+		pos := indexed[i].Position
+		query.Where(
+			"(event.indexed[?] = ANY(?) OR event.indexed[?] IN ('string', 'bytes') OR event.indexed[?] LIKE '%]' OR event.indexed[?] LIKE '(%')",
+			pos, pg.Array(indexed[i].Types), pos, pos, pos,
+		)
+	}
+
+	applyFilter(query, filter)
 	postgres.Pagination(query, limit, offset, order)
 
-	if err := query.Select(); err != nil {
+	var err error
+	if prepared := m.prepared.metadataByTopic(filter, order); prepared != nil && len(indexed) == 0 {
+		err = prepared.Query(ctx, &events, func() error { return query.Select() }, topic, pageLimit(limit), offset)
+	} else {
+		err = query.Select()
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -69,3 +147,450 @@ func (m *Metadata) GetByTopic(ctx context.Context, topic string, limit, offset u
 	}
 	return response, nil
 }
+
+// GetByErrorSelector - returns metadata which declares custom error with the selector
+func (m *Metadata) GetByErrorSelector(ctx context.Context, selector []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var abiErrors []*models.Error
+	query := m.DB().ModelContext(ctx, &abiErrors).
+		Relation("Metadata").
+		Where("signature_id = ?", selector).
+		Where("metadata_id is not null")
+
+	applyFilter(query, filter)
+	postgres.Pagination(query, limit, offset, order)
+
+	if err := query.Select(); err != nil {
+		return nil, err
+	}
+
+	response := make([]*models.Metadata, len(abiErrors))
+	for i := range abiErrors {
+		response[i] = abiErrors[i].Metadata
+	}
+	return response, nil
+}
+
+// GetByCodeHash - returns metadata of contracts with the same runtime bytecode hash
+func (m *Metadata) GetByCodeHash(ctx context.Context, hash []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Where("code_hash = ?", hash)
+	applyFilter(query, filter)
+
+	postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// GetBySelectors -
+func (m *Metadata) GetBySelectors(ctx context.Context, selectors [][]byte, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	subQuery := m.DB().ModelContext(ctx, (*models.Method)(nil)).
+		Column("metadata_id").
+		WhereIn("signature_id IN (?)", selectors).
+		Group("metadata_id")
+
+	if match == models.MatchAll {
+		subQuery.Having("count(distinct signature_id) = ?", len(selectors))
+	}
+
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).Where("id IN (?)", subQuery)
+	applyFilter(query, filter)
+
+	postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// ListByFilter - returns metadata matching filter. If columns are passed only they are selected.
+func (m *Metadata) ListByFilter(ctx context.Context, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder, columns ...string) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response)
+	if len(columns) > 0 {
+		query.Column(projection(columns)...)
+	}
+	applyFilter(query, filter)
+
+	postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// ListWithoutInterfaces - returns metadata with id greater than lastID which interfaces or proxy classification were never detected
+func (m *Metadata) ListWithoutInterfaces(ctx context.Context, lastID, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	err := m.DB().ModelContext(ctx, &response).
+		Where("interfaces is null OR is_proxy is null").
+		Where("id > ?", lastID).
+		Order("id asc").
+		Limit(int(limit)).
+		Select()
+	return response, err
+}
+
+// Sample - returns pseudo-random set of n metadata
+func (m *Metadata) Sample(ctx context.Context, n uint64, method models.SampleMethod) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+
+	if method == models.SampleApproximate {
+		percent, err := m.samplePercent(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		if percent > 0 {
+			_, err = m.DB().QueryContext(ctx, &response, `SELECT * FROM metadata TABLESAMPLE SYSTEM (?) ORDER BY random() LIMIT ?`, percent, n)
+			return response, err
+		}
+	}
+
+	err := m.DB().ModelContext(ctx, &response).OrderExpr("random()").Limit(int(n)).Select()
+	return response, err
+}
+
+// samplePercent - returns percent of table pages which should be sampled to receive about n rows. It's computed from planner's estimation of rows count. Zero is returned if table was never analyzed.
+func (m *Metadata) samplePercent(ctx context.Context, n uint64) (float64, error) {
+	var estimate float64
+	if _, err := m.DB().QueryOneContext(ctx, pg.Scan(&estimate), `SELECT reltuples FROM pg_class WHERE relname = ?`, models.Metadata{}.TableName()); err != nil {
+		return 0, err
+	}
+	if estimate <= 0 {
+		return 0, nil
+	}
+
+	// sample twice more rows than requested because pages are filled unevenly
+	percent := float64(n) * 200 / estimate
+	if percent > 100 {
+		percent = 100
+	}
+	return percent, nil
+}
+
+// GetByTags - returns metadata marked by any or all of tags
+func (m *Metadata) GetByTags(ctx context.Context, tags []string, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response)
+	if match == models.MatchAll {
+		query.Where("tags @> ?", pg.Array(tags))
+	} else {
+		query.Where("tags && ?", pg.Array(tags))
+	}
+	applyFilter(query, filter)
+
+	postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// AddTags - adds tags to the contract and returns its tags sorted alphabetically. Write time and hash of the entry are kept.
+func (m *Metadata) AddTags(ctx context.Context, address string, tags []string) ([]string, error) {
+	return m.setTags(ctx, address, `
+		UPDATE metadata SET tags = ARRAY(
+			SELECT DISTINCT tag FROM unnest(coalesce(tags, '{}') || ?::text[]) AS tag ORDER BY tag
+		) WHERE contract = ? RETURNING tags`,
+		pg.Array(tags), strings.ToLower(address),
+	)
+}
+
+// RemoveTags - removes tags from the contract and returns its remaining tags. Write time and hash of the entry are kept.
+func (m *Metadata) RemoveTags(ctx context.Context, address string, tags []string) ([]string, error) {
+	return m.setTags(ctx, address, `
+		UPDATE metadata SET tags = ARRAY(
+			SELECT tag FROM unnest(coalesce(tags, '{}')) AS tag WHERE tag <> ALL(?) ORDER BY tag
+		) WHERE contract = ? RETURNING tags`,
+		pg.Array(tags), strings.ToLower(address),
+	)
+}
+
+func (m *Metadata) setTags(ctx context.Context, address, query string, params ...any) ([]string, error) {
+	var tags []string
+	if _, err := m.DB().QueryOneContext(ctx, pg.Scan(pg.Array(&tags)), query, params...); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, errors.Wrap(models.ErrNotFound, address)
+		}
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ListTags - returns all tags with count of marked contracts. The most used tags are returned first.
+func (m *Metadata) ListTags(ctx context.Context) ([]models.TagCount, error) {
+	var response []models.TagCount
+	_, err := m.DB().QueryContext(ctx, &response, `
+		SELECT tag, count(*) AS count FROM metadata, unnest(tags) AS tag
+		GROUP BY tag
+		ORDER BY count DESC, tag ASC`,
+	)
+	return response, err
+}
+
+// similarCandidates - max count of contracts sharing selectors or topics with requested one for which similarity is computed. Candidates with the most shared entries are chosen.
+const similarCandidates = 1000
+
+// FindSimilar - returns contracts with the same fingerprint as model first and then contracts with similarity not less than minSimilarity ordered by similarity. The model itself is excluded. Only light columns are returned.
+func (m *Metadata) FindSimilar(ctx context.Context, model *models.Metadata, minSimilarity float64, limit uint64) ([]models.SimilarMetadata, error) {
+	if len(model.Fingerprint) == 0 {
+		return []models.SimilarMetadata{}, nil
+	}
+	limit = pageLimit(limit)
+
+	var exact []*models.Metadata
+	if err := m.DB().ModelContext(ctx, &exact).
+		Column(models.MetadataLightColumns...).
+		Where("fingerprint = ?", model.Fingerprint).
+		Where("id <> ?", model.ID).
+		Order("id asc").
+		Limit(int(limit)).
+		Select(); err != nil {
+		return nil, err
+	}
+
+	response := make([]models.SimilarMetadata, 0, len(exact))
+	found := make(map[uint64]struct{}, len(exact))
+	for i := range exact {
+		response = append(response, models.SimilarMetadata{
+			Metadata:   exact[i],
+			Similarity: 1,
+		})
+		found[exact[i].ID] = struct{}{}
+	}
+	if uint64(len(response)) >= limit || minSimilarity >= 1 {
+		return response, nil
+	}
+
+	var similar []struct {
+		ID         uint64
+		Similarity float64
+	}
+	if _, err := m.DB().QueryContext(ctx, &similar, `
+		WITH target AS (
+			SELECT '\x01'::bytea || signature_id AS entry FROM methods WHERE metadata_id = ?0
+			UNION
+			SELECT '\x02'::bytea || signature_id FROM events WHERE metadata_id = ?0
+		), shared AS (
+			SELECT metadata_id, '\x01'::bytea || signature_id AS entry FROM methods
+			WHERE signature_id IN (SELECT signature_id FROM methods WHERE metadata_id = ?0) AND metadata_id <> ?0
+			UNION
+			SELECT metadata_id, '\x02'::bytea || signature_id FROM events
+			WHERE signature_id IN (SELECT signature_id FROM events WHERE metadata_id = ?0) AND metadata_id <> ?0
+		), candidates AS (
+			SELECT metadata_id, count(*) AS common FROM shared
+			GROUP BY metadata_id
+			HAVING count(*) >= ?1 * (SELECT count(*) FROM target)
+			ORDER BY count(*) DESC, metadata_id ASC
+			LIMIT ?2
+		), scores AS (
+			SELECT metadata_id, common::float8 / (
+				(SELECT count(DISTINCT signature_id) FROM methods WHERE methods.metadata_id = candidates.metadata_id) +
+				(SELECT count(DISTINCT signature_id) FROM events WHERE events.metadata_id = candidates.metadata_id) +
+				(SELECT count(*) FROM target) - common
+			) AS similarity FROM candidates
+		)
+		SELECT metadata_id AS id, similarity FROM scores
+		WHERE similarity >= ?1
+		ORDER BY similarity DESC, id ASC
+		LIMIT ?3`,
+		model.ID, minSimilarity, similarCandidates, limit+uint64(len(exact)),
+	); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(similar))
+	for i := range similar {
+		if _, ok := found[similar[i].ID]; !ok {
+			ids = append(ids, similar[i].ID)
+		}
+	}
+	if len(ids) == 0 {
+		return response, nil
+	}
+
+	var rows []*models.Metadata
+	if err := m.DB().ModelContext(ctx, &rows).
+		Column(models.MetadataLightColumns...).
+		WhereIn("id IN (?)", ids).
+		Select(); err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]*models.Metadata, len(rows))
+	for i := range rows {
+		byID[rows[i].ID] = rows[i]
+	}
+
+	for i := range similar {
+		if uint64(len(response)) >= limit {
+			break
+		}
+		if _, ok := found[similar[i].ID]; ok {
+			continue
+		}
+		if row, ok := byID[similar[i].ID]; ok {
+			response = append(response, models.SimilarMetadata{
+				Metadata:   row,
+				Similarity: similar[i].Similarity,
+			})
+		}
+	}
+	return response, nil
+}
+
+// ListByBlockRange - returns metadata of contracts created between blocks from and to inclusively ordered by creation block. If to is zero the range isn't bounded. Contracts with unknown creation block are excluded.
+func (m *Metadata) ListByBlockRange(ctx context.Context, from, to uint64, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Where("creation_block is not null").
+		Where("creation_block >= ?", from)
+	if to > 0 {
+		query.Where("creation_block <= ?", to)
+	}
+	applyFilter(query, filter)
+
+	sortByKey(query, "creation_block %s", limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// GetByCreator - returns metadata of contracts deployed by creator ordered by creation time. Contracts with unknown creation time are returned last.
+func (m *Metadata) GetByCreator(ctx context.Context, creator string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Where("creator = ?", strings.ToLower(creator))
+	applyFilter(query, filter)
+
+	sortByKey(query, "created_at %s nulls last", limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// ListByFactory - returns metadata of contracts deployed by factory
+func (m *Metadata) ListByFactory(ctx context.Context, factory string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Where("factory = ?", strings.ToLower(factory))
+	applyFilter(query, filter)
+
+	postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// ListStale - returns metadata which wasn't checked by background refresh since the time. Entries which were never checked are compared by write time. The oldest entries are returned first.
+func (m *Metadata) ListStale(ctx context.Context, before time.Time, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	err := m.DB().ModelContext(ctx, &response).
+		Where("coalesce(refreshed_at, updated_at) < ? OR coalesce(refreshed_at, updated_at) IS NULL", before).
+		OrderExpr("coalesce(refreshed_at, updated_at) asc nulls first, id asc").
+		Limit(int(limit)).
+		Select()
+	return response, err
+}
+
+// MarkRefreshed - sets time of the last background refresh and fetch attempt. Write time and hash of the entry are kept.
+func (m *Metadata) MarkRefreshed(ctx context.Context, id uint64, at time.Time) error {
+	_, err := m.DB().ExecContext(ctx, `UPDATE metadata SET refreshed_at = ?0, last_fetch_attempt = ?0 WHERE id = ?1`, at, id)
+	return err
+}
+
+// ListCodeUnchecked - returns metadata which code was never checked or was checked before the time, the least recently checked first. If createdBefore is set, contracts created at or after the block are skipped, contracts with unknown creation block are returned. ABI and JSON schema aren't selected.
+func (m *Metadata) ListCodeUnchecked(ctx context.Context, before time.Time, createdBefore, limit uint64) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Column(models.MetadataLightColumns...).
+		Where("code_checked_at IS NULL OR code_checked_at < ?", before)
+	if createdBefore > 0 {
+		query.Where("creation_block IS NULL OR creation_block < ?", createdBefore)
+	}
+	err := query.
+		OrderExpr("code_checked_at asc nulls first, id asc").
+		Limit(int(limit)).
+		Select()
+	return response, err
+}
+
+// MarkCodeStatus - sets result and time of the last check of contract code. Write time and hash of the entry are kept.
+func (m *Metadata) MarkCodeStatus(ctx context.Context, id uint64, status models.CodeStatus, at time.Time) error {
+	_, err := m.DB().ExecContext(ctx, `UPDATE metadata SET code_status = ?0, code_checked_at = ?1 WHERE id = ?2`, status, at, id)
+	return err
+}
+
+// ListIncomplete - returns metadata without full ABI ordered by time of the last fetch attempt. Entries which were never attempted since tracking started are compared by write time. ABI and JSON schema aren't selected.
+func (m *Metadata) ListIncomplete(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	var response []*models.Metadata
+	query := m.DB().ModelContext(ctx, &response).
+		Column(models.MetadataLightColumns...).
+		Where("is_complete = false")
+
+	sortByKey(query, "coalesce(last_fetch_attempt, updated_at) %s", limit, offset, order)
+
+	err := query.Select()
+	return response, err
+}
+
+// LastID - returns the greatest id of metadata. Zero is returned if table is empty.
+func (m *Metadata) LastID(ctx context.Context) (uint64, error) {
+	var id uint64
+	_, err := m.DB().QueryOneContext(ctx, pg.Scan(&id), `SELECT coalesce(max(id), 0) FROM metadata`)
+	return id, err
+}
+
+// LatestUpdate - returns write time of the most recently written metadata. Zero time is returned if table is empty.
+func (m *Metadata) LatestUpdate(ctx context.Context) (time.Time, error) {
+	var updatedAt pg.NullTime
+	_, err := m.DB().QueryOneContext(ctx, pg.Scan(&updatedAt), `SELECT max(updated_at) FROM metadata`)
+	return updatedAt.Time, err
+}
+
+// applyFilter - adds metadata filter conditions to query. Metadata table should be available in query by `metadata` alias.
+func applyFilter(query *orm.Query, filter models.MetadataFilter) *orm.Query {
+	if filter.OnlyComplete {
+		query.Where("metadata.is_complete = true")
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query.Where("metadata.updated_at >= ?", filter.UpdatedSince)
+	}
+	if filter.AfterID > 0 {
+		query.Where("metadata.id > ?", filter.AfterID)
+	}
+	if filter.BeforeID > 0 {
+		query.Where("metadata.id < ?", filter.BeforeID)
+	}
+	if filter.CompilerVersion != "" {
+		query.Where("metadata.compiler_version LIKE ?", escapeLike(filter.CompilerVersion)+"%")
+	}
+	if filter.IsProxy != nil {
+		if *filter.IsProxy {
+			query.Where("metadata.is_proxy = true")
+		} else {
+			query.Where("metadata.is_proxy IS NOT TRUE")
+		}
+	}
+	if filter.WithoutDestroyed {
+		query.Where("metadata.code_status IS DISTINCT FROM ?", models.CodeStatusDestroyed)
+	}
+	return query
+}
+
+// escapeLike - escapes wildcards of LIKE pattern, so value is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// sortByKey - adds limit, offset and sort by the key to query. Key is expression with `%s` placeholder of direction. Rows are sorted by id in the same direction as tiebreaker, so rows with equal keys keep their order between pages.
+func sortByKey(query *orm.Query, key string, limit, offset uint64, order storage.SortOrder) *orm.Query {
+	if limit == 0 {
+		limit = 10
+	}
+	query.Limit(int(limit)).Offset(int(offset))
+
+	direction := "asc"
+	if order == storage.SortOrderDesc {
+		direction = "desc"
+	}
+	return query.OrderExpr(fmt.Sprintf(key, direction)).OrderExpr(fmt.Sprintf("id %s", direction))
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+func TestSortByKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		limit  uint64
+		offset uint64
+		order  storage.SortOrder
+		want   string
+	}{
+		{"ascending", "creation_block %s", 5, 10, storage.SortOrderAsc, "ORDER BY creation_block asc, id asc LIMIT 5 OFFSET 10"},
+		{"descending", "created_at %s nulls last", 5, 0, storage.SortOrderDesc, "ORDER BY created_at desc nulls last, id desc LIMIT 5"},
+		{"default limit", "coalesce(last_fetch_attempt, updated_at) %s", 0, 0, storage.SortOrderAsc, "ORDER BY coalesce(last_fetch_attempt, updated_at) asc, id asc LIMIT 10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var response []*models.Metadata
+			query := sortByKey(orm.NewQuery(nil, &response), tt.key, tt.limit, tt.offset, tt.order)
+			sql, err := orm.NewSelectQuery(query).AppendQuery(orm.NewFormatter(), nil)
+			if err != nil {
+				t.Fatalf("formatting query: %v", err)
+			}
+			if !strings.HasSuffix(string(sql), tt.want) {
+				t.Fatalf("query %s doesn't end with %s", sql, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjection(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		want    []string
+	}{
+		{"ABI", []string{models.ColumnMetadata}, []string{models.ColumnMetadata, models.ColumnAbiCompressed}},
+		{"ABI with other columns", []string{"id", models.ColumnMetadata, "contract"}, []string{"id", models.ColumnMetadata, "contract", models.ColumnAbiCompressed}},
+		{"ABI with flag", []string{models.ColumnAbiCompressed, models.ColumnMetadata}, []string{models.ColumnAbiCompressed, models.ColumnMetadata}},
+		{"without ABI", []string{"id", models.ColumnJSONSchema}, []string{"id", models.ColumnJSONSchema}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns := append([]string(nil), tt.columns...)
+			if got := projection(columns); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("projection = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Fatalf("requested columns are changed: %v", columns)
+			}
+		})
+	}
+}
+
+func TestCompressedABIRoundTrip(t *testing.T) {
+	const abi = `[{"type":"function","name":"foo","stateMutability":"view","inputs":[],"outputs":[]}]`
+	strg := newTestStorage(t, Config{CompressABI: true})
+	ctx := context.Background()
+
+	isCompressed := func(address string) bool {
+		t.Helper()
+		var compressed bool
+		if _, err := strg.DB().QueryOneContext(ctx, pg.Scan(&compressed), `SELECT abi_compressed FROM metadata WHERE contract = ?`, address); err != nil {
+			t.Fatalf("reading compression flag: %v", err)
+		}
+		return compressed
+	}
+
+	// row written by transaction
+	inserted := testContract(t, strg, 0)
+	tx, err := strg.Transactable.BeginTransaction(ctx)
+	if err != nil {
+		t.Fatalf("BeginTransaction: %v", err)
+	}
+	if err := tx.Add(ctx, &models.Metadata{Contract: inserted, Metadata: []byte(abi)}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := tx.Flush(ctx); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if err := tx.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// row written and then updated by table
+	updated := testContract(t, strg, 1)
+	model := &models.Metadata{Contract: updated, Metadata: []byte(`[]`)}
+	if err := strg.Metadata.Save(ctx, model); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	model.Metadata = []byte(abi)
+	if err := strg.Metadata.Update(ctx, model); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if string(model.Metadata) != abi || model.ABICompressed {
+		t.Fatal("raw ABI of model isn't restored after write")
+	}
+
+	for _, address := range []string{inserted, updated} {
+		if !isCompressed(address) {
+			t.Fatalf("ABI of %s isn't compressed", address)
+		}
+		for name, read := range map[string]func() (*models.Metadata, error){
+			"all columns": func() (*models.Metadata, error) {
+				return strg.Metadata.GetByAddress(ctx, address)
+			},
+			"ABI column": func() (*models.Metadata, error) {
+				return strg.Metadata.GetByAddress(ctx, address, models.ColumnMetadata)
+			},
+			"list of ABI column": func() (*models.Metadata, error) {
+				model, err := strg.Metadata.GetByAddress(ctx, address, "id")
+				if err != nil {
+					return nil, err
+				}
+				response, err := strg.Metadata.ListByFilter(ctx, models.MetadataFilter{AfterID: model.ID - 1, BeforeID: model.ID + 1}, 1, 0, storage.SortOrderAsc, "id", models.ColumnMetadata)
+				if err != nil {
+					return nil, err
+				}
+				if len(response) != 1 {
+					return nil, fmt.Errorf("list returned %d rows", len(response))
+				}
+				return response[0], nil
+			},
+		} {
+			model, err := read()
+			if err != nil {
+				t.Fatalf("%s of %s: %v", name, address, err)
+			}
+			if string(model.Metadata) != abi {
+				t.Fatalf("%s of %s: ABI = %q, want raw JSON", name, address, model.Metadata)
+			}
+		}
+	}
+}
@@ -1,19 +1,85 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/dipdup-net/abi-indexer/internal/storage"
-	"github.com/dipdup-net/go-lib/database"
-	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/go-pg/pg/v10"
 )
 
 // Methods -
 type Methods struct {
-	*postgres.Table[*storage.Method]
+	*Table[*storage.Method]
 }
 
 // NewMethods -
-func NewMethods(db *database.PgGo) *Methods {
+func NewMethods(db *pg.DB) *Methods {
 	return &Methods{
-		Table: postgres.NewTable[*storage.Method](db),
+		Table: NewTable[*storage.Method](db),
+	}
+}
+
+// ListSelectorSignatures - returns distinct triples of selector, signature and kind of indexed functions and errors and imported signatures ordered by selector, signature and kind. Triples after (afterSelector, afterSignature, afterKind) are returned.
+func (m *Methods) ListSelectorSignatures(ctx context.Context, afterSelector []byte, afterSignature string, afterKind storage.SignatureKind, limit uint64) ([]storage.SelectorSignature, error) {
+	var response []storage.SelectorSignature
+	if afterSelector == nil {
+		afterSelector = []byte{}
+	}
+	// each part is limited separately and filtered by (selector, signature) only, so all of them are read by index. The last returned pair may be repeated in each part with another kind, it's filtered out by outer query.
+	_, err := m.DB().QueryContext(ctx, &response, `
+		SELECT signature_id, signature, kind FROM (
+			(SELECT DISTINCT signature_id, signature, 'function'::text AS kind FROM methods
+				WHERE (signature_id, signature) >= (?0, ?1)
+				ORDER BY signature_id, signature LIMIT ?3 + 1)
+			UNION
+			(SELECT DISTINCT signature_id, signature, 'error'::text AS kind FROM errors
+				WHERE (signature_id, signature) >= (?0, ?1)
+				ORDER BY signature_id, signature LIMIT ?3 + 1)
+			UNION
+			(SELECT selector AS signature_id, signature, kind FROM signatures
+				WHERE (selector, signature) >= (?0, ?1)
+				ORDER BY selector, signature LIMIT ?3 + 2)
+		) AS pairs
+		WHERE (signature_id, signature, kind) > (?0, ?1, ?2)
+		ORDER BY signature_id, signature, kind
+		LIMIT ?3`,
+		afterSelector, afterSignature, string(afterKind), limit,
+	)
+	return response, err
+}
+
+// CoverageStats - counts distinct selectors, topics and signatures of lookup tables. Tables are scanned entirely, so it's expensive on large databases.
+func (m *Methods) CoverageStats(ctx context.Context) (storage.CoverageStats, error) {
+	var stats storage.CoverageStats
+	_, err := m.DB().QueryOneContext(ctx, &stats, `
+		WITH pairs AS (
+			SELECT signature_id AS selector, signature FROM methods
+			UNION
+			SELECT signature_id, signature FROM errors
+			UNION
+			SELECT selector, signature FROM signatures
+		), selectors AS (
+			SELECT selector, count(*) AS signatures FROM pairs GROUP BY selector
+		)
+		SELECT
+			(SELECT count(*) FROM selectors) AS selectors,
+			(SELECT count(*) FROM selectors WHERE signatures > 1) AS colliding_selectors,
+			(SELECT count(DISTINCT signature_id) FROM events) AS topics,
+			(SELECT count(*) FROM (SELECT signature FROM pairs UNION SELECT signature FROM events) AS texts) AS signatures`,
+	)
+	return stats, err
+}
+
+// SelectorsByMetadata - returns selectors of methods of metadata. Only `metadata_id` and `signature_id` are filled.
+func (m *Methods) SelectorsByMetadata(ctx context.Context, metadataIDs []uint64) ([]*storage.Method, error) {
+	var response []*storage.Method
+	if len(metadataIDs) == 0 {
+		return response, nil
 	}
+	err := m.DB().ModelContext(ctx, &response).
+		Column("metadata_id", "signature_id").
+		WhereIn("metadata_id IN (?)", metadataIDs).
+		Order("metadata_id asc", "signature_id asc", "id asc").
+		Select()
+	return response, err
 }
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/go-pg/pg/v10"
+)
+
+// metric names
+const (
+	MetricPoolConnections = "abi_indexer_db_pool_connections"
+	MetricPoolTimeouts    = "abi_indexer_db_pool_timeouts"
+)
+
+// roles of connection pools
+const (
+	poolRolePrimary = "primary"
+	poolRoleReplica = "replica"
+)
+
+func registerPoolMetrics(metrics *prometheus.Service) {
+	metrics.RegisterGauge(MetricPoolConnections, "count of connections in pool by role and state", "role", "state")
+	metrics.RegisterGauge(MetricPoolTimeouts, "count of times when connection was not acquired from pool during acquire timeout", "role")
+}
+
+func (s *Storage) reportPoolStats(ctx context.Context, metrics *prometheus.Service) {
+	defer s.wg.Done()
+
+	registerPoolMetrics(metrics)
+
+	ticker := time.NewTicker(time.Second * 5)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.setPoolStats(metrics)
+		}
+	}
+}
+
+// setPoolStats - sets gauges of primary pool and of replica pool if it's configured. Replica pool serves read traffic of API, so it's reported separately.
+func (s *Storage) setPoolStats(metrics *prometheus.Service) {
+	setPoolStats(metrics, poolRolePrimary, s.db.PoolStats())
+	if s.replica != nil {
+		setPoolStats(metrics, poolRoleReplica, s.replica.PoolStats())
+	}
+}
+
+func setPoolStats(metrics *prometheus.Service, role string, stats *pg.PoolStats) {
+	metrics.SetGaugeValue(MetricPoolConnections, map[string]string{"role": role, "state": "in_use"}, float64(stats.TotalConns-stats.IdleConns))
+	metrics.SetGaugeValue(MetricPoolConnections, map[string]string{"role": role, "state": "idle"}, float64(stats.IdleConns))
+	metrics.SetGaugeValue(MetricPoolConnections, map[string]string{"role": role, "state": "stale"}, float64(stats.StaleConns))
+	metrics.SetGaugeValue(MetricPoolTimeouts, map[string]string{"role": role}, float64(stats.Timeouts))
+}
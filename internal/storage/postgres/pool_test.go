@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/go-pg/pg/v10"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestSetPoolStats(t *testing.T) {
+	metrics := prometheus.NewService(nil)
+	registerPoolMetrics(metrics)
+
+	// pools are lazy, so stats are read without database
+	primary := pg.Connect(&pg.Options{Addr: "127.0.0.1:1"})
+	defer primary.Close()
+
+	s := &Storage{db: primary}
+	s.setPoolStats(metrics)
+	if count := testutil.CollectAndCount(metrics.Gauge(MetricPoolTimeouts)); count != 1 {
+		t.Fatalf("timeouts are reported for %d pools without replica, want 1", count)
+	}
+
+	replica := pg.Connect(&pg.Options{Addr: "127.0.0.1:2"})
+	defer replica.Close()
+	s.replica = replica
+	s.setPoolStats(metrics)
+
+	for _, role := range []string{poolRolePrimary, poolRoleReplica} {
+		if value := testutil.ToFloat64(metrics.Gauge(MetricPoolTimeouts).WithLabelValues(role)); value != 0 {
+			t.Fatalf("timeouts of %s = %v, want 0", role, value)
+		}
+	}
+	if count := testutil.CollectAndCount(metrics.Gauge(MetricPoolConnections)); count != 6 {
+		t.Fatalf("count of connection gauges = %d, want 6", count)
+	}
+}
@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricPreparedQueries = "abi_indexer_storage_prepared_queries_total"
+)
+
+// SQLSTATE codes of errors which mean that prepared statement can't be executed anymore
+const (
+	// cached plan must not change result type: columns of table were changed after statement was prepared
+	codeFeatureNotSupported = "0A000"
+	codeInvalidStatement    = "26000"
+)
+
+// preparedQuery - lazily prepared copies of query. Statement of go-pg is bound to one connection, so several copies are kept to serve concurrent requests. Copies are prepared on first use, i.e. after migrations, and each of them holds connection of pool while it's alive.
+type preparedQuery struct {
+	name    string
+	query   string
+	db      *pg.DB
+	idle    chan *pg.Stmt
+	slots   chan struct{}
+	metrics *prometheus.Service
+}
+
+func newPreparedQuery(db *pg.DB, name, query string, size int, metrics *prometheus.Service) *preparedQuery {
+	q := &preparedQuery{
+		name:    name,
+		query:   query,
+		db:      db,
+		idle:    make(chan *pg.Stmt, size),
+		slots:   make(chan struct{}, size),
+		metrics: metrics,
+	}
+	for i := 0; i < size; i++ {
+		q.slots <- struct{}{}
+	}
+	return q
+}
+
+// acquire - returns idle copy or prepares new one. Nil is returned if all copies are busy or statement can't be prepared: caller should run plain query instead of waiting.
+func (q *preparedQuery) acquire() *pg.Stmt {
+	select {
+	case stmt := <-q.idle:
+		return stmt
+	default:
+	}
+
+	select {
+	case <-q.slots:
+	default:
+		return nil
+	}
+
+	stmt, err := q.db.Prepare(q.query)
+	if err != nil {
+		log.Warn().Err(err).Str("query", q.name).Msg("preparing statement")
+		q.slots <- struct{}{}
+		return nil
+	}
+	return stmt
+}
+
+// release - returns copy for reuse. Copy is closed if it can't be executed anymore, e.g. after schema change or if its connection is broken. It's prepared again on demand.
+func (q *preparedQuery) release(stmt *pg.Stmt, err error) bool {
+	if isValidStatement(err) {
+		q.idle <- stmt
+		return true
+	}
+
+	log.Warn().Err(err).Str("query", q.name).Msg("prepared statement is closed")
+	if closeErr := stmt.Close(); closeErr != nil {
+		log.Err(closeErr).Str("query", q.name).Msg("closing prepared statement")
+	}
+	q.slots <- struct{}{}
+	return false
+}
+
+// Query - runs prepared query. Plain query is run by fallback if there is no free copy or copy was closed during execution.
+func (q *preparedQuery) Query(ctx context.Context, model any, fallback func() error, params ...any) error {
+	return q.run(ctx, fallback, func(stmt *pg.Stmt) error {
+		_, err := stmt.QueryContext(ctx, model, params...)
+		return err
+	})
+}
+
+// QueryOne - runs prepared query which returns one row. Plain query is run by fallback if there is no free copy or copy was closed during execution.
+func (q *preparedQuery) QueryOne(ctx context.Context, model any, fallback func() error, params ...any) error {
+	return q.run(ctx, fallback, func(stmt *pg.Stmt) error {
+		_, err := stmt.QueryOneContext(ctx, model, params...)
+		return err
+	})
+}
+
+func (q *preparedQuery) run(ctx context.Context, fallback func() error, query func(stmt *pg.Stmt) error) error {
+	stmt := q.acquire()
+	if stmt == nil {
+		q.count("plain")
+		return fallback()
+	}
+
+	err := query(stmt)
+	if q.release(stmt, err) {
+		q.count("prepared")
+		return err
+	}
+	if ctx.Err() != nil {
+		return err
+	}
+	q.count("reprepared")
+	return fallback()
+}
+
+func (q *preparedQuery) count(result string) {
+	if q.metrics != nil {
+		q.metrics.IncrementCounter(MetricPreparedQueries, map[string]string{
+			"query":  q.name,
+			"result": result,
+		})
+	}
+}
+
+// Close - closes idle copies
+func (q *preparedQuery) Close() {
+	for {
+		select {
+		case stmt := <-q.idle:
+			if err := stmt.Close(); err != nil {
+				log.Err(err).Str("query", q.name).Msg("closing prepared statement")
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isValidStatement(err error) bool {
+	if err == nil || errors.Is(err, pg.ErrNoRows) || errors.Is(err, pg.ErrMultiRows) {
+		return true
+	}
+	var pgErr pg.Error
+	if errors.As(err, &pgErr) {
+		code := pgErr.Field('C')
+		return code != codeFeatureNotSupported && code != codeInvalidStatement
+	}
+	// network and context errors leave connection of statement in unknown state
+	return false
+}
+
+// preparedQueries - prepared statements of hot paths. Variants of queries which differ by sort order and filter are prepared separately on first use.
+type preparedQueries struct {
+	db      *pg.DB
+	size    int
+	metrics *prometheus.Service
+
+	queries map[string]*preparedQuery
+	mx      *sync.Mutex
+}
+
+func newPreparedQueries(db *pg.DB, size int, metrics *prometheus.Service) *preparedQueries {
+	return &preparedQueries{
+		db:      db,
+		size:    size,
+		metrics: metrics,
+		queries: make(map[string]*preparedQuery),
+		mx:      new(sync.Mutex),
+	}
+}
+
+// get - returns prepared query by key. Query text is built on first request.
+func (p *preparedQueries) get(name, key string, build func() (string, error)) *preparedQuery {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if q, ok := p.queries[key]; ok {
+		return q
+	}
+	query, err := build()
+	if err != nil {
+		log.Err(err).Str("query", name).Msg("building prepared query")
+		return nil
+	}
+	q := newPreparedQuery(p.db, name, query, p.size, p.metrics)
+	p.queries[key] = q
+	return q
+}
+
+// metadataByAddress - metadata with all columns by contract address: `$1` is lower-cased address
+func (p *preparedQueries) metadataByAddress() *preparedQuery {
+	if p == nil {
+		return nil
+	}
+	return p.get("metadata_by_address", "metadata_by_address", func() (string, error) {
+		query := p.db.Model((*models.Metadata)(nil)).
+			Where("contract = ?", pg.Safe("$1")).
+			Order("id asc").
+			Limit(1)
+		return selectSQL(query)
+	})
+}
+
+// metadataByMethod - methods with metadata by signature, `$1` is signature, `$2` and `$3` are limit and offset. Nil is returned if filter has other conditions than `OnlyComplete`.
+func (p *preparedQueries) metadataByMethod(filter models.MetadataFilter, order storage.SortOrder) *preparedQuery {
+	return p.relation("metadata_by_method", (*models.Method)(nil), "signature", filter, order)
+}
+
+// metadataByTopic - events with metadata by topic, `$1` is topic, `$2` and `$3` are limit and offset. Nil is returned if filter has other conditions than `OnlyComplete`.
+func (p *preparedQueries) metadataByTopic(filter models.MetadataFilter, order storage.SortOrder) *preparedQuery {
+	return p.relation("metadata_by_topic", (*models.Event)(nil), "signature_id", filter, order)
+}
+
+func (p *preparedQueries) relation(name string, model any, column string, filter models.MetadataFilter, order storage.SortOrder) *preparedQuery {
+	if p == nil || filter != (models.MetadataFilter{OnlyComplete: filter.OnlyComplete}) {
+		return nil
+	}
+
+	direction := "asc"
+	if order == storage.SortOrderDesc {
+		direction = "desc"
+	}
+	key := fmt.Sprintf("%s:%s:%t", name, direction, filter.OnlyComplete)
+
+	return p.get(name, key, func() (string, error) {
+		query := p.db.Model(model).
+			Relation("Metadata").
+			Where("? = ?", pg.Ident(column), pg.Safe("$1")).
+			Where("metadata_id is not null")
+		applyFilter(query, filter)
+		query.Order(fmt.Sprintf("id %s", direction))
+
+		sql, err := selectSQL(query)
+		if err != nil {
+			return "", err
+		}
+		return sql + " LIMIT $2 OFFSET $3", nil
+	})
+}
+
+// Close - closes idle prepared statements
+func (p *preparedQueries) Close() {
+	if p == nil {
+		return
+	}
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	for _, q := range p.queries {
+		q.Close()
+	}
+}
+
+func selectSQL(query *orm.Query) (string, error) {
+	b, err := orm.NewSelectQuery(query).AppendQuery(orm.NewFormatter(), nil)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// pageLimit - limit of pagination with default of `postgres.Pagination`
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return 10
+	}
+	return limit
+}
@@ -0,0 +1,295 @@
+package postgres
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricStorageRetries = "abi_indexer_storage_retries_total"
+)
+
+const (
+	defaultRetryAttempts       = 3
+	defaultRetryInitialBackoff = 50
+	defaultRetryMaxBackoff     = 1000
+)
+
+// retryableCodes - SQLSTATE codes and classes of transient errors. Query may succeed if it's repeated.
+var retryableCodes = []string{
+	"08",    // connection exception
+	"40001", // serialization_failure
+	"40P01", // deadlock_detected
+	"53300", // too_many_connections
+	"57P01", // admin_shutdown
+	"57P02", // crash_shutdown
+	"57P03", // cannot_connect_now
+}
+
+// IsRetryable - checks if storage error is transient: connection is lost, deadlock is detected or server is restarting. Missing rows, constraint violations, cancellation and other errors aren't retryable.
+func IsRetryable(err error) bool {
+	if err == nil ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, pg.ErrNoRows) ||
+		errors.Is(err, models.ErrNotFound) {
+		return false
+	}
+
+	var pgErr pg.Error
+	if errors.As(err, &pgErr) {
+		code := pgErr.Field('C')
+		for i := range retryableCodes {
+			if strings.HasPrefix(code, retryableCodes[i]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// retrier - repeats failed read queries with exponential backoff and jitter
+type retrier struct {
+	attempts       int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	metrics        *prometheus.Service
+}
+
+func newRetrier(cfg RetryConfig, metrics *prometheus.Service) *retrier {
+	r := &retrier{
+		attempts:       defaultRetryAttempts,
+		initialBackoff: defaultRetryInitialBackoff * time.Millisecond,
+		maxBackoff:     defaultRetryMaxBackoff * time.Millisecond,
+		metrics:        metrics,
+	}
+	if cfg.MaxAttempts > 0 {
+		r.attempts = cfg.MaxAttempts
+	}
+	if cfg.InitialBackoff > 0 {
+		r.initialBackoff = time.Duration(cfg.InitialBackoff) * time.Millisecond
+	}
+	if cfg.MaxBackoff > 0 {
+		r.maxBackoff = time.Duration(cfg.MaxBackoff) * time.Millisecond
+	}
+	if r.maxBackoff < r.initialBackoff {
+		r.maxBackoff = r.initialBackoff
+	}
+	if metrics != nil {
+		metrics.RegisterCounter(MetricStorageRetries, "count of repeated storage read queries after transient errors", "query")
+	}
+	return r
+}
+
+// backoff - returns delay before retry with number attempt: random value up to exponentially growing bound
+func (r *retrier) backoff(attempt int) time.Duration {
+	bound := r.initialBackoff << (attempt - 1)
+	if bound <= 0 || bound > r.maxBackoff {
+		bound = r.maxBackoff
+	}
+	return bound/2 + time.Duration(rand.Int63n(int64(bound/2)+1))
+}
+
+// retry - calls fn until it succeeds, returns not retryable error or attempts are exhausted. Retry isn't started if backoff ends after deadline of ctx, so retries never extend request.
+func retry[T any](ctx context.Context, r *retrier, query string, fn func() (T, error)) (T, error) {
+	result, err := fn()
+	for attempt := 1; attempt < r.attempts && IsRetryable(err); attempt++ {
+		delay := r.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return result, err
+		}
+
+		log.Debug().Err(err).Str("query", query).Int("attempt", attempt).Dur("backoff", delay).Msg("retrying storage query")
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+
+		if r.metrics != nil {
+			r.metrics.IncrementCounter(MetricStorageRetries, map[string]string{"query": query})
+		}
+		result, err = fn()
+	}
+	return result, err
+}
+
+// retryMetadata - metadata storage which retries read queries after transient errors. Writes aren't retried.
+type retryMetadata struct {
+	models.IMetadata
+	r *retrier
+}
+
+// GetByAddress -
+func (m *retryMetadata) GetByAddress(ctx context.Context, address string, columns ...string) (*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByAddress", func() (*models.Metadata, error) {
+		return m.IMetadata.GetByAddress(ctx, address, columns...)
+	})
+}
+
+// GetByMethod -
+func (m *retryMetadata) GetByMethod(ctx context.Context, signature string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByMethod", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByMethod(ctx, signature, filter, limit, offset, order)
+	})
+}
+
+// GetByTopic -
+func (m *retryMetadata) GetByTopic(ctx context.Context, topic string, indexed []models.IndexedTopic, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByTopic", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByTopic(ctx, topic, indexed, filter, limit, offset, order)
+	})
+}
+
+// GetByErrorSelector -
+func (m *retryMetadata) GetByErrorSelector(ctx context.Context, selector []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByErrorSelector", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByErrorSelector(ctx, selector, filter, limit, offset, order)
+	})
+}
+
+// GetByCodeHash -
+func (m *retryMetadata) GetByCodeHash(ctx context.Context, hash []byte, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByCodeHash", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByCodeHash(ctx, hash, filter, limit, offset, order)
+	})
+}
+
+// GetBySelectors -
+func (m *retryMetadata) GetBySelectors(ctx context.Context, selectors [][]byte, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetBySelectors", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetBySelectors(ctx, selectors, match, filter, limit, offset, order)
+	})
+}
+
+// ListByFilter -
+func (m *retryMetadata) ListByFilter(ctx context.Context, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder, columns ...string) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "ListByFilter", func() ([]*models.Metadata, error) {
+		return m.IMetadata.ListByFilter(ctx, filter, limit, offset, order, columns...)
+	})
+}
+
+// Sample -
+func (m *retryMetadata) Sample(ctx context.Context, n uint64, method models.SampleMethod) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "Sample", func() ([]*models.Metadata, error) {
+		return m.IMetadata.Sample(ctx, n, method)
+	})
+}
+
+// GetByTags -
+func (m *retryMetadata) GetByTags(ctx context.Context, tags []string, match models.MatchType, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByTags", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByTags(ctx, tags, match, filter, limit, offset, order)
+	})
+}
+
+// ListTags -
+func (m *retryMetadata) ListTags(ctx context.Context) ([]models.TagCount, error) {
+	return retry(ctx, m.r, "ListTags", func() ([]models.TagCount, error) {
+		return m.IMetadata.ListTags(ctx)
+	})
+}
+
+// FindSimilar -
+func (m *retryMetadata) FindSimilar(ctx context.Context, model *models.Metadata, minSimilarity float64, limit uint64) ([]models.SimilarMetadata, error) {
+	return retry(ctx, m.r, "FindSimilar", func() ([]models.SimilarMetadata, error) {
+		return m.IMetadata.FindSimilar(ctx, model, minSimilarity, limit)
+	})
+}
+
+// ListByBlockRange -
+func (m *retryMetadata) ListByBlockRange(ctx context.Context, from, to uint64, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "ListByBlockRange", func() ([]*models.Metadata, error) {
+		return m.IMetadata.ListByBlockRange(ctx, from, to, filter, limit, offset, order)
+	})
+}
+
+// GetByCreator -
+func (m *retryMetadata) GetByCreator(ctx context.Context, creator string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "GetByCreator", func() ([]*models.Metadata, error) {
+		return m.IMetadata.GetByCreator(ctx, creator, filter, limit, offset, order)
+	})
+}
+
+// ListByFactory -
+func (m *retryMetadata) ListByFactory(ctx context.Context, factory string, filter models.MetadataFilter, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "ListByFactory", func() ([]*models.Metadata, error) {
+		return m.IMetadata.ListByFactory(ctx, factory, filter, limit, offset, order)
+	})
+}
+
+// ListIncomplete -
+func (m *retryMetadata) ListIncomplete(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*models.Metadata, error) {
+	return retry(ctx, m.r, "ListIncomplete", func() ([]*models.Metadata, error) {
+		return m.IMetadata.ListIncomplete(ctx, limit, offset, order)
+	})
+}
+
+// LatestUpdate -
+func (m *retryMetadata) LatestUpdate(ctx context.Context) (time.Time, error) {
+	return retry(ctx, m.r, "LatestUpdate", func() (time.Time, error) {
+		return m.IMetadata.LatestUpdate(ctx)
+	})
+}
+
+// retryMethods - methods storage which retries read queries after transient errors
+type retryMethods struct {
+	models.IMethod
+	r *retrier
+}
+
+// ListSelectorSignatures -
+func (m *retryMethods) ListSelectorSignatures(ctx context.Context, afterSelector []byte, afterSignature string, afterKind models.SignatureKind, limit uint64) ([]models.SelectorSignature, error) {
+	return retry(ctx, m.r, "ListSelectorSignatures", func() ([]models.SelectorSignature, error) {
+		return m.IMethod.ListSelectorSignatures(ctx, afterSelector, afterSignature, afterKind, limit)
+	})
+}
+
+// SelectorsByMetadata -
+func (m *retryMethods) SelectorsByMetadata(ctx context.Context, metadataIDs []uint64) ([]*models.Method, error) {
+	return retry(ctx, m.r, "SelectorsByMetadata", func() ([]*models.Method, error) {
+		return m.IMethod.SelectorsByMetadata(ctx, metadataIDs)
+	})
+}
+
+// CoverageStats -
+func (m *retryMethods) CoverageStats(ctx context.Context) (models.CoverageStats, error) {
+	return retry(ctx, m.r, "CoverageStats", func() (models.CoverageStats, error) {
+		return m.IMethod.CoverageStats(ctx)
+	})
+}
+
+// retryEvents - events storage which retries read queries after transient errors
+type retryEvents struct {
+	models.IEvent
+	r *retrier
+}
+
+// GetByTopics -
+func (e *retryEvents) GetByTopics(ctx context.Context, topics [][]byte, limit uint64) ([]models.TopicEvent, error) {
+	return retry(ctx, e.r, "GetByTopics", func() ([]models.TopicEvent, error) {
+		return e.IEvent.GetByTopics(ctx, topics, limit)
+	})
+}
@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// pgError - error returned by postgres with SQLSTATE code
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string {
+	return "ERROR #" + e.code
+}
+
+func (e pgError) Field(field byte) string {
+	if field == 'C' {
+		return e.code
+	}
+	return ""
+}
+
+func (e pgError) IntegrityViolation() bool {
+	return e.code[:2] == "23"
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"connection closed", io.EOF, true},
+		{"unexpected eof", errors.Wrap(io.ErrUnexpectedEOF, "query"), true},
+		{"connection exception", pgError{"08006"}, true},
+		{"deadlock", pgError{"40P01"}, true},
+		{"serialization failure", errors.Wrap(pgError{"40001"}, "query"), true},
+		{"too many connections", pgError{"53300"}, true},
+		{"admin shutdown", pgError{"57P01"}, true},
+		{"no rows", pg.ErrNoRows, false},
+		{"not found", errors.Wrap(models.ErrNotFound, "contract"), false},
+		{"unique violation", pgError{"23505"}, false},
+		{"foreign key violation", pgError{"23503"}, false},
+		{"syntax error", pgError{"42601"}, false},
+		{"query canceled", pgError{"57014"}, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", errors.Wrap(context.DeadlineExceeded, "query"), false},
+		{"other", errors.New("invalid ABI"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Fatalf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// faultyMetadata - metadata storage which fails queries with injected errors before it succeeds
+type faultyMetadata struct {
+	models.IMetadata
+
+	faults []error
+	calls  int
+}
+
+func (m *faultyMetadata) GetByAddress(ctx context.Context, address string, columns ...string) (*models.Metadata, error) {
+	m.calls++
+	if m.calls <= len(m.faults) {
+		return nil, m.faults[m.calls-1]
+	}
+	return &models.Metadata{Contract: address}, nil
+}
+
+// retryMetrics - metrics are registered in the global registry, so one service is shared by tests
+var retryMetrics = prometheus.NewService(nil)
+
+func newTestRetrier(cfg RetryConfig) *retrier {
+	if retryMetrics.Counter(MetricStorageRetries) == nil {
+		return newRetrier(cfg, retryMetrics)
+	}
+	r := newRetrier(cfg, nil)
+	r.metrics = retryMetrics
+	return r
+}
+
+func retriesCount() float64 {
+	return testutil.ToFloat64(retryMetrics.Counter(MetricStorageRetries).WithLabelValues("GetByAddress"))
+}
+
+func TestRetry(t *testing.T) {
+	connectionLost := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	deadlock := pgError{"40P01"}
+
+	tests := []struct {
+		name        string
+		faults      []error
+		wantCalls   int
+		wantRetries float64
+		wantErr     error
+	}{
+		{
+			name:      "success",
+			wantCalls: 1,
+		}, {
+			name:        "transient errors",
+			faults:      []error{connectionLost, deadlock},
+			wantCalls:   3,
+			wantRetries: 2,
+		}, {
+			name:        "attempts are exhausted",
+			faults:      []error{deadlock, deadlock, deadlock, deadlock},
+			wantCalls:   3,
+			wantRetries: 2,
+			wantErr:     deadlock,
+		}, {
+			name:      "no rows",
+			faults:    []error{pg.ErrNoRows},
+			wantCalls: 1,
+			wantErr:   pg.ErrNoRows,
+		}, {
+			name:      "constraint violation",
+			faults:    []error{pgError{"23505"}},
+			wantCalls: 1,
+			wantErr:   pgError{"23505"},
+		}, {
+			name:        "retry returns not retryable error",
+			faults:      []error{deadlock, pg.ErrNoRows, deadlock},
+			wantCalls:   2,
+			wantRetries: 1,
+			wantErr:     pg.ErrNoRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &faultyMetadata{faults: tt.faults}
+			repo := &retryMetadata{
+				IMetadata: storage,
+				r:         newTestRetrier(RetryConfig{MaxAttempts: 3, InitialBackoff: 1, MaxBackoff: 2}),
+			}
+			before := retriesCount()
+
+			_, err := repo.GetByAddress(context.Background(), "0x5fbdb2315678afecb367f032d93f642f64180aa3")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if storage.calls != tt.wantCalls {
+				t.Fatalf("calls = %d, want %d", storage.calls, tt.wantCalls)
+			}
+			if retries := retriesCount() - before; retries != tt.wantRetries {
+				t.Fatalf("retries = %v, want %v", retries, tt.wantRetries)
+			}
+		})
+	}
+}
+
+func TestRetryDeadline(t *testing.T) {
+	deadlock := pgError{"40P01"}
+	storage := &faultyMetadata{faults: []error{deadlock, deadlock, deadlock, deadlock, deadlock}}
+	repo := &retryMetadata{
+		IMetadata: storage,
+		r:         newTestRetrier(RetryConfig{MaxAttempts: 5, InitialBackoff: 20, MaxBackoff: 20}),
+	}
+	before := retriesCount()
+
+	// backoff is 10-20 ms, so deadline is reached before attempts are exhausted
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := repo.GetByAddress(ctx, "0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, deadlock) {
+		t.Fatalf("error = %v, want %v", err, deadlock)
+	}
+	// retry is started only if its backoff ends before deadline, so only scheduling delay can exceed it
+	if elapsed > 40*time.Millisecond {
+		t.Fatalf("retries took %s which is after deadline", elapsed)
+	}
+	if storage.calls >= 5 {
+		t.Fatalf("all %d attempts are made before deadline", storage.calls)
+	}
+	if retries := retriesCount() - before; retries != float64(storage.calls-1) {
+		t.Fatalf("retries = %v, want %d", retries, storage.calls-1)
+	}
+}
+
+func TestRetryExpiredContext(t *testing.T) {
+	storage := &faultyMetadata{faults: []error{pgError{"40P01"}}}
+	repo := &retryMetadata{
+		IMetadata: storage,
+		r:         newTestRetrier(RetryConfig{MaxAttempts: 3, InitialBackoff: 1000, MaxBackoff: 1000}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := repo.GetByAddress(ctx, "0x5fbdb2315678afecb367f032d93f642f64180aa3"); err == nil {
+		t.Fatal("error isn't returned")
+	}
+	if storage.calls != 1 {
+		t.Fatalf("calls = %d, retry with backoff after deadline is started", storage.calls)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("retry waited %s", elapsed)
+	}
+}
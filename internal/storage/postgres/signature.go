@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/go-pg/pg/v10"
+)
+
+// Signatures -
+type Signatures struct {
+	*Table[*storage.Signature]
+}
+
+// NewSignatures -
+func NewSignatures(db *pg.DB) *Signatures {
+	return &Signatures{
+		Table: NewTable[*storage.Signature](db),
+	}
+}
+
+// Import - inserts signatures which are unknown yet and returns count of inserted ones. Signatures already imported with the same kind or declared by functions or errors of indexed contracts are skipped.
+func (s *Signatures) Import(ctx context.Context, signatures []*storage.Signature) (int, error) {
+	if len(signatures) == 0 {
+		return 0, nil
+	}
+
+	texts := make([]string, len(signatures))
+	selectors := make([][]byte, len(signatures))
+	topics := make([][]byte, len(signatures))
+	kinds := make([]string, len(signatures))
+	for i := range signatures {
+		texts[i] = signatures[i].Signature
+		selectors[i] = signatures[i].Selector
+		topics[i] = signatures[i].Topic
+		kinds[i] = string(signatures[i].Kind)
+		if kinds[i] == "" {
+			kinds[i] = string(storage.KindFunction)
+		}
+	}
+
+	result, err := s.DB().ExecContext(ctx, `
+		INSERT INTO signatures (signature, selector, topic, kind)
+		SELECT s.signature, s.selector, s.topic, s.kind
+		FROM unnest(?0::text[], ?1::bytea[], ?2::bytea[], ?3::text[]) AS s(signature, selector, topic, kind)
+		WHERE NOT EXISTS (SELECT 1 FROM methods WHERE s.kind = 'function' AND methods.signature_id = s.selector AND methods.signature = s.signature)
+			AND NOT EXISTS (SELECT 1 FROM errors WHERE s.kind = 'error' AND errors.signature_id = s.selector AND errors.signature = s.signature)
+		ON CONFLICT (signature, kind) DO NOTHING`,
+		pg.Array(texts), pg.Array(selectors), pg.Array(topics), pg.Array(kinds),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// Table - Postgres realization of storage.Table interface over own connection pool
+type Table[M storage.Model] struct {
+	db *pg.DB
+}
+
+// NewTable -
+func NewTable[M storage.Model](db *pg.DB) *Table[M] {
+	return &Table[M]{db}
+}
+
+// Save - inserts row to table and returns id.
+func (t *Table[M]) Save(ctx context.Context, m M) error {
+	_, err := t.db.ModelContext(ctx, m).Returning("id").Insert()
+	return err
+}
+
+// Update - updates table row by primary key.
+func (t *Table[M]) Update(ctx context.Context, m M) error {
+	_, err := excludeCurated(t.db.ModelContext(ctx, m), m).WherePK().Update()
+	return err
+}
+
+// List - returns array of rows
+func (t *Table[M]) List(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]M, error) {
+	var models []M
+	query := t.db.ModelContext(ctx, &models)
+	query = postgres.Pagination(query, limit, offset, order)
+
+	err := query.Select(&models)
+	return models, err
+}
+
+// GetByID - returns row by id
+func (t *Table[M]) GetByID(ctx context.Context, id uint64) (m M, err error) {
+	typ := reflect.TypeOf(m)
+	if typ.Kind() == reflect.Ptr {
+		value := reflect.New(typ.Elem())
+		val := value.Interface()
+		err = t.db.ModelContext(ctx, val).Where("id = ?", id).First()
+		return val.(M), err
+	}
+	err = t.db.ModelContext(ctx, &m).Where("id = ?", id).First()
+	return
+}
+
+// CursorList - returns array of rows by cursor pagination
+func (t *Table[M]) CursorList(ctx context.Context, id, limit uint64, order storage.SortOrder, cmp storage.Comparator) ([]M, error) {
+	var models []M
+	query := t.db.ModelContext(ctx, &models)
+	query = postgres.CursorPagination(query, id, limit, order, cmp)
+
+	err := query.Select(&models)
+	return models, err
+}
+
+// IsNoRows - checks errors is pg.ErrNoRows or storage.ErrNotFound
+func (t *Table[M]) IsNoRows(err error) bool {
+	return errors.Is(err, pg.ErrNoRows) || errors.Is(err, models.ErrNotFound)
+}
+
+// Ping - checks connection to database
+func (t *Table[M]) Ping(ctx context.Context) error {
+	return t.db.Ping(ctx)
+}
+
+// DB - returns Postgres connection
+func (t *Table[M]) DB() *pg.DB {
+	return t.db
+}
+
+// excludeCurated - excludes columns which are changed by separate queries only, so update of the whole model doesn't overwrite them
+func excludeCurated(query *orm.Query, model any) *orm.Query {
+	if _, ok := model.(*models.Metadata); ok {
+		query.ExcludeColumn("tags", "code_status", "code_checked_at")
+	}
+	return query
+}
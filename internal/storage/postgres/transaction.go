@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/go-pg/pg/v10"
+	"github.com/pkg/errors"
+)
+
+var (
+	errNilTx = errors.New("nil transaction pointer")
+)
+
+// Transactable - realization of storage.Transactable interface for Postgres
+type Transactable struct {
+	db       *pg.DB
+	compress bool
+}
+
+// NewTransactable - creates transactable. If compress is set, ABI of metadata is written gzipped.
+func NewTransactable(db *pg.DB, compress bool) *Transactable {
+	return &Transactable{db, compress}
+}
+
+// BeginTransaction - opens atomic transaction
+func (t *Transactable) BeginTransaction(ctx context.Context) (storage.Transaction, error) {
+	tx, err := t.db.BeginContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{tx, t.compress}, nil
+}
+
+// Transaction -
+type Transaction struct {
+	tx       *pg.Tx
+	compress bool
+}
+
+// Flush -
+func (t *Transaction) Flush(ctx context.Context) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+	return t.tx.CommitContext(ctx)
+}
+
+// Add -
+func (t *Transaction) Add(ctx context.Context, model any) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+
+	return withCompressedABI(t.compress, model, func() error {
+		_, err := t.tx.ModelContext(ctx, model).Returning("id").Insert()
+		return err
+	})
+}
+
+// Update -
+func (t *Transaction) Update(ctx context.Context, model any) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+
+	return withCompressedABI(t.compress, model, func() error {
+		_, err := excludeCurated(t.tx.ModelContext(ctx, model), model).WherePK().Update()
+		return err
+	})
+}
+
+// withCompressedABI - calls write with ABI of metadata model gzipped if compression is enabled and restores raw ABI of the model after it. It's the only write path of metadata ABI, so rows are compressed the same way by transactions and tables. Hash of entry is computed from raw ABI, so it doesn't depend on compression.
+func withCompressedABI(compress bool, model any, write func() error) error {
+	m, ok := model.(*storage.Metadata)
+	if !compress || !ok || m.ABICompressed || len(m.Metadata) == 0 {
+		return write()
+	}
+
+	compressed, err := storage.CompressABI(m.Metadata)
+	if err != nil {
+		return err
+	}
+	raw := m.Metadata
+	m.Hash = m.ContentHash()
+	m.Metadata = compressed
+	m.ABICompressed = true
+	defer func() {
+		m.Metadata = raw
+		m.ABICompressed = false
+	}()
+	return write()
+}
+
+// BulkSave -
+func (t *Transaction) BulkSave(ctx context.Context, models []any) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := t.tx.ModelContext(ctx, &models).Returning("id").Insert()
+	return err
+}
+
+// Exec - executes raw query in transaction and returns count of affected rows
+func (t *Transaction) Exec(ctx context.Context, query string, params ...any) (int, error) {
+	if t.tx == nil {
+		return 0, errNilTx
+	}
+
+	result, err := t.tx.ExecContext(ctx, query, params...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// LockHash - locks metadata row by `SELECT ... FOR UPDATE` if its hash is equal to hash
+func (t *Transaction) LockHash(ctx context.Context, id uint64, hash []byte) (bool, error) {
+	locked, err := t.Exec(ctx, `SELECT 1 FROM metadata WHERE id = ?0 AND hash = ?1 FOR UPDATE`, id, hash)
+	if err != nil {
+		return false, err
+	}
+	return locked > 0, nil
+}
+
+// DeleteContent - deletes methods, events and errors of metadata
+func (t *Transaction) DeleteContent(ctx context.Context, metadataID uint64) error {
+	for _, query := range []string{
+		`DELETE FROM methods WHERE metadata_id = ?`,
+		`DELETE FROM events WHERE metadata_id = ?`,
+		`DELETE FROM errors WHERE metadata_id = ?`,
+	} {
+		if _, err := t.Exec(ctx, query, metadataID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback -
+func (t *Transaction) Rollback(ctx context.Context) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+	return t.tx.RollbackContext(ctx)
+}
+
+// Close -
+func (t *Transaction) Close(ctx context.Context) error {
+	if t.tx == nil {
+		return errNilTx
+	}
+	if err := t.tx.CloseContext(ctx); err != nil {
+		return err
+	}
+	t.tx = nil
+	return nil
+}
+
+// HandleError -
+func (t *Transaction) HandleError(ctx context.Context, err error) error {
+	processorErr := errors.Wrap(err, "transaction error")
+	if err := t.Rollback(ctx); err != nil {
+		return errors.Wrap(processorErr, errors.Wrap(err, "rollback").Error())
+	}
+	return processorErr
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+// ISignature -
+type ISignature interface {
+	storage.Table[*Signature]
+
+	Import(ctx context.Context, signatures []*Signature) (int, error)
+}
+
+// SignatureKind - kind of ABI entry which is identified by 4-byte selector. Functions and errors share 4-byte space, events are identified by 32-byte topic.
+type SignatureKind string
+
+// signature kinds
+const (
+	KindFunction SignatureKind = "function"
+	KindError    SignatureKind = "error"
+)
+
+// Signature - textual signature imported without ABI, e.g. from 4byte directory dump. It's used by reverse lookup of selectors along with signatures of indexed methods.
+type Signature struct {
+	// nolint
+	tableName struct{} `pg:"signatures"`
+
+	ID uint64
+
+	Signature string `pg:",notnull"`
+	// Kind - kind of entry declared by signature. The same text may be imported as function and as error.
+	Kind SignatureKind `pg:",notnull,default:'function'"`
+	// Selector - the first 4 bytes of keccak256 of signature
+	Selector []byte `pg:",notnull"`
+	// Topic - keccak256 of signature
+	Topic []byte `pg:",notnull"`
+}
+
+// TableName -
+func (Signature) TableName() string {
+	return "signatures"
+}
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+// Transactable - storage which can open transactions
+type Transactable interface {
+	BeginTransaction(ctx context.Context) (Transaction, error)
+}
+
+// Transaction - storage transaction which also supports raw queries, e.g. for bulk delete
+type Transaction interface {
+	storage.Transaction
+
+	Exec(ctx context.Context, query string, params ...any) (int, error)
+	// LockHash - locks metadata with id until the end of transaction if its hash is equal to hash. False is returned if metadata isn't found or its hash differs.
+	LockHash(ctx context.Context, id uint64, hash []byte) (bool, error)
+	// DeleteContent - deletes methods, events and errors of metadata with id
+	DeleteContent(ctx context.Context, metadataID uint64) error
+}
@@ -0,0 +1,85 @@
+package evm
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// bindEntry - ABI entry with keys of ABI specification only
+type bindEntry struct {
+	Type            string          `json:"type"`
+	Name            string          `json:"name,omitempty"`
+	Inputs          *[]bindArgument `json:"inputs,omitempty"`
+	Outputs         *[]bindArgument `json:"outputs,omitempty"`
+	StateMutability string          `json:"stateMutability,omitempty"`
+	Anonymous       bool            `json:"anonymous,omitempty"`
+}
+
+// bindArgument - parameter of ABI entry with keys of ABI specification only
+type bindArgument struct {
+	Name         string         `json:"name"`
+	Type         string         `json:"type"`
+	InternalType string         `json:"internalType,omitempty"`
+	Components   []bindArgument `json:"components,omitempty"`
+	Indexed      bool           `json:"indexed,omitempty"`
+}
+
+// BindABI - converts ABI JSON to the form which abigen and `bind` package of go-ethereum expect: legacy entries are normalized (see NormalizeABI), keys which aren't declared by ABI specification are removed (e.g. `gas` or `signature` added by some tools) and JSON is compacted. Result is checked to declare the same selectors of functions and errors and topics of events as source ABI.
+func BindABI(data []byte) ([]byte, error) {
+	normalized, err := NormalizeABI(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []bindEntry
+	if err := json.Unmarshal(normalized, &entries); err != nil {
+		return nil, err
+	}
+	result, err := json.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := abiIdentifiers(normalized)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := abiIdentifiers(result)
+	if err != nil {
+		return nil, errors.Wrap(err, "converted ABI")
+	}
+	if len(expected) != len(actual) {
+		return nil, errors.Errorf("converted ABI declares %d entries instead of %d", len(actual), len(expected))
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			return nil, errors.Errorf("converted ABI declares %s instead of %s", actual[i], expected[i])
+		}
+	}
+	return result, nil
+}
+
+// abiIdentifiers - parses ABI by go-ethereum and returns sorted selectors of functions and errors and topics of events
+func abiIdentifiers(data []byte) ([]string, error) {
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(parsed.Methods)+len(parsed.Events)+len(parsed.Errors))
+	for _, method := range parsed.Methods {
+		ids = append(ids, "function:"+hexutil.Encode(method.ID))
+	}
+	for _, event := range parsed.Events {
+		ids = append(ids, "event:"+event.ID.Hex())
+	}
+	for _, abiError := range parsed.Errors {
+		ids = append(ids, "error:"+hexutil.Encode(abiError.ID[:4]))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
@@ -0,0 +1,128 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// Argument - decoded argument. Value has JSON-friendly format: integers are decimal strings, addresses and bytes are hex strings, tuples are maps by component names. Typed is the same value with Solidity types of nested values.
+type Argument struct {
+	Name  string
+	Type  string
+	Value any
+	Typed Value
+}
+
+// DecodeConstructorArgs - unpacks ABI-encoded constructor arguments, i.e. tail of creation bytecode. If ABI doesn't contain constructor it's considered as constructor without arguments.
+func (vm *VirtualMachine) DecodeConstructorArgs(data []byte) ([]Argument, error) {
+	if vm.contractABI == nil {
+		return nil, ErrNilABI
+	}
+
+	inputs := vm.contractABI.Constructor.Inputs
+	if len(inputs) == 0 {
+		if len(data) > 0 {
+			return nil, errors.Wrapf(ErrInvalidArgs, "constructor has no arguments, but %d bytes are passed", len(data))
+		}
+		return []Argument{}, nil
+	}
+
+	return decodeArgs(inputs, data, constructorSignature(inputs))
+}
+
+// staticSize - returns encoded size of arguments if all of them have static types
+func staticSize(args abi.Arguments) (int, bool) {
+	var size int
+	for i := range args {
+		if isDynamic(args[i].Type) {
+			return 0, false
+		}
+		size += typeSize(args[i].Type)
+	}
+	return size, true
+}
+
+// typeSize - returns encoded size of static type
+func typeSize(typ abi.Type) int {
+	switch typ.T {
+	case abi.ArrayTy:
+		return typ.Size * typeSize(*typ.Elem)
+	case abi.TupleTy:
+		var size int
+		for _, elem := range typ.TupleElems {
+			size += typeSize(*elem)
+		}
+		return size
+	default:
+		return 32
+	}
+}
+
+func isDynamic(typ abi.Type) bool {
+	switch typ.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy:
+		return true
+	case abi.ArrayTy:
+		return isDynamic(*typ.Elem)
+	case abi.TupleTy:
+		for _, elem := range typ.TupleElems {
+			if isDynamic(*elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func constructorSignature(args abi.Arguments) string {
+	signature := "constructor("
+	for i := range args {
+		if i > 0 {
+			signature += ","
+		}
+		signature += args[i].Type.String()
+	}
+	return signature + ")"
+}
+
+// formatValue - converts unpacked value to JSON-friendly format
+func formatValue(typ abi.Type, value reflect.Value) any {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		if number, ok := value.Interface().(*big.Int); ok {
+			return number.String()
+		}
+		return fmt.Sprint(value.Interface())
+	case abi.AddressTy:
+		return value.Interface().(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(value.Bytes())
+	case abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		buf := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(buf), value)
+		return hexutil.Encode(buf)
+	case abi.SliceTy, abi.ArrayTy:
+		items := make([]any, value.Len())
+		for i := range items {
+			items[i] = formatValue(*typ.Elem, value.Index(i))
+		}
+		return items
+	case abi.TupleTy:
+		if value.Kind() == reflect.Ptr {
+			value = value.Elem()
+		}
+		fields := make(map[string]any, len(typ.TupleElems))
+		for i := range typ.TupleElems {
+			fields[typ.TupleRawNames[i]] = formatValue(*typ.TupleElems[i], value.Field(i))
+		}
+		return fields
+	default:
+		return value.Interface()
+	}
+}
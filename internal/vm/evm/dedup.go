@@ -0,0 +1,33 @@
+package evm
+
+import (
+	stdJSON "encoding/json"
+)
+
+// DedupABI - removes entries which are declared several times from ABI JSON. Entries are matched like by MergeABI: functions and errors by selector, events by topic, constructor, fallback and receive by type. The first entry of each key is kept, so order of entries is preserved. Count of removed entries is returned. Data is returned as is if ABI doesn't have duplicates.
+func DedupABI(data []byte) ([]byte, int, error) {
+	entries, err := abiEntries(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	known := make(map[string]struct{}, len(entries))
+	unique := make([]stdJSON.RawMessage, 0, len(entries))
+	for i := range entries {
+		if _, ok := known[entries[i].key]; ok {
+			continue
+		}
+		known[entries[i].key] = struct{}{}
+		unique = append(unique, entries[i].raw)
+	}
+
+	removed := len(entries) - len(unique)
+	if removed == 0 {
+		return data, 0, nil
+	}
+	deduped, err := json.Marshal(unique)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deduped, removed, nil
+}
@@ -0,0 +1,110 @@
+package evm
+
+import (
+	"testing"
+)
+
+const (
+	transferEntry      = `{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}`
+	transferEventEntry = `{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}`
+	insufficientEntry  = `{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"}]}`
+	constructorEntry   = `{"type":"constructor","stateMutability":"nonpayable","inputs":[]}`
+)
+
+func TestDedupABI(t *testing.T) {
+	tests := []struct {
+		name        string
+		abi         string
+		want        string
+		wantRemoved int
+	}{
+		{
+			name: "without duplicates",
+			abi:  "[" + transferEntry + "," + transferEventEntry + "," + insufficientEntry + "]",
+			want: "[" + transferEntry + "," + transferEventEntry + "," + insufficientEntry + "]",
+		}, {
+			name:        "duplicate function",
+			abi:         "[" + transferEntry + "," + transferEventEntry + "," + transferEntry + "]",
+			want:        "[" + transferEntry + "," + transferEventEntry + "]",
+			wantRemoved: 1,
+		}, {
+			name:        "function with renamed parameters",
+			abi:         "[" + transferEntry + `,{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"recipient","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`,
+			want:        "[" + transferEntry + "]",
+			wantRemoved: 1,
+		}, {
+			name:        "duplicate event",
+			abi:         "[" + transferEventEntry + "," + transferEventEntry + "," + transferEventEntry + "]",
+			want:        "[" + transferEventEntry + "]",
+			wantRemoved: 2,
+		}, {
+			name:        "duplicate error",
+			abi:         "[" + insufficientEntry + "," + transferEntry + "," + insufficientEntry + "]",
+			want:        "[" + insufficientEntry + "," + transferEntry + "]",
+			wantRemoved: 1,
+		}, {
+			name:        "duplicate constructor",
+			abi:         "[" + constructorEntry + "," + transferEntry + "," + constructorEntry + "]",
+			want:        "[" + constructorEntry + "," + transferEntry + "]",
+			wantRemoved: 1,
+		}, {
+			name: "overloaded function",
+			abi:  overloadedABI,
+			want: overloadedABI,
+		}, {
+			name: "event and function of the same name",
+			abi:  `[{"type":"event","name":"transfer","anonymous":false,"inputs":[{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]},` + transferEntry + "]",
+			want: `[{"type":"event","name":"transfer","anonymous":false,"inputs":[{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]},` + transferEntry + "]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deduped, removed, err := DedupABI([]byte(tt.abi))
+			if err != nil {
+				t.Fatalf("DedupABI: %v", err)
+			}
+			if removed != tt.wantRemoved {
+				t.Fatalf("removed = %d, want %d", removed, tt.wantRemoved)
+			}
+			if string(deduped) != tt.want {
+				t.Fatalf("ABI = %s, want %s", deduped, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupABIInvalid(t *testing.T) {
+	for _, data := range []string{``, `{}`, `[{"type":"function"`, `[{"type":"foo","name":"transfer"}]`} {
+		if _, _, err := DedupABI([]byte(data)); err == nil {
+			t.Fatalf("error isn't returned for %q", data)
+		}
+	}
+}
+
+func TestDuplicateEntriesAreIndexedOnce(t *testing.T) {
+	vm, err := NewVM([]byte("[" + transferEntry + "," + transferEventEntry + "," + insufficientEntry + "," + transferEntry + "," + transferEventEntry + "," + insufficientEntry + "]"))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	methods, err := vm.Methods()
+	if err != nil {
+		t.Fatalf("Methods: %v", err)
+	}
+	if len(methods) != 1 || methods[0].Name != "transfer" {
+		t.Fatalf("methods = %v, want single transfer", methods)
+	}
+	events, err := vm.Events()
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	if len(events) != 1 || events[0].Name != "Transfer" {
+		t.Fatalf("events = %v, want single Transfer", events)
+	}
+	abiErrors, err := vm.Errors()
+	if err != nil {
+		t.Fatalf("Errors: %v", err)
+	}
+	if len(abiErrors) != 1 || abiErrors[0].Name != "InsufficientBalance" {
+		t.Fatalf("errors = %v, want single InsufficientBalance", abiErrors)
+	}
+}
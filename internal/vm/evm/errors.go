@@ -4,5 +4,7 @@ import "errors"
 
 // errors
 var (
-	ErrNilABI = errors.New("nil contract ABI")
+	ErrNilABI          = errors.New("nil contract ABI")
+	ErrInvalidArgs     = errors.New("invalid ABI-encoded arguments")
+	ErrUnknownSelector = errors.New("unknown selector")
 )
@@ -0,0 +1,314 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// types of ABI entries parsed from human-readable signatures
+const (
+	FragmentFunction = "function"
+	FragmentEvent    = "event"
+	FragmentError    = "error"
+)
+
+const (
+	maxIndexedEvent          = 3
+	maxIndexedAnonymousEvent = 4
+)
+
+var fragmentToken = regexp.MustCompile(`[a-zA-Z0-9_$]+|\S`)
+
+// Fragment - ABI entry parsed from human-readable signature
+type Fragment struct {
+	// Type - type of ABI entry: `function`, `event` or `error`
+	Type string
+	// Name - name of function, event or error
+	Name string
+	// Signature - canonical signature with its selector and topic
+	Signature Signature
+	// Entry - JSON-encoded ABI entry
+	Entry []byte
+}
+
+// fragmentParameter - parameter of JSON ABI entry. Indexed is set for inputs of events only.
+type fragmentParameter struct {
+	Name       string              `json:"name"`
+	Type       string              `json:"type"`
+	Indexed    *bool               `json:"indexed,omitempty"`
+	Components []fragmentParameter `json:"components,omitempty"`
+}
+
+// fragmentEntry - JSON ABI entry
+type fragmentEntry struct {
+	Type            string              `json:"type"`
+	Name            string              `json:"name"`
+	Inputs          []fragmentParameter `json:"inputs"`
+	Outputs         []fragmentParameter `json:"outputs,omitempty"`
+	StateMutability string              `json:"stateMutability,omitempty"`
+	Anonymous       bool                `json:"anonymous,omitempty"`
+}
+
+// ParseFragment - parses human-readable signature like `function balanceOf(address owner) view returns (uint256)`, `event Transfer(address indexed from, address indexed to, uint256 value)` or `error InsufficientBalance(uint256 available, uint256 required)` to JSON ABI entry. Signature without keyword is a function. Parameter names, data locations (`memory`, `calldata`, `storage`) and visibility of functions are optional, aliases of types are replaced by canonical ones. Tuples are written in parentheses with optional `tuple` prefix. Functions without mutability are `nonpayable`. Unnamed components of tuples are named `name0`, `name1`, ... by their position, because ABI decoders map tuples to structures by names. Entry is validated by go-ethereum ABI parser.
+func ParseFragment(text string) (Fragment, error) {
+	p := &fragmentParser{
+		tokens: fragmentToken.FindAllString(text, -1),
+	}
+	entry, err := p.entry()
+	if err != nil {
+		return Fragment{}, errors.Wrapf(ErrInvalidSignature, "%s: %s", text, err.Error())
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return Fragment{}, err
+	}
+	sig, err := fragmentSignature(entry, data)
+	if err != nil {
+		return Fragment{}, errors.Wrapf(ErrInvalidSignature, "%s: %s", text, err.Error())
+	}
+	signature, err := ParseSignature(sig)
+	if err != nil {
+		return Fragment{}, err
+	}
+
+	return Fragment{
+		Type:      entry.Type,
+		Name:      entry.Name,
+		Signature: signature,
+		Entry:     data,
+	}, nil
+}
+
+// fragmentSignature - parses JSON ABI entry by go-ethereum and returns its canonical signature
+func fragmentSignature(entry *fragmentEntry, data []byte) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(data)
+	buf.WriteByte(']')
+
+	parsed, err := abi.JSON(&buf)
+	if err != nil {
+		return "", err
+	}
+	switch entry.Type {
+	case FragmentEvent:
+		if event, ok := parsed.Events[entry.Name]; ok {
+			return event.Sig, nil
+		}
+	case FragmentError:
+		if abiError, ok := parsed.Errors[entry.Name]; ok {
+			return abiError.Sig, nil
+		}
+	default:
+		if method, ok := parsed.Methods[entry.Name]; ok {
+			return method.Sig, nil
+		}
+	}
+	return "", errors.Errorf("%s %s isn't found in parsed ABI", entry.Type, entry.Name)
+}
+
+// fragmentParser - recursive descent parser of human-readable signature
+type fragmentParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *fragmentParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *fragmentParser) next() string {
+	token := p.peek()
+	if token != "" {
+		p.pos++
+	}
+	return token
+}
+
+func (p *fragmentParser) expect(token string) error {
+	if got := p.next(); got != token {
+		return unexpectedToken(got, token)
+	}
+	return nil
+}
+
+func unexpectedToken(got, want string) error {
+	if got == "" {
+		return errors.Errorf("unexpected end, expected %s", want)
+	}
+	return errors.Errorf("unexpected %s, expected %s", got, want)
+}
+
+func (p *fragmentParser) entry() (*fragmentEntry, error) {
+	entry := &fragmentEntry{
+		Type: FragmentFunction,
+	}
+	// keyword can be omitted for function, so `error(uint256)` is a function named `error`
+	if len(p.tokens) > 1 && p.tokens[1] != "(" {
+		switch p.peek() {
+		case FragmentFunction, FragmentEvent, FragmentError:
+			entry.Type = p.next()
+		}
+	}
+
+	entry.Name = p.next()
+	if !signatureName.MatchString(entry.Name) {
+		return nil, unexpectedToken(entry.Name, "name")
+	}
+
+	inputs, err := p.parameters(entry.Type == FragmentEvent)
+	if err != nil {
+		return nil, err
+	}
+	entry.Inputs = inputs
+
+	for token := p.next(); token != ""; token = p.next() {
+		switch {
+		case entry.Type == FragmentEvent && token == "anonymous" && !entry.Anonymous:
+			entry.Anonymous = true
+		case entry.Type == FragmentFunction && entry.StateMutability == "" && (token == "view" || token == "pure" || token == "payable" || token == "nonpayable"):
+			entry.StateMutability = token
+		case entry.Type == FragmentFunction && (token == "external" || token == "public"):
+		case entry.Type == FragmentFunction && token == "returns" && entry.Outputs == nil:
+			outputs, err := p.parameters(false)
+			if err != nil {
+				return nil, err
+			}
+			entry.Outputs = outputs
+		default:
+			return nil, errors.Errorf("unexpected %s", token)
+		}
+	}
+
+	if entry.Type == FragmentFunction && entry.StateMutability == "" {
+		entry.StateMutability = "nonpayable"
+	}
+	if entry.Type == FragmentEvent {
+		limit := maxIndexedEvent
+		if entry.Anonymous {
+			limit = maxIndexedAnonymousEvent
+		}
+		var indexed int
+		for i := range entry.Inputs {
+			if *entry.Inputs[i].Indexed {
+				indexed++
+			}
+		}
+		if indexed > limit {
+			return nil, errors.Errorf("too many indexed parameters: %d, maximum is %d", indexed, limit)
+		}
+	}
+	return entry, nil
+}
+
+// parameters - parses parenthesized list of parameters. Indexed flag is allowed and set for parameters of events only.
+func (p *fragmentParser) parameters(event bool) ([]fragmentParameter, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	params := make([]fragmentParameter, 0)
+	if p.peek() == ")" {
+		p.next()
+		return params, nil
+	}
+
+	for {
+		param, err := p.parameter(event)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+
+		switch token := p.next(); token {
+		case ")":
+			return params, nil
+		case ",":
+		default:
+			return nil, unexpectedToken(token, ", or )")
+		}
+	}
+}
+
+func (p *fragmentParser) parameter(event bool) (fragmentParameter, error) {
+	param, err := p.parameterType()
+	if err != nil {
+		return param, err
+	}
+	if event {
+		param.Indexed = new(bool)
+	}
+
+	for {
+		switch token := p.peek(); token {
+		case "indexed":
+			if !event || *param.Indexed {
+				return param, errors.Errorf("unexpected %s", token)
+			}
+			*param.Indexed = true
+			p.next()
+		case "memory", "calldata", "storage":
+			p.next()
+		default:
+			if signatureName.MatchString(token) {
+				param.Name = p.next()
+			}
+			return param, nil
+		}
+	}
+}
+
+func (p *fragmentParser) parameterType() (fragmentParameter, error) {
+	var param fragmentParameter
+	if p.peek() == "tuple" {
+		p.next()
+		if p.peek() != "(" {
+			return param, unexpectedToken(p.peek(), "(")
+		}
+	}
+
+	if p.peek() == "(" {
+		components, err := p.parameters(false)
+		if err != nil {
+			return param, err
+		}
+		for i := range components {
+			if components[i].Name == "" {
+				components[i].Name = fmt.Sprintf("name%d", i)
+			}
+		}
+		param.Type = "tuple"
+		param.Components = components
+	} else {
+		token := p.next()
+		elementary, ok := canonicalElementary(token)
+		if !ok {
+			return param, unexpectedToken(token, "type")
+		}
+		param.Type = elementary
+	}
+
+	for p.peek() == "[" {
+		p.next()
+		param.Type += "["
+		if size := p.peek(); size != "]" {
+			if _, err := strconv.ParseUint(size, 10, 32); err != nil {
+				return param, unexpectedToken(size, "array size")
+			}
+			param.Type += p.next()
+		}
+		if err := p.expect("]"); err != nil {
+			return param, err
+		}
+		param.Type += "]"
+	}
+	return param, nil
+}
@@ -0,0 +1,41 @@
+package evm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	stdJSON "encoding/json"
+	"sort"
+)
+
+// ABIHash - computes sha256 of ABI JSON ignoring formatting, order of keys of entries and order of entries. ABI fetched from different sources has the same hash if it declares the same entries.
+func ABIHash(data []byte) ([]byte, error) {
+	data, err := NormalizeABI(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []any
+	if err := stdJSON.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	canonical := make([][]byte, len(entries))
+	for i := range entries {
+		// keys of objects are sorted by encoder
+		entry, err := stdJSON.Marshal(entries[i])
+		if err != nil {
+			return nil, err
+		}
+		canonical[i] = entry
+	}
+	sort.Slice(canonical, func(i, j int) bool {
+		return bytes.Compare(canonical[i], canonical[j]) < 0
+	})
+
+	h := sha256.New()
+	for i := range canonical {
+		h.Write(canonical[i])
+		h.Write([]byte{'\n'})
+	}
+	return h.Sum(nil), nil
+}
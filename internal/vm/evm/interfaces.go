@@ -0,0 +1,100 @@
+package evm
+
+import "github.com/dipdup-net/abi-indexer/internal/storage"
+
+// Interface - standard contract interface which can be detected by ABI
+type Interface struct {
+	Name    string
+	Methods []string
+	Events  []string
+}
+
+// Interfaces - list of detected standard interfaces
+var Interfaces = []Interface{
+	{
+		Name: "ERC20",
+		Methods: []string{
+			"totalSupply()",
+			"balanceOf(address)",
+			"transfer(address,uint256)",
+			"transferFrom(address,address,uint256)",
+			"approve(address,uint256)",
+			"allowance(address,address)",
+		},
+		Events: []string{
+			"Transfer(address,address,uint256)",
+			"Approval(address,address,uint256)",
+		},
+	}, {
+		Name: "ERC721",
+		Methods: []string{
+			"balanceOf(address)",
+			"ownerOf(uint256)",
+			"safeTransferFrom(address,address,uint256)",
+			"safeTransferFrom(address,address,uint256,bytes)",
+			"transferFrom(address,address,uint256)",
+			"approve(address,uint256)",
+			"setApprovalForAll(address,bool)",
+			"getApproved(uint256)",
+			"isApprovedForAll(address,address)",
+		},
+		Events: []string{
+			"Transfer(address,address,uint256)",
+			"Approval(address,address,uint256)",
+			"ApprovalForAll(address,address,bool)",
+		},
+	}, {
+		Name: "ERC1155",
+		Methods: []string{
+			"balanceOf(address,uint256)",
+			"balanceOfBatch(address[],uint256[])",
+			"setApprovalForAll(address,bool)",
+			"isApprovedForAll(address,address)",
+			"safeTransferFrom(address,address,uint256,uint256,bytes)",
+			"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+		},
+		Events: []string{
+			"TransferSingle(address,address,address,uint256,uint256)",
+			"TransferBatch(address,address,address,uint256[],uint256[])",
+			"ApprovalForAll(address,address,bool)",
+		},
+	}, {
+		Name: "ERC165",
+		Methods: []string{
+			"supportsInterface(bytes4)",
+		},
+	},
+}
+
+// DetectInterfaces - returns names of standard interfaces which are fully implemented by methods and events. Result is never nil.
+func DetectInterfaces(methods []storage.Method, events []storage.Event) []string {
+	signatures := make(map[string]struct{}, len(methods)+len(events))
+	for i := range methods {
+		signatures[methods[i].Signature] = struct{}{}
+	}
+	for i := range events {
+		signatures[events[i].Signature] = struct{}{}
+	}
+
+	result := make([]string, 0)
+	for _, iface := range Interfaces {
+		if implements(signatures, iface) {
+			result = append(result, iface.Name)
+		}
+	}
+	return result
+}
+
+func implements(signatures map[string]struct{}, iface Interface) bool {
+	for _, method := range iface.Methods {
+		if _, ok := signatures[method]; !ok {
+			return false
+		}
+	}
+	for _, event := range iface.Events {
+		if _, ok := signatures[event]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package evm
+
+import (
+	stdJSON "encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// NormalizeABI - converts entries of ABI in pre-standard shapes emitted by old compilers to the modern form:
+//   - entry without `type` is a function;
+//   - absent `stateMutability` of functions, constructors and fallbacks is derived from deprecated `constant` and `payable` flags: `payable` is `payable`, `constant` is `view` and others are `nonpayable`. Flags are kept as solc 0.5 emits them along with `stateMutability`;
+//   - absent `inputs` and `outputs` are empty lists.
+//
+// Entries in modern form are kept byte by byte, so data is returned as is if all entries are modern.
+func NormalizeABI(data []byte) ([]byte, error) {
+	var raw []stdJSON.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	for i := range raw {
+		var entry map[string]any
+		if err := json.Unmarshal(raw[i], &entry); err != nil {
+			return nil, errors.Wrapf(err, "entry %d", i)
+		}
+		if !normalizeEntry(entry) {
+			continue
+		}
+		normalized, err := json.Marshal(entry)
+		if err != nil {
+			return nil, errors.Wrapf(err, "entry %d", i)
+		}
+		raw[i] = normalized
+		changed = true
+	}
+	if !changed {
+		return data, nil
+	}
+	return json.Marshal(raw)
+}
+
+// normalizeEntry - converts legacy fields of entry and reports if entry was changed
+func normalizeEntry(entry map[string]any) bool {
+	var changed bool
+	typ, _ := entry["type"].(string)
+	if typ == "" {
+		typ = "function"
+		entry["type"] = typ
+		changed = true
+	}
+
+	switch typ {
+	case "function", "constructor", "fallback", "receive":
+		if _, ok := entry["stateMutability"]; !ok {
+			entry["stateMutability"] = legacyMutability(entry)
+			changed = true
+		}
+	}
+
+	switch typ {
+	case "function":
+		changed = setEmptyList(entry, "inputs") || changed
+		changed = setEmptyList(entry, "outputs") || changed
+	case "constructor", "event", "error":
+		changed = setEmptyList(entry, "inputs") || changed
+	}
+	return changed
+}
+
+func legacyMutability(entry map[string]any) string {
+	if payable, _ := entry["payable"].(bool); payable {
+		return "payable"
+	}
+	if constant, _ := entry["constant"].(bool); constant {
+		return "view"
+	}
+	return "nonpayable"
+}
+
+func setEmptyList(entry map[string]any, key string) bool {
+	if value, ok := entry[key]; ok && value != nil {
+		return false
+	}
+	entry[key] = []any{}
+	return true
+}
@@ -0,0 +1,126 @@
+package evm
+
+import (
+	stdJSON "encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeABI(t *testing.T) {
+	tests := []struct {
+		name string
+		abi  string
+		want string
+	}{
+		{
+			name: "constant function",
+			abi:  `[{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"type":"function"}]`,
+			want: `[{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`,
+		}, {
+			name: "payable function",
+			abi:  `[{"constant":false,"inputs":[],"name":"deposit","outputs":[],"payable":true,"type":"function"}]`,
+			want: `[{"constant":false,"inputs":[],"name":"deposit","outputs":[],"payable":true,"stateMutability":"payable","type":"function"}]`,
+		}, {
+			name: "function without flags",
+			abi:  `[{"inputs":[{"name":"to","type":"address"}],"name":"transfer","outputs":[],"type":"function"}]`,
+			want: `[{"inputs":[{"name":"to","type":"address"}],"name":"transfer","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		}, {
+			name: "entry without type",
+			abi:  `[{"constant":true,"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}]}]`,
+			want: `[{"constant":true,"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"}]`,
+		}, {
+			name: "missing inputs and outputs",
+			abi:  `[{"name":"stop","type":"function","stateMutability":"nonpayable"}]`,
+			want: `[{"inputs":[],"name":"stop","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		}, {
+			name: "null inputs",
+			abi:  `[{"inputs":null,"name":"Paused","type":"event","anonymous":false}]`,
+			want: `[{"anonymous":false,"inputs":[],"name":"Paused","type":"event"}]`,
+		}, {
+			name: "payable constructor",
+			abi:  `[{"inputs":[{"name":"owner","type":"address"}],"payable":true,"type":"constructor"}]`,
+			want: `[{"inputs":[{"name":"owner","type":"address"}],"payable":true,"stateMutability":"payable","type":"constructor"}]`,
+		}, {
+			name: "fallback",
+			abi:  `[{"payable":false,"type":"fallback"}]`,
+			want: `[{"payable":false,"stateMutability":"nonpayable","type":"fallback"}]`,
+		}, {
+			name: "state mutability isn't overridden by flags",
+			abi:  `[{"constant":false,"inputs":[],"name":"balance","outputs":[],"payable":false,"stateMutability":"view","type":"function"}]`,
+			want: `[{"constant":false,"inputs":[],"name":"balance","outputs":[],"payable":false,"stateMutability":"view","type":"function"}]`,
+		}, {
+			name: "error without inputs",
+			abi:  `[{"name":"Unauthorized","type":"error"}]`,
+			want: `[{"inputs":[],"name":"Unauthorized","type":"error"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := NormalizeABI([]byte(tt.abi))
+			if err != nil {
+				t.Fatalf("NormalizeABI: %v", err)
+			}
+			var got, want any
+			if err := stdJSON.Unmarshal(normalized, &got); err != nil {
+				t.Fatalf("normalized ABI isn't JSON: %v", err)
+			}
+			if err := stdJSON.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatalf("invalid expected ABI: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("ABI = %s, want %s", normalized, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeABIModern(t *testing.T) {
+	modern := `[ {"type":"function","name":"foo","stateMutability":"view","inputs":[],"outputs":[]}, {"type":"receive","stateMutability":"payable"} ]`
+	normalized, err := NormalizeABI([]byte(modern))
+	if err != nil {
+		t.Fatalf("NormalizeABI: %v", err)
+	}
+	if string(normalized) != modern {
+		t.Fatalf("modern ABI is changed: %s", normalized)
+	}
+}
+
+func TestNormalizeABIInvalid(t *testing.T) {
+	for _, data := range []string{``, `{}`, `[1]`, `[{"type":"function"`} {
+		if _, err := NormalizeABI([]byte(data)); err == nil {
+			t.Fatalf("error isn't returned for %q", data)
+		}
+	}
+}
+
+func TestLegacyABIIsParsed(t *testing.T) {
+	legacy := `[
+		{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"payable":false},
+		{"constant":false,"inputs":[],"name":"deposit","outputs":[],"payable":true,"type":"function"},
+		{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"}],"name":"Deposit","type":"event"}
+	]`
+	vm, err := NewVM([]byte(legacy))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	methods, err := vm.Methods()
+	if err != nil {
+		t.Fatalf("Methods: %v", err)
+	}
+	signatures := make(map[string]struct{}, len(methods))
+	for i := range methods {
+		signatures[methods[i].Signature] = struct{}{}
+	}
+	for _, signature := range []string{"balanceOf(address)", "deposit()"} {
+		if _, ok := signatures[signature]; !ok {
+			t.Fatalf("method %s isn't parsed: %v", signature, methods)
+		}
+	}
+	events, err := vm.Events()
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	if len(events) != 1 || events[0].Signature != "Deposit(address)" {
+		t.Fatalf("events = %v, want Deposit(address)", events)
+	}
+}
@@ -0,0 +1,140 @@
+package evm
+
+import (
+	"bytes"
+	stdJSON "encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// MergeResult - ABI merged from two ABI JSON documents
+type MergeResult struct {
+	ABI []byte
+	// Added - count of entries of the second ABI which were appended
+	Added int
+	// Conflicts - signatures of entries which are declared in both ABI with different content. Entries of the first ABI are kept for them.
+	Conflicts []string
+}
+
+// MergeABI - appends entries of extra absent in base to base. Functions and errors are matched by selector, events by topic, constructor, fallback and receive by type. If both ABI declare entry with the same key, entry of base takes precedence: it's kept as is and the key is reported as conflict if entries differ, e.g. by names or types of outputs.
+func MergeABI(base, extra []byte) (*MergeResult, error) {
+	baseEntries, err := abiEntries(base)
+	if err != nil {
+		return nil, errors.Wrap(err, "base ABI")
+	}
+	extraEntries, err := abiEntries(extra)
+	if err != nil {
+		return nil, errors.Wrap(err, "merged ABI")
+	}
+
+	known := make(map[string]stdJSON.RawMessage, len(baseEntries))
+	merged := make([]stdJSON.RawMessage, 0, len(baseEntries)+len(extraEntries))
+	for i := range baseEntries {
+		known[baseEntries[i].key] = baseEntries[i].raw
+		merged = append(merged, baseEntries[i].raw)
+	}
+
+	result := &MergeResult{
+		Conflicts: make([]string, 0),
+	}
+	for i := range extraEntries {
+		existing, ok := known[extraEntries[i].key]
+		if !ok {
+			known[extraEntries[i].key] = extraEntries[i].raw
+			merged = append(merged, extraEntries[i].raw)
+			result.Added++
+			continue
+		}
+		if !sameEntry(existing, extraEntries[i].raw) {
+			result.Conflicts = append(result.Conflicts, extraEntries[i].signature)
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	result.ABI = data
+	return result, nil
+}
+
+type abiEntry struct {
+	key       string
+	signature string
+	raw       stdJSON.RawMessage
+}
+
+// abiEntries - splits ABI JSON to entries and computes their keys
+func abiEntries(data []byte) ([]abiEntry, error) {
+	data, err := NormalizeABI(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []stdJSON.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	entries := make([]abiEntry, 0, len(raw))
+	for i := range raw {
+		var single abi.ABI
+		if err := json.Unmarshal(append(append([]byte{'['}, raw[i]...), ']'), &single); err != nil {
+			return nil, errors.Wrapf(err, "entry %d", i)
+		}
+		var header struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw[i], &header); err != nil {
+			return nil, errors.Wrapf(err, "entry %d", i)
+		}
+		entry, ok := entryKey(header.Type, &single)
+		if !ok {
+			return nil, errors.Errorf("entry %d: unknown type", i)
+		}
+		entry.raw = raw[i]
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func entryKey(typ string, single *abi.ABI) (abiEntry, bool) {
+	switch typ {
+	case "constructor", "fallback", "receive":
+		return abiEntry{key: typ, signature: typ}, true
+	}
+	for _, method := range single.Methods {
+		return abiEntry{
+			key:       fmt.Sprintf("function:%s", hexutil.Encode(method.ID)),
+			signature: method.Sig,
+		}, true
+	}
+	for _, event := range single.Events {
+		return abiEntry{
+			key:       fmt.Sprintf("event:%s", event.ID.Hex()),
+			signature: event.Sig,
+		}, true
+	}
+	for _, abiError := range single.Errors {
+		return abiEntry{
+			key:       fmt.Sprintf("error:%s", hexutil.Encode(abiError.ID[:4])),
+			signature: abiError.Sig,
+		}, true
+	}
+	return abiEntry{}, false
+}
+
+// sameEntry - compares entries ignoring formatting
+func sameEntry(a, b stdJSON.RawMessage) bool {
+	var bufA, bufB bytes.Buffer
+	if err := stdJSON.Compact(&bufA, a); err != nil {
+		return false
+	}
+	if err := stdJSON.Compact(&bufB, b); err != nil {
+		return false
+	}
+	return bytes.Equal(bufA.Bytes(), bufB.Bytes())
+}
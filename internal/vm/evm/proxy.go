@@ -0,0 +1,40 @@
+package evm
+
+import "github.com/dipdup-net/abi-indexer/internal/storage"
+
+// proxySignatures - methods and events which are declared by ABI of proxy contracts: EIP-1967 transparent and beacon proxies, EIP-897 delegate proxies and EIP-2535 diamonds
+var proxySignatures = []string{
+	"implementation()",
+	"Upgraded(address)",
+	"BeaconUpgraded(address)",
+	"facetAddress(bytes4)",
+	"DiamondCut((address,uint8,bytes4[])[],address,bytes)",
+}
+
+// implementationSignatures - methods which are declared by implementations of EIP-1822 (UUPS) proxies. Such contracts declare upgrade methods and events too, but they are implementations, not proxies.
+var implementationSignatures = []string{
+	"proxiableUUID()",
+}
+
+// DetectProxy - classifies the contract as proxy by its methods and events. Proxies which ABI doesn't declare any of proxy methods and events, e.g. EIP-1167 minimal proxies, aren't detected.
+func DetectProxy(methods []storage.Method, events []storage.Event) bool {
+	signatures := make(map[string]struct{}, len(methods)+len(events))
+	for i := range methods {
+		signatures[methods[i].Signature] = struct{}{}
+	}
+	for i := range events {
+		signatures[events[i].Signature] = struct{}{}
+	}
+
+	for _, signature := range implementationSignatures {
+		if _, ok := signatures[signature]; ok {
+			return false
+		}
+	}
+	for _, signature := range proxySignatures {
+		if _, ok := signatures[signature]; ok {
+			return true
+		}
+	}
+	return false
+}
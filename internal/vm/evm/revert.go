@@ -0,0 +1,152 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// standard error selectors which are emitted by Solidity
+var (
+	ErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	PanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+var (
+	stringType, _  = abi.NewType("string", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+
+	standardErrors = []abi.Error{
+		abi.NewError("Error", abi.Arguments{{Name: "reason", Type: stringType}}),
+		abi.NewError("Panic", abi.Arguments{{Name: "code", Type: uint256Type}}),
+	}
+)
+
+// panicCodes - descriptions of Solidity panic codes
+var panicCodes = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion to invalid enum value",
+	0x22: "access to incorrectly encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "too much memory allocated",
+	0x51: "call of zero-initialized internal function",
+}
+
+// DecodedError - decoded revert data
+type DecodedError struct {
+	Name      string
+	Signature string
+	Args      []Argument
+	// Description - human-readable meaning of the error. It's filled for `Panic(uint256)` only.
+	Description string
+}
+
+// IsStandardError - checks if revert data has selector of `Error(string)` or `Panic(uint256)`. They can be decoded without ABI of contract.
+func IsStandardError(data []byte) bool {
+	return len(data) >= 4 && (bytes.Equal(data[:4], ErrorSelector) || bytes.Equal(data[:4], PanicSelector))
+}
+
+// DecodeStandardError - decodes `Error(string)` and `Panic(uint256)` revert data
+func DecodeStandardError(data []byte) (*DecodedError, error) {
+	if !IsStandardError(data) {
+		return nil, errors.Wrapf(ErrUnknownSelector, "%s is not a standard error selector", selectorHex(data))
+	}
+	for i := range standardErrors {
+		if bytes.Equal(standardErrors[i].ID[:4], data[:4]) {
+			return decodeError(standardErrors[i], data)
+		}
+	}
+	return nil, errors.Wrap(ErrUnknownSelector, selectorHex(data))
+}
+
+// DecodeError - finds custom error of ABI by 4-byte selector of revert data and unpacks its parameters. Errors share selector space with methods, so only error entries are matched and methods with the same selector are ignored. Standard `Error(string)` and `Panic(uint256)` are decoded even if ABI doesn't declare them.
+func (vm *VirtualMachine) DecodeError(data []byte) (*DecodedError, error) {
+	if vm.contractABI == nil {
+		return nil, ErrNilABI
+	}
+	if len(data) < 4 {
+		return nil, errors.Wrapf(ErrInvalidArgs, "revert data is %d bytes, but selector is 4 bytes", len(data))
+	}
+
+	for _, abiError := range vm.contractABI.Errors {
+		if bytes.Equal(abiError.ID[:4], data[:4]) {
+			return decodeError(abiError, data)
+		}
+	}
+	if IsStandardError(data) {
+		return DecodeStandardError(data)
+	}
+	return nil, errors.Wrapf(ErrUnknownSelector, "error with selector %s is not found in ABI", selectorHex(data))
+}
+
+func decodeError(abiError abi.Error, data []byte) (*DecodedError, error) {
+	args, err := decodeArgs(abiError.Inputs, data[4:], abiError.Sig)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &DecodedError{
+		Name:      abiError.Name,
+		Signature: abiError.Sig,
+		Args:      args,
+	}
+	if bytes.Equal(data[:4], PanicSelector) && len(args) == 1 {
+		decoded.Description = panicDescription(args[0].Value)
+	}
+	return decoded, nil
+}
+
+// decodeArgs - unpacks ABI-encoded arguments. Signature is used in error messages.
+func decodeArgs(inputs abi.Arguments, data []byte, signature string) ([]Argument, error) {
+	if len(data)%32 != 0 {
+		return nil, errors.Wrapf(ErrInvalidArgs, "%s: length %d is not a multiple of 32 bytes", signature, len(data))
+	}
+	if size, ok := staticSize(inputs); ok && size != len(data) {
+		return nil, errors.Wrapf(ErrInvalidArgs, "%s expects %d bytes, but %d bytes are passed", signature, size, len(data))
+	}
+
+	values, err := inputs.UnpackValues(data)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidArgs, "%s: %s", signature, err.Error())
+	}
+
+	args := make([]Argument, len(inputs))
+	for i := range inputs {
+		args[i] = Argument{
+			Name:  inputs[i].Name,
+			Type:  inputs[i].Type.String(),
+			Value: formatValue(inputs[i].Type, reflect.ValueOf(values[i])),
+			Typed: typedValue(inputs[i].Type, reflect.ValueOf(values[i])),
+		}
+	}
+	return args, nil
+}
+
+func panicDescription(value any) string {
+	code, ok := new(big.Int).SetString(fmt.Sprint(value), 10)
+	if !ok {
+		return ""
+	}
+	if code.IsUint64() {
+		if description, ok := panicCodes[code.Uint64()]; ok {
+			return description
+		}
+	}
+	return "unknown panic code"
+}
+
+func selectorHex(data []byte) string {
+	if len(data) > 4 {
+		data = data[:4]
+	}
+	return hexutil.Encode(data)
+}
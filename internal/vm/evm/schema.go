@@ -0,0 +1,151 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// patterns of string-encoded values
+const (
+	patternUint    = "^[0-9]+$"
+	patternInt     = "^-?[0-9]+$"
+	patternAddress = "^0x[0-9a-fA-F]{40}$"
+	patternBytes   = "^0x([0-9a-fA-F]{2})*$"
+)
+
+// MethodSchema - JSON schema of method inputs
+type MethodSchema struct {
+	Name      string
+	Signature string
+	Schema    []byte
+}
+
+// jsonSchema - node of JSON schema document
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+}
+
+// InputSchema - finds method of ABI by 4-byte selector and builds JSON schema of its inputs. Inputs are properties of root object keyed by parameter names, unnamed parameters are keyed by their position. Integers are decimal strings, because they can exceed precision of JSON numbers, addresses and bytes are hex strings.
+func (vm *VirtualMachine) InputSchema(selector []byte) (*MethodSchema, error) {
+	if vm.contractABI == nil {
+		return nil, ErrNilABI
+	}
+	if len(selector) != 4 {
+		return nil, errors.Wrapf(ErrInvalidArgs, "selector should be 4 bytes, got %d", len(selector))
+	}
+
+	for _, method := range vm.contractABI.Methods {
+		if !bytes.Equal(method.ID, selector) {
+			continue
+		}
+
+		schema := objectSchema(method.Inputs)
+		schema.Schema = jsonSchemaDraft
+		schema.Title = method.RawName
+
+		data, err := json.Marshal(schema)
+		if err != nil {
+			return nil, err
+		}
+		return &MethodSchema{
+			Name:      method.RawName,
+			Signature: method.Sig,
+			Schema:    data,
+		}, nil
+	}
+	return nil, errors.Wrapf(ErrUnknownSelector, "method with selector %s is not found in ABI", selectorHex(selector))
+}
+
+func objectSchema(args abi.Arguments) *jsonSchema {
+	names := make([]string, len(args))
+	types := make([]*abi.Type, len(args))
+	for i := range args {
+		names[i] = args[i].Name
+		types[i] = &args[i].Type
+	}
+	return tupleSchema(names, types)
+}
+
+func tupleSchema(names []string, types []*abi.Type) *jsonSchema {
+	additional := false
+	schema := &jsonSchema{
+		Type:                 "object",
+		Properties:           make(map[string]*jsonSchema, len(types)),
+		Required:             make([]string, 0, len(types)),
+		AdditionalProperties: &additional,
+	}
+	for i := range types {
+		name := fmt.Sprintf("%d", i)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		property := typeSchema(*types[i])
+		property.Title = name
+		schema.Properties[name] = property
+		schema.Required = append(schema.Required, name)
+	}
+	return schema
+}
+
+// typeSchema - returns schema of ABI type. Arrays and tuples are handled recursively.
+func typeSchema(typ abi.Type) *jsonSchema {
+	schema := &jsonSchema{
+		Description: typ.String(),
+	}
+
+	switch typ.T {
+	case abi.UintTy:
+		schema.Type = "string"
+		schema.Pattern = patternUint
+	case abi.IntTy:
+		schema.Type = "string"
+		schema.Pattern = patternInt
+	case abi.BoolTy:
+		schema.Type = "boolean"
+	case abi.StringTy:
+		schema.Type = "string"
+	case abi.AddressTy:
+		schema.Type = "string"
+		schema.Pattern = patternAddress
+	case abi.BytesTy:
+		schema.Type = "string"
+		schema.Pattern = patternBytes
+	case abi.FixedBytesTy, abi.HashTy:
+		schema.Type = "string"
+		schema.Pattern = fmt.Sprintf("^0x[0-9a-fA-F]{%d}$", typ.Size*2)
+	case abi.FunctionTy:
+		schema.Type = "string"
+		schema.Pattern = "^0x[0-9a-fA-F]{48}$"
+	case abi.SliceTy:
+		schema.Type = "array"
+		schema.Items = typeSchema(*typ.Elem)
+	case abi.ArrayTy:
+		size := typ.Size
+		schema.Type = "array"
+		schema.Items = typeSchema(*typ.Elem)
+		schema.MinItems = &size
+		schema.MaxItems = &size
+	case abi.TupleTy:
+		tuple := tupleSchema(typ.TupleRawNames, typ.TupleElems)
+		tuple.Description = schema.Description
+		return tuple
+	default:
+		schema.Type = "string"
+	}
+	return schema
+}
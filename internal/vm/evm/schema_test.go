@@ -0,0 +1,151 @@
+package evm
+
+import (
+	"regexp"
+	"testing"
+)
+
+const integersABI = `[
+	{"type":"function","name":"f","stateMutability":"nonpayable","inputs":[{"name":"a","type":"uint256"},{"name":"b","type":"int8"},{"name":"c","type":"uint64[]"},{"name":"d","type":"tuple","components":[{"name":"x","type":"int128"},{"name":"y","type":"address"}]},{"name":"e","type":"bool"}],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"event","name":"E","anonymous":false,"inputs":[{"name":"v","type":"uint256","indexed":true}]}
+]`
+
+// schemaNode - returns node of schema by path of keys and indices
+func schemaNode(t *testing.T, node any, path ...any) map[string]any {
+	t.Helper()
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			object, ok := node.(map[string]any)
+			if !ok {
+				t.Fatalf("node isn't object at %v", key)
+			}
+			node = object[key]
+		case int:
+			list, ok := node.([]any)
+			if !ok || len(list) <= key {
+				t.Fatalf("node isn't list with item %d", key)
+			}
+			node = list[key]
+		}
+	}
+	object, ok := node.(map[string]any)
+	if !ok {
+		t.Fatalf("node %v isn't found", path)
+	}
+	return object
+}
+
+func TestJSONSchemaIntegers(t *testing.T) {
+	vm, err := NewVM([]byte(integersABI))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	data, err := vm.JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("schema isn't JSON: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    []any
+		typ     string
+		pattern string
+	}{
+		{"uint256", []any{"f", "inputs", "properties", "a"}, "string", patternUint},
+		{"int8", []any{"f", "inputs", "properties", "b"}, "string", patternInt},
+		{"array item", []any{"f", "inputs", "properties", "c", "items", 0}, "string", patternUint},
+		{"tuple component", []any{"f", "inputs", "properties", "d", "properties", "x"}, "string", patternInt},
+		{"output", []any{"f", "outputs", "properties", "uint8_00"}, "string", patternUint},
+		{"event input", []any{"E", "inputs", "properties", "v"}, "string", patternUint},
+		{"address", []any{"f", "inputs", "properties", "d", "properties", "y"}, "string", ""},
+		{"bool", []any{"f", "inputs", "properties", "e"}, "boolean", ""},
+		{"array", []any{"f", "inputs", "properties", "c"}, "array", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := schemaNode(t, schema, tt.path...)
+			if node["type"] != tt.typ {
+				t.Fatalf("type = %v, want %s", node["type"], tt.typ)
+			}
+			pattern, _ := node["pattern"].(string)
+			if pattern != tt.pattern {
+				t.Fatalf("pattern = %q, want %q", pattern, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestIntegerPatterns(t *testing.T) {
+	tests := []struct {
+		value string
+		uint  bool
+		int   bool
+	}{
+		{"0", true, true},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639935", true, true},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", false, true},
+		{"1e18", false, false},
+		{"0x10", false, false},
+		{"1.5", false, false},
+		{" 1", false, false},
+		{"", false, false},
+	}
+	uintRegexp, intRegexp := regexp.MustCompile(patternUint), regexp.MustCompile(patternInt)
+	for _, tt := range tests {
+		if got := uintRegexp.MatchString(tt.value); got != tt.uint {
+			t.Fatalf("uint pattern matches %q = %v, want %v", tt.value, got, tt.uint)
+		}
+		if got := intRegexp.MatchString(tt.value); got != tt.int {
+			t.Fatalf("int pattern matches %q = %v, want %v", tt.value, got, tt.int)
+		}
+	}
+}
+
+func TestStringifyIntegersKeepsOtherNumbers(t *testing.T) {
+	node := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"fixed":   map[string]any{"type": "number", "internal_type": "fixed128x18"},
+			"unknown": map[string]any{"type": "number"},
+		},
+	}
+	stringifyIntegers(node)
+	for _, name := range []string{"fixed", "unknown"} {
+		property := schemaNode(t, node, "properties", name)
+		if property["type"] != "number" {
+			t.Fatalf("%s: type = %v, want number", name, property["type"])
+		}
+		if _, ok := property["pattern"]; ok {
+			t.Fatalf("%s: pattern is set", name)
+		}
+	}
+}
+
+func TestInputSchemaIntegers(t *testing.T) {
+	vm, err := NewVM([]byte(integersABI))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	method, err := vm.InputSchema([]byte{0xfc, 0xd1, 0xfb, 0x9a})
+	if err != nil {
+		t.Fatalf("InputSchema: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(method.Schema, &schema); err != nil {
+		t.Fatalf("schema isn't JSON: %v", err)
+	}
+	for name, pattern := range map[string]string{"a": patternUint, "b": patternInt} {
+		property := schemaNode(t, schema, "properties", name)
+		if property["type"] != "string" || property["pattern"] != pattern {
+			t.Fatalf("%s: type %v with pattern %v, want string with %s", name, property["type"], property["pattern"], pattern)
+		}
+	}
+	if item := schemaNode(t, schema, "properties", "c", "items"); item["pattern"] != patternUint {
+		t.Fatalf("array item pattern = %v, want %s", item["pattern"], patternUint)
+	}
+}
@@ -0,0 +1,168 @@
+package evm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// errors
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+var (
+	signatureName = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+	arraySuffix   = regexp.MustCompile(`(\[[0-9]*\])+$`)
+
+	integerType    = regexp.MustCompile(`^(u?int)([0-9]+)$`)
+	fixedBytesType = regexp.MustCompile(`^bytes([0-9]+)$`)
+	fixedPointType = regexp.MustCompile(`^(u?fixed)([0-9]+)x([0-9]+)$`)
+)
+
+// Signature - textual signature with its hashes
+type Signature struct {
+	// Text - canonical signature without spaces, e.g. `transfer(address,uint256)`
+	Text string
+	// Selector - the first 4 bytes of keccak256 of signature. It identifies functions and errors.
+	Selector []byte
+	// Topic - keccak256 of signature. It identifies events.
+	Topic []byte
+}
+
+// ParseSignature - validates textual signature like ones of 4byte directory and computes its selector and topic. Spaces are removed and aliases are replaced by canonical types, e.g. `uint` by `uint256`. Parameter names aren't allowed. Tuples are written in parentheses, e.g. `swap((address,uint256)[],bytes)`.
+func ParseSignature(text string) (Signature, error) {
+	text = strings.Join(strings.Fields(text), "")
+
+	open := strings.IndexByte(text, '(')
+	if open <= 0 || !strings.HasSuffix(text, ")") {
+		return Signature{}, errors.Wrap(ErrInvalidSignature, text)
+	}
+	if !signatureName.MatchString(text[:open]) {
+		return Signature{}, errors.Wrapf(ErrInvalidSignature, "%s: invalid name", text)
+	}
+	list, err := canonicalTypes(text[open+1 : len(text)-1])
+	if err != nil {
+		return Signature{}, errors.Wrapf(ErrInvalidSignature, "%s: %s", text, err.Error())
+	}
+	text = text[:open+1] + list + ")"
+
+	topic := Keccak256([]byte(text))
+	return Signature{
+		Text:     text,
+		Selector: topic[:4],
+		Topic:    topic,
+	}, nil
+}
+
+// Keccak256 - returns keccak256 hash of data. Selector of signature is its first 4 bytes and topic is the whole hash.
+func Keccak256(data []byte) []byte {
+	return crypto.Keccak256(data)
+}
+
+// canonicalTypes - validates comma-separated list of types and returns it in canonical form. Empty list is valid.
+func canonicalTypes(list string) (string, error) {
+	if list == "" {
+		return "", nil
+	}
+	types, err := splitTypes(list)
+	if err != nil {
+		return "", err
+	}
+	for i := range types {
+		canonical, err := canonicalType(types[i])
+		if err != nil {
+			return "", err
+		}
+		types[i] = canonical
+	}
+	return strings.Join(types, ","), nil
+}
+
+func canonicalType(typ string) (string, error) {
+	suffix := arraySuffix.FindString(typ)
+	base := strings.TrimSuffix(typ, suffix)
+	if strings.HasPrefix(base, "(") {
+		if !strings.HasSuffix(base, ")") {
+			return "", errors.Errorf("invalid tuple: %s", typ)
+		}
+		list, err := canonicalTypes(base[1 : len(base)-1])
+		if err != nil {
+			return "", err
+		}
+		return "(" + list + ")" + suffix, nil
+	}
+	elementary, ok := canonicalElementary(base)
+	if !ok {
+		return "", errors.Errorf("invalid type: %s", typ)
+	}
+	return elementary + suffix, nil
+}
+
+// canonicalElementary - returns canonical name of elementary type. Sizes of integers, fixed point numbers and fixed bytes are checked.
+func canonicalElementary(typ string) (string, bool) {
+	switch typ {
+	case "address", "bool", "string", "bytes", "function":
+		return typ, true
+	case "uint", "int":
+		return typ + "256", true
+	case "fixed", "ufixed":
+		return typ + "128x18", true
+	}
+
+	if match := integerType.FindStringSubmatch(typ); match != nil {
+		size, err := strconv.Atoi(match[2])
+		return typ, err == nil && size >= 8 && size <= 256 && size%8 == 0
+	}
+	if match := fixedBytesType.FindStringSubmatch(typ); match != nil {
+		size, err := strconv.Atoi(match[1])
+		return typ, err == nil && size >= 1 && size <= 32
+	}
+	if match := fixedPointType.FindStringSubmatch(typ); match != nil {
+		size, err := strconv.Atoi(match[2])
+		if err != nil || size < 8 || size > 256 || size%8 != 0 {
+			return "", false
+		}
+		decimals, err := strconv.Atoi(match[3])
+		return typ, err == nil && decimals >= 0 && decimals <= 80
+	}
+	return "", false
+}
+
+// splitTypes - splits list by commas which aren't nested in tuples
+func splitTypes(list string) ([]string, error) {
+	var (
+		types = make([]string, 0)
+		depth int
+		start int
+	)
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				types = append(types, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	types = append(types, list[start:])
+	for i := range types {
+		if types[i] == "" {
+			return nil, errors.New("empty type")
+		}
+	}
+	return types, nil
+}
@@ -0,0 +1,78 @@
+package evm
+
+import (
+	"bytes"
+	stdJSON "encoding/json"
+	"strings"
+)
+
+// DecodeTable - entries of ABI keyed by hex-encoded identifiers which are matched against chain data: 4-byte selectors of functions and errors and 32-byte topics of events
+type DecodeTable struct {
+	Functions map[string]DecodeTableEntry
+	Events    map[string]DecodeTableEntry
+	Errors    map[string]DecodeTableEntry
+}
+
+// DecodeTableEntry - ABI entry of decode table
+type DecodeTableEntry struct {
+	Name      string
+	Signature string
+	// Fragment - compact JSON of ABI entry in the modern form (see NormalizeABI)
+	Fragment []byte
+	// Anonymous - event is anonymous, so its logs don't contain the topic
+	Anonymous bool
+}
+
+// NewDecodeTable - builds decode table of ABI JSON. Selectors and topics are computed by the same parser as during indexing. Constructor, fallback and receive aren't identified by selector, so they are skipped. If several entries have the same identifier the first of them is kept as by DedupABI.
+func NewDecodeTable(data []byte) (*DecodeTable, error) {
+	entries, err := abiEntries(data)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &DecodeTable{
+		Functions: make(map[string]DecodeTableEntry),
+		Events:    make(map[string]DecodeTableEntry),
+		Errors:    make(map[string]DecodeTableEntry),
+	}
+	for i := range entries {
+		kind, id, ok := strings.Cut(entries[i].key, ":")
+		if !ok {
+			continue
+		}
+
+		var target map[string]DecodeTableEntry
+		switch kind {
+		case "function":
+			target = table.Functions
+		case "event":
+			target = table.Events
+		case "error":
+			target = table.Errors
+		default:
+			continue
+		}
+		if _, ok := target[id]; ok {
+			continue
+		}
+
+		var header struct {
+			Name      string `json:"name"`
+			Anonymous bool   `json:"anonymous"`
+		}
+		if err := json.Unmarshal(entries[i].raw, &header); err != nil {
+			return nil, err
+		}
+		var fragment bytes.Buffer
+		if err := stdJSON.Compact(&fragment, entries[i].raw); err != nil {
+			return nil, err
+		}
+		target[id] = DecodeTableEntry{
+			Name:      header.Name,
+			Signature: entries[i].signature,
+			Fragment:  fragment.Bytes(),
+			Anonymous: header.Anonymous,
+		}
+	}
+	return table, nil
+}
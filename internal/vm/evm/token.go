@@ -0,0 +1,104 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// token issue codes
+const (
+	IssueMissingMethod   = "missing_method"
+	IssueMissingEvent    = "missing_event"
+	IssueNoBoolReturn    = "no_bool_return"
+	IssueMissingDecimals = "missing_decimals"
+	IssueInvalidDecimals = "invalid_decimals"
+	IssueMissingName     = "missing_name"
+	IssueMissingSymbol   = "missing_symbol"
+	IssueIndexedValue    = "indexed_value"
+)
+
+// TokenIssue - deviation of token ABI from ERC20 standard
+type TokenIssue struct {
+	Code    string
+	Message string
+}
+
+// TokenAnalysis -
+type TokenAnalysis struct {
+	IsToken bool
+	Issues  []TokenIssue
+}
+
+// AnalyzeToken - checks ABI shape of ERC20-like contract against the standard. Contract is considered ERC20-like if it has `transfer(address,uint256)` and `balanceOf(address)` methods.
+func (vm *VirtualMachine) AnalyzeToken() (*TokenAnalysis, error) {
+	if vm.contractABI == nil {
+		return nil, ErrNilABI
+	}
+
+	methods := make(map[string]abi.Method, len(vm.contractABI.Methods))
+	for _, method := range vm.contractABI.Methods {
+		methods[method.Sig] = method
+	}
+	events := make(map[string]abi.Event, len(vm.contractABI.Events))
+	for _, event := range vm.contractABI.Events {
+		events[event.Sig] = event
+	}
+
+	analysis := &TokenAnalysis{
+		Issues: make([]TokenIssue, 0),
+	}
+	_, hasTransfer := methods["transfer(address,uint256)"]
+	_, hasBalanceOf := methods["balanceOf(address)"]
+	if !hasTransfer || !hasBalanceOf {
+		return analysis, nil
+	}
+	analysis.IsToken = true
+
+	for _, sig := range Interfaces[0].Methods {
+		if _, ok := methods[sig]; !ok {
+			analysis.add(IssueMissingMethod, "%s is not found", sig)
+		}
+	}
+	for _, sig := range Interfaces[0].Events {
+		event, ok := events[sig]
+		if !ok {
+			analysis.add(IssueMissingEvent, "event %s is not found", sig)
+			continue
+		}
+		if len(event.Inputs) == 3 && event.Inputs[2].Indexed {
+			analysis.add(IssueIndexedValue, "value of event %s is indexed", sig)
+		}
+	}
+
+	for _, sig := range []string{"transfer(address,uint256)", "transferFrom(address,address,uint256)", "approve(address,uint256)"} {
+		method, ok := methods[sig]
+		if !ok {
+			continue
+		}
+		if len(method.Outputs) != 1 || method.Outputs[0].Type.T != abi.BoolTy {
+			analysis.add(IssueNoBoolReturn, "%s does not return bool", sig)
+		}
+	}
+
+	if decimals, ok := methods["decimals()"]; !ok {
+		analysis.add(IssueMissingDecimals, "decimals() is not found")
+	} else if len(decimals.Outputs) != 1 || decimals.Outputs[0].Type.String() != "uint8" {
+		analysis.add(IssueInvalidDecimals, "decimals() does not return uint8")
+	}
+	if _, ok := methods["name()"]; !ok {
+		analysis.add(IssueMissingName, "name() is not found")
+	}
+	if _, ok := methods["symbol()"]; !ok {
+		analysis.add(IssueMissingSymbol, "symbol() is not found")
+	}
+
+	return analysis, nil
+}
+
+func (analysis *TokenAnalysis) add(code, format string, args ...any) {
+	analysis.Issues = append(analysis.Issues, TokenIssue{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
@@ -0,0 +1,55 @@
+package evm
+
+import (
+	"fmt"
+)
+
+// TopicTypes - returns elementary static types which encoding can be equal to the value of indexed event parameter. Values of dynamic types (strings, bytes, arrays and tuples) are hashed, so any value matches them and they aren't returned.
+func TopicTypes(value []byte) []string {
+	if len(value) != 32 {
+		return nil
+	}
+
+	types := make([]string, 0)
+	if isZero(value[:12]) {
+		types = append(types, "address")
+	}
+	if isZero(value[:31]) && value[31] <= 1 {
+		types = append(types, "bool")
+	}
+	for size := 1; size <= 32; size++ {
+		if isZero(value[:32-size]) {
+			types = append(types, fmt.Sprintf("uint%d", size*8))
+		}
+		if isSignExtended(value, size) {
+			types = append(types, fmt.Sprintf("int%d", size*8))
+		}
+		if isZero(value[size:]) {
+			types = append(types, fmt.Sprintf("bytes%d", size))
+		}
+	}
+	return types
+}
+
+// isSignExtended - checks that value is two's complement of signed integer of size bytes extended to 32 bytes
+func isSignExtended(value []byte, size int) bool {
+	head := value[:32-size]
+	if value[32-size]&0x80 == 0 {
+		return isZero(head)
+	}
+	for i := range head {
+		if head[i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(data []byte) bool {
+	for i := range data {
+		if data[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+package evm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TypedDataField - member of EIP-712 struct type
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedDataSchema - struct types of ABI in the form of `types` of EIP-712 typed data
+type TypedDataSchema struct {
+	// Types - struct types keyed by name. Members which are structs reference other types by name, e.g. `Order` or `Order[]`.
+	Types map[string][]TypedDataField
+	// DomainGetter - ABI declares `eip712Domain()` of EIP-5267, so domain of the contract can be received from it
+	DomainGetter bool
+	// Warnings - tuples of ABI which can't be converted, sorted alphabetically
+	Warnings []string
+}
+
+var (
+	eip712Identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	eip712Atomic     = regexp.MustCompile(`^(address|bool|string|bytes([1-9]|[12][0-9]|3[0-2])?|u?int(8|16|24|32|40|48|56|64|72|80|88|96|104|112|120|128|136|144|152|160|168|176|184|192|200|208|216|224|232|240|248|256)?)$`)
+)
+
+// NewTypedDataSchema - extracts struct types of parameters of functions, events and errors. ABI doesn't declare structs: only `internalType` of tuple (e.g. `struct Exchange.Order`), which is emitted by solc since 0.5.11, names it. Struct is named by the part after the last dot. Tuples without struct name or with unnamed components, structs with members which can't be expressed in EIP-712 (`fixed`, `function`) and structs which conflict by name with other ones are skipped and described in warnings.
+func NewTypedDataSchema(data []byte) (*TypedDataSchema, error) {
+	normalized, err := NormalizeABI(data)
+	if err != nil {
+		return nil, err
+	}
+	var entries []bindEntry
+	if err := json.Unmarshal(normalized, &entries); err != nil {
+		return nil, err
+	}
+
+	extractor := &typedDataExtractor{
+		types:    make(map[string][]TypedDataField),
+		warnings: make(map[string]struct{}),
+	}
+	schema := &TypedDataSchema{}
+	for i := range entries {
+		if entries[i].Type == "function" && entries[i].Name == "eip712Domain" && entries[i].Inputs != nil && len(*entries[i].Inputs) == 0 {
+			schema.DomainGetter = true
+		}
+		for _, args := range []*[]bindArgument{entries[i].Inputs, entries[i].Outputs} {
+			if args == nil {
+				continue
+			}
+			for j := range *args {
+				extractor.fieldType(entries[i].Name, (*args)[j])
+			}
+		}
+	}
+
+	schema.Types = extractor.types
+	schema.Warnings = make([]string, 0, len(extractor.warnings))
+	for warning := range extractor.warnings {
+		schema.Warnings = append(schema.Warnings, warning)
+	}
+	sort.Strings(schema.Warnings)
+	return schema, nil
+}
+
+type typedDataExtractor struct {
+	types    map[string][]TypedDataField
+	warnings map[string]struct{}
+}
+
+func (e *typedDataExtractor) warn(entry, format string, args ...any) {
+	e.warnings[fmt.Sprintf("%s: %s", entry, fmt.Sprintf(format, args...))] = struct{}{}
+}
+
+// fieldType - returns EIP-712 type of ABI parameter and registers structs of tuples. False is returned if type can't be expressed in EIP-712.
+func (e *typedDataExtractor) fieldType(entry string, arg bindArgument) (string, bool) {
+	base, dims := splitArrayType(arg.Type)
+	if base != "tuple" {
+		if !eip712Atomic.MatchString(base) {
+			e.warn(entry, "type %s isn't supported by EIP-712", base)
+			return "", false
+		}
+		return base + dims, true
+	}
+
+	name, ok := structName(arg.InternalType)
+	if !ok {
+		e.warn(entry, "tuple %s doesn't have struct name in internalType", arg.Name)
+		return "", false
+	}
+
+	fields := make([]TypedDataField, len(arg.Components))
+	for i := range arg.Components {
+		if arg.Components[i].Name == "" {
+			e.warn(entry, "struct %s has unnamed component %d", name, i)
+			return "", false
+		}
+		typ, ok := e.fieldType(entry, arg.Components[i])
+		if !ok {
+			e.warn(entry, "struct %s has component %s which isn't supported by EIP-712", name, arg.Components[i].Name)
+			return "", false
+		}
+		fields[i] = TypedDataField{
+			Name: arg.Components[i].Name,
+			Type: typ,
+		}
+	}
+	if len(fields) == 0 {
+		e.warn(entry, "struct %s is empty", name)
+		return "", false
+	}
+
+	if current, ok := e.types[name]; ok && !sameFields(current, fields) {
+		e.warn(entry, "struct %s conflicts with another struct of the same name", name)
+		return "", false
+	}
+	e.types[name] = fields
+	return name + dims, true
+}
+
+// structName - returns struct name of `internalType`, e.g. `Order` of `struct Exchange.Order[]`
+func structName(internalType string) (string, bool) {
+	if !strings.HasPrefix(internalType, "struct ") {
+		return "", false
+	}
+	name, _ := splitArrayType(strings.TrimPrefix(internalType, "struct "))
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return name, eip712Identifier.MatchString(name)
+}
+
+// splitArrayType - splits type to base type and array dimensions, e.g. `tuple[2][]` to `tuple` and `[2][]`
+func splitArrayType(typ string) (string, string) {
+	if i := strings.IndexByte(typ, '['); i >= 0 {
+		return typ[:i], typ[i:]
+	}
+	return typ, ""
+}
+
+func sameFields(a, b []TypedDataField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
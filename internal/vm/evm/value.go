@@ -0,0 +1,113 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValueKind - kind of decoded value. It defines which field of Value is set.
+type ValueKind int
+
+// value kinds
+const (
+	KindUnknown ValueKind = iota
+	KindUint
+	KindInt
+	KindBool
+	KindAddress
+	// KindBytes - `bytes`, `bytesN` and `function`
+	KindBytes
+	KindString
+	// KindArray - fixed size and dynamic arrays
+	KindArray
+	KindTuple
+)
+
+// Value - decoded value with its Solidity type. Integers of any size are kept as big.Int, so `uint256` isn't truncated.
+type Value struct {
+	Type string
+	Kind ValueKind
+
+	Number  *big.Int
+	Bool    bool
+	Address common.Address
+	Bytes   []byte
+	// String - value of `string`. For unknown kind it's textual representation of the value.
+	String string
+	// Items - elements of array
+	Items []Value
+	// Components - fields of tuple in declaration order
+	Components []Component
+}
+
+// Component - named field of tuple
+type Component struct {
+	Name  string
+	Value Value
+}
+
+// typedValue - converts unpacked value to Value
+func typedValue(typ abi.Type, value reflect.Value) Value {
+	result := Value{
+		Type: typ.String(),
+	}
+
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		result.Kind = KindUint
+		if typ.T == abi.IntTy {
+			result.Kind = KindInt
+		}
+		switch number := value.Interface().(type) {
+		case *big.Int:
+			result.Number = new(big.Int).Set(number)
+		default:
+			if value.CanInt() {
+				result.Number = big.NewInt(value.Int())
+			} else {
+				result.Number = new(big.Int).SetUint64(value.Uint())
+			}
+		}
+	case abi.BoolTy:
+		result.Kind = KindBool
+		result.Bool = value.Bool()
+	case abi.AddressTy:
+		result.Kind = KindAddress
+		result.Address = value.Interface().(common.Address)
+	case abi.StringTy:
+		result.Kind = KindString
+		result.String = value.String()
+	case abi.BytesTy:
+		result.Kind = KindBytes
+		result.Bytes = common.CopyBytes(value.Bytes())
+	case abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		result.Kind = KindBytes
+		result.Bytes = make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(result.Bytes), value)
+	case abi.SliceTy, abi.ArrayTy:
+		result.Kind = KindArray
+		result.Items = make([]Value, value.Len())
+		for i := range result.Items {
+			result.Items[i] = typedValue(*typ.Elem, value.Index(i))
+		}
+	case abi.TupleTy:
+		if value.Kind() == reflect.Ptr {
+			value = value.Elem()
+		}
+		result.Kind = KindTuple
+		result.Components = make([]Component, len(typ.TupleElems))
+		for i := range typ.TupleElems {
+			result.Components[i] = Component{
+				Name:  typ.TupleRawNames[i],
+				Value: typedValue(*typ.TupleElems[i], value.Field(i)),
+			}
+		}
+	default:
+		result.String = fmt.Sprint(value.Interface())
+	}
+	return result
+}
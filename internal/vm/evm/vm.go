@@ -1,9 +1,12 @@
 package evm
 
 import (
+	"strings"
+
 	"github.com/dipdup-net/abi-indexer/internal/storage"
 	"github.com/dipdup-net/indexer-sdk/pkg/contract"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -18,8 +21,13 @@ type VirtualMachine struct {
 	contractABI *abi.ABI
 }
 
-// NewVM -
+// NewVM - parses ABI JSON. Legacy ABI is normalized to the modern form first, see NormalizeABI.
 func NewVM(data []byte) (*VirtualMachine, error) {
+	data, err := NormalizeABI(data)
+	if err != nil {
+		return nil, err
+	}
+
 	var contractABI abi.ABI
 	if err := json.Unmarshal(data, &contractABI); err != nil {
 		return nil, err
@@ -31,21 +39,64 @@ func NewVM(data []byte) (*VirtualMachine, error) {
 	}, nil
 }
 
-// JSONSchema -
+// JSONSchema - returns JSON schema of methods and events. Integers are declared as decimal strings instead of numbers, because JSON numbers lose precision of values above 2^53.
 func (vm *VirtualMachine) JSONSchema() ([]byte, error) {
-	return vm.EVM.JSONSchema(vm.raw)
+	data, err := vm.EVM.JSONSchema(vm.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	stringifyIntegers(schema)
+	return json.Marshal(schema)
 }
 
-// Methods -
+// stringifyIntegers - replaces number type of integer nodes of schema by string with decimal pattern
+func stringifyIntegers(node any) {
+	switch typed := node.(type) {
+	case map[string]any:
+		if typed["type"] == "number" {
+			if internalType, ok := typed["internal_type"].(string); ok {
+				switch {
+				case strings.HasPrefix(internalType, "uint"):
+					typed["type"] = "string"
+					typed["pattern"] = patternUint
+				case strings.HasPrefix(internalType, "int"):
+					typed["type"] = "string"
+					typed["pattern"] = patternInt
+				}
+			}
+		}
+		for _, value := range typed {
+			stringifyIntegers(value)
+		}
+	case []any:
+		for i := range typed {
+			stringifyIntegers(typed[i])
+		}
+	}
+}
+
+// Methods - returns methods declared in ABI. Overloaded methods are returned separately with the same name and distinct signatures.
 func (vm *VirtualMachine) Methods() ([]storage.Method, error) {
 	if vm.contractABI == nil {
 		return nil, ErrNilABI
 	}
 
 	methods := make([]storage.Method, 0)
-	for name, method := range vm.contractABI.Methods {
+	selectors := make(map[string]struct{})
+	for _, method := range vm.contractABI.Methods {
+		// duplicate entries of ABI are parsed as methods with renamed names and the same selector
+		if _, ok := selectors[string(method.ID)]; ok {
+			continue
+		}
+		selectors[string(method.ID)] = struct{}{}
+
 		methods = append(methods, storage.Method{
-			Name:        name,
+			Name:        method.RawName,
 			Signature:   method.Sig,
 			SignatureID: method.ID,
 			IsConst:     method.Constant,
@@ -58,20 +109,83 @@ func (vm *VirtualMachine) Methods() ([]storage.Method, error) {
 	return methods, nil
 }
 
-// Events -
+// Events - returns events declared in ABI. Overloaded events are returned separately with the same name and distinct signatures.
 func (vm *VirtualMachine) Events() ([]storage.Event, error) {
 	if vm.contractABI == nil {
 		return nil, ErrNilABI
 	}
 
 	events := make([]storage.Event, 0)
-	for name, event := range vm.contractABI.Events {
+	topics := make(map[common.Hash]struct{})
+	for _, event := range vm.contractABI.Events {
+		if _, ok := topics[event.ID]; ok {
+			continue
+		}
+		topics[event.ID] = struct{}{}
+
+		indexed := make([]string, 0)
+		for i := range event.Inputs {
+			if event.Inputs[i].Indexed {
+				indexed = append(indexed, event.Inputs[i].Type.String())
+			}
+		}
 		events = append(events, storage.Event{
-			Name:        name,
+			Name:        event.RawName,
 			Signature:   event.Sig,
 			SignatureID: event.ID.Bytes(),
 			Anonymous:   event.Anonymous,
+			Indexed:     indexed,
 		})
 	}
 	return events, nil
 }
+
+// Errors - returns custom errors declared in ABI
+func (vm *VirtualMachine) Errors() ([]storage.Error, error) {
+	if vm.contractABI == nil {
+		return nil, ErrNilABI
+	}
+
+	abiErrors := make([]storage.Error, 0)
+	selectors := make(map[common.Hash]struct{})
+	for name, abiError := range vm.contractABI.Errors {
+		if _, ok := selectors[abiError.ID]; ok {
+			continue
+		}
+		selectors[abiError.ID] = struct{}{}
+
+		// array of loop variable is reused by iterations, so selector is copied instead of slicing it
+		abiErrors = append(abiErrors, storage.Error{
+			Name:        name,
+			Signature:   abiError.Sig,
+			SignatureID: abiError.ID.Bytes()[:4],
+		})
+	}
+	return abiErrors, nil
+}
+
+// Interfaces -
+func (vm *VirtualMachine) Interfaces() ([]string, error) {
+	methods, err := vm.Methods()
+	if err != nil {
+		return nil, err
+	}
+	events, err := vm.Events()
+	if err != nil {
+		return nil, err
+	}
+	return DetectInterfaces(methods, events), nil
+}
+
+// IsProxy -
+func (vm *VirtualMachine) IsProxy() (bool, error) {
+	methods, err := vm.Methods()
+	if err != nil {
+		return false, err
+	}
+	events, err := vm.Events()
+	if err != nil {
+		return false, err
+	}
+	return DetectProxy(methods, events), nil
+}
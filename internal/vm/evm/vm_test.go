@@ -0,0 +1,144 @@
+package evm
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const overloadedABI = `[
+	{"type":"function","name":"foo","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"foo","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+func TestOverloadedMethods(t *testing.T) {
+	overloads := map[string]string{
+		"2fbebd38": "foo(uint256)",
+		"fdf80bda": "foo(address)",
+	}
+
+	vm, err := NewVM([]byte(overloadedABI))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	methods, err := vm.Methods()
+	if err != nil {
+		t.Fatalf("Methods: %v", err)
+	}
+	if len(methods) != len(overloads) {
+		t.Fatalf("got %d methods, want %d", len(methods), len(overloads))
+	}
+	for _, method := range methods {
+		selector := hex.EncodeToString(method.SignatureID)
+		want, ok := overloads[selector]
+		if !ok {
+			t.Fatalf("unexpected selector %s of %s", selector, method.Signature)
+		}
+		if method.Signature != want || method.Name != "foo" {
+			t.Fatalf("selector %s: got %s named %s, want %s named foo", selector, method.Signature, method.Name, want)
+		}
+	}
+
+	table, err := NewDecodeTable([]byte(overloadedABI))
+	if err != nil {
+		t.Fatalf("NewDecodeTable: %v", err)
+	}
+	for selector, signature := range overloads {
+		entry, ok := table.Functions["0x"+selector]
+		if !ok {
+			t.Fatalf("selector %s isn't found in decode table", selector)
+		}
+		if entry.Signature != signature {
+			t.Fatalf("selector %s: signature %s, want %s", selector, entry.Signature, signature)
+		}
+
+		fragment, err := NewVM([]byte("[" + string(entry.Fragment) + "]"))
+		if err != nil {
+			t.Fatalf("fragment of %s isn't parsed: %v", selector, err)
+		}
+		fragmentMethods, err := fragment.Methods()
+		if err != nil {
+			t.Fatalf("Methods of fragment: %v", err)
+		}
+		if len(fragmentMethods) != 1 || fragmentMethods[0].Signature != signature {
+			t.Fatalf("fragment of %s is %s", selector, entry.Fragment)
+		}
+
+		id, _ := hex.DecodeString(selector)
+		schema, err := vm.InputSchema(id)
+		if err != nil {
+			t.Fatalf("InputSchema(%s): %v", selector, err)
+		}
+		if schema.Signature != signature {
+			t.Fatalf("InputSchema(%s) resolved %s, want %s", selector, schema.Signature, signature)
+		}
+	}
+}
+
+func TestEventsIndexed(t *testing.T) {
+	vm, err := NewVM([]byte(`[
+		{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+		{"type":"event","name":"Named","anonymous":false,"inputs":[{"name":"value","type":"uint256","indexed":false},{"name":"name","type":"string","indexed":true},{"name":"ids","type":"uint256[]","indexed":true}]},
+		{"type":"event","name":"Paused","anonymous":true,"inputs":[]}
+	]`))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	events, err := vm.Events()
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	want := map[string][]string{
+		"Transfer": {"address", "address"},
+		"Named":    {"string", "uint256[]"},
+		"Paused":   {},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(events), len(want))
+	}
+	for i := range events {
+		indexed, ok := want[events[i].Name]
+		if !ok {
+			t.Fatalf("unexpected event %s", events[i].Name)
+		}
+		if events[i].Indexed == nil || len(events[i].Indexed) != len(indexed) {
+			t.Fatalf("%s: indexed = %#v, want %#v", events[i].Name, events[i].Indexed, indexed)
+		}
+		for j := range indexed {
+			if events[i].Indexed[j] != indexed[j] {
+				t.Fatalf("%s: indexed = %v, want %v", events[i].Name, events[i].Indexed, indexed)
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	vm, err := NewVM([]byte(`[
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+		{"type":"error","name":"Unauthorized","inputs":[]},
+		{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]}
+	]`))
+	if err != nil {
+		t.Fatalf("NewVM: %v", err)
+	}
+	abiErrors, err := vm.Errors()
+	if err != nil {
+		t.Fatalf("Errors: %v", err)
+	}
+	want := map[string]string{
+		"cf479181": "InsufficientBalance(uint256,uint256)",
+		"82b42900": "Unauthorized()",
+	}
+	if len(abiErrors) != len(want) {
+		t.Fatalf("got %d errors, want %d", len(abiErrors), len(want))
+	}
+	for i := range abiErrors {
+		selector := hex.EncodeToString(abiErrors[i].SignatureID)
+		signature, ok := want[selector]
+		if !ok {
+			t.Fatalf("unexpected selector %s of %s", selector, abiErrors[i].Signature)
+		}
+		if abiErrors[i].Signature != signature {
+			t.Fatalf("selector %s: signature %s, want %s", selector, abiErrors[i].Signature, signature)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 type Decoder interface {
 	Methods() ([]storage.Method, error)
 	Events() ([]storage.Event, error)
+	Errors() ([]storage.Error, error)
 }
 
 // Type -
@@ -25,6 +26,12 @@ type VirtualMachine interface {
 	Decoder
 
 	JSONSchema() ([]byte, error)
+	Interfaces() ([]string, error)
+	IsProxy() (bool, error)
+	AnalyzeToken() (*evm.TokenAnalysis, error)
+	DecodeConstructorArgs(data []byte) ([]evm.Argument, error)
+	DecodeError(data []byte) (*evm.DecodedError, error)
+	InputSchema(selector []byte) (*evm.MethodSchema, error)
 }
 
 // Config -
@@ -41,3 +48,23 @@ func Factory(typ Type, abi []byte) (VirtualMachine, error) {
 		return nil, errors.Errorf("unknown virtual machine: %s", typ)
 	}
 }
+
+// DedupABI - removes entries of ABI which are declared several times and returns count of removed ones
+func DedupABI(typ Type, abi []byte) ([]byte, int, error) {
+	switch typ {
+	case TypeEVM:
+		return evm.DedupABI(abi)
+	default:
+		return nil, 0, errors.Errorf("unknown virtual machine: %s", typ)
+	}
+}
+
+// ParseSignature - validates textual signature of the virtual machine and computes its selector and topic
+func ParseSignature(typ Type, text string) (evm.Signature, error) {
+	switch typ {
+	case TypeEVM:
+		return evm.ParseSignature(text)
+	default:
+		return evm.Signature{}, errors.Errorf("unknown virtual machine: %s", typ)
+	}
+}
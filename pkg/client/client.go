@@ -0,0 +1,323 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	clientIDHeader        = "x-client-id"
+	readConsistencyHeader = "x-read-consistency"
+	featurePrimaryReads   = "primary_reads"
+	defaultReconnectDelay = 5 * time.Second
+)
+
+// Client - convenience client of ABI indexer gRPC API. It introduces itself to server on connection and passes issued client identifier in every request.
+type Client struct {
+	conn           *grpc.ClientConn
+	api            pb.MetadataServiceClient
+	clientID       string
+	reconnectDelay time.Duration
+	batchSize      uint32
+	batchInterval  uint32
+	// clockOffset - difference between server and client clocks in seconds
+	clockOffset int64
+	// session - server info received by `Hello`
+	session *pb.HelloResponse
+	methods map[string]struct{}
+
+	wg *sync.WaitGroup
+}
+
+// New - dials server and receives client identifier by `Hello` request
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	client := &Client{
+		reconnectDelay: defaultReconnectDelay,
+		batchSize:      cfg.BatchSize,
+		batchInterval:  cfg.BatchInterval,
+		wg:             new(sync.WaitGroup),
+	}
+	if cfg.ReconnectDelay > 0 {
+		client.reconnectDelay = time.Duration(cfg.ReconnectDelay) * time.Second
+	}
+
+	conn, err := grpc.DialContext(
+		ctx,
+		cfg.ServerAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    20 * time.Second,
+			Timeout: 10 * time.Second,
+		}),
+		grpc.WithChainUnaryInterceptor(client.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(client.streamInterceptor),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial connection")
+	}
+	client.conn = conn
+	client.api = pb.NewMetadataServiceClient(conn)
+
+	hello, err := client.api.Hello(ctx, &pb.HelloRequest{
+		Name:     cfg.Name,
+		ClientId: cfg.ClientID,
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrap(err, "hello")
+	}
+	client.clientID = hello.ClientId
+	client.clockOffset = hello.ServerTime - time.Now().Unix()
+	client.session = hello
+	client.methods = make(map[string]struct{}, len(hello.Methods))
+	for i := range hello.Methods {
+		client.methods[hello.Methods[i]] = struct{}{}
+	}
+
+	return client, nil
+}
+
+// ClientID - returns identifier issued by server or stable identifier of config
+func (client *Client) ClientID() string {
+	return client.clientID
+}
+
+// Session - returns server info received on connection: version, supported methods, enabled features and limits
+func (client *Client) Session() *pb.HelloResponse {
+	return client.session
+}
+
+// Supports - checks if server supports the method, e.g. `GetMetadataByCreator`. Servers which don't report their methods are considered to support all of them.
+func (client *Client) Supports(method string) bool {
+	if len(client.methods) == 0 {
+		return true
+	}
+	_, ok := client.methods[method]
+	return ok
+}
+
+// HasFeature - checks if optional feature is enabled on server, e.g. `refresh`
+func (client *Client) HasFeature(feature string) bool {
+	for i := range client.session.GetFeatures() {
+		if client.session.Features[i] == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrimaryReads - returns context which asks server to read contracts from primary storage instead of replica, e.g. right after `PutMetadata`. Reads from primary aren't stale but are slower and load primary, so use it only for requests which need read-your-writes. Context is returned as is if server doesn't report `primary_reads` feature.
+func (client *Client) WithPrimaryReads(ctx context.Context) context.Context {
+	if !client.HasFeature(featurePrimaryReads) {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, readConsistencyHeader, "primary")
+}
+
+// API - returns generated client stub for requests which are not wrapped by the package
+func (client *Client) API() pb.MetadataServiceClient {
+	return client.api
+}
+
+// Close - closes connection. It waits until all subscriptions are finished, so contexts of subscriptions should be cancelled before.
+func (client *Client) Close() error {
+	client.wg.Wait()
+	return client.conn.Close()
+}
+
+// serverTime - returns current unix time in seconds by server clock
+func (client *Client) serverTime() int64 {
+	return time.Now().Unix() + client.clockOffset
+}
+
+func (client *Client) withClientID(ctx context.Context) context.Context {
+	if client.clientID == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, clientIDHeader, client.clientID)
+}
+
+func (client *Client) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(client.withClientID(ctx), method, req, reply, cc, opts...)
+}
+
+func (client *Client) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(client.withClientID(ctx), desc, cc, method, opts...)
+}
+
+// GetMetadata - receives metadata by contract address
+func (client *Client) GetMetadata(ctx context.Context, address string) (*Metadata, error) {
+	response, err := client.api.GetMetadata(ctx, &pb.GetMetadataRequest{
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadata(response), nil
+}
+
+// ListMetadata - receives metadata with pagination
+func (client *Client) ListMetadata(ctx context.Context, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.ListMetadata(ctx, &pb.ListMetadataRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// GetMetadataByMethod - receives metadata of contracts which contain method with signature
+func (client *Client) GetMetadataByMethod(ctx context.Context, signature string, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.GetMetadataByMethodSinature(ctx, &pb.GetMetadataByMethodSinatureRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Signature: signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// GetMetadataByTopic - receives metadata of contracts which contain event with topic
+func (client *Client) GetMetadataByTopic(ctx context.Context, topic string, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	return client.GetMetadataByIndexedTopics(ctx, topic, nil, limit, offset, order)
+}
+
+// GetMetadataByIndexedTopics - receives metadata of contracts which declare event with signature topic and indexed parameters which types can be encoded to values of topic1..topic3. Empty value matches any topic.
+func (client *Client) GetMetadataByIndexedTopics(ctx context.Context, topic string, indexed []string, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.GetMetadataByTopic(ctx, &pb.GetMetadataByTopicRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Topic:   topic,
+		Indexed: indexed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// GetMetadataBySelectors - receives metadata of contracts which contain methods with 4-byte selectors. If matchAll is true contract should contain all of them.
+func (client *Client) GetMetadataBySelectors(ctx context.Context, selectors []string, matchAll bool, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	match := pb.MatchType_ANY
+	if matchAll {
+		match = pb.MatchType_ALL
+	}
+	response, err := client.api.GetMetadataBySelectors(ctx, &pb.GetMetadataBySelectorsRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Selectors: selectors,
+		Match:     match,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// GetMetadataByTag - receives metadata of contracts marked by tags. If matchAll is true contract should be marked by all of them.
+func (client *Client) GetMetadataByTag(ctx context.Context, tags []string, matchAll bool, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	match := pb.MatchType_ANY
+	if matchAll {
+		match = pb.MatchType_ALL
+	}
+	response, err := client.api.GetMetadataByTag(ctx, &pb.GetMetadataByTagRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Tags:  tags,
+		Match: match,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// ListTags - receives all known tags with count of marked contracts by tag
+func (client *Client) ListTags(ctx context.Context) (map[string]uint64, error) {
+	response, err := client.api.ListTags(ctx, new(pb.ListTagsRequest))
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]uint64, len(response.Tags))
+	for i := range response.Tags {
+		tags[response.Tags[i].Tag] = response.Tags[i].Count
+	}
+	return tags, nil
+}
+
+// GetMetadataByBytecodeHash - receives metadata of contracts with the same keccak256 hash of runtime bytecode. It allows to reuse ABI of indexed contract for unverified clone.
+func (client *Client) GetMetadataByBytecodeHash(ctx context.Context, hash string, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.GetMetadataByBytecodeHash(ctx, &pb.GetMetadataByBytecodeHashRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Hash: hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// GetMetadataByCreator - receives metadata of contracts deployed by creator ordered by creation time
+func (client *Client) GetMetadataByCreator(ctx context.Context, creator string, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.GetMetadataByCreator(ctx, &pb.GetMetadataByCreatorRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		Creator: creator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
+
+// ListMetadataByBlockRange - receives metadata of contracts created between blocks ordered by creation block. If `to` is zero the range isn't bounded.
+func (client *Client) ListMetadataByBlockRange(ctx context.Context, from, to uint64, limit, offset uint64, order generalPB.SortOrder) ([]*Metadata, error) {
+	response, err := client.api.ListMetadataByBlockRange(ctx, &pb.ListMetadataByBlockRangeRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		FromBlock: from,
+		ToBlock:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataList(response.Metadata), nil
+}
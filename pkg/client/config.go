@@ -0,0 +1,16 @@
+package client
+
+// Config -
+type Config struct {
+	ServerAddress string `yaml:"server_address" validate:"required"`
+	// Name - readable name of the client which is used as prefix of client identifier issued by server
+	Name string `yaml:"name" validate:"omitempty"`
+	// ClientID - stable identifier of the client which is kept across reconnects and restarts. If it's empty, server issues random identifier with Name prefix.
+	ClientID string `yaml:"client_id" validate:"omitempty,max=64"`
+	// ReconnectDelay - delay between attempts to restore subscription in seconds. Default: 5
+	ReconnectDelay int `yaml:"reconnect_delay" validate:"omitempty,min=1"`
+	// BatchSize - maximum count of metadata which server accumulates in one subscription message. Batching is disabled if it's 0 or 1.
+	BatchSize uint32 `yaml:"batch_size" validate:"omitempty,max=1000"`
+	// BatchInterval - maximum delay of batched metadata in milliseconds. Default: 100
+	BatchInterval uint32 `yaml:"batch_interval" validate:"omitempty,max=10000"`
+}
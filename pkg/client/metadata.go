@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Metadata - indexed metadata of the contract
+type Metadata struct {
+	Address    string
+	ABI        []byte
+	JSONSchema []byte
+	IsComplete bool
+	Interfaces []string
+	UpdatedAt  time.Time
+	Hash       string
+	Creator    string
+	CreationTx string
+	CreatedAt  time.Time
+	Factory    string
+	CodeHash   string
+	// CreationBlock - number of block which contains creation transaction. It's zero if it's unknown.
+	CreationBlock uint64
+	// Tags - labels set by curators of indexer
+	Tags []string
+	// InheritedFrom - address of contract deployed by the same factory which ABI is returned
+	InheritedFrom string
+	// Source - type of source which returned ABI or `manual` if it was put by API
+	Source string
+	// Compilation - compiler settings and license of the contract. It's nil if source of ABI doesn't provide them.
+	Compilation *Compilation
+	// IsProxy - contract is classified as proxy by its ABI
+	IsProxy bool
+	// Destroyed - code check of server found that the contract doesn't have code anymore, e.g. it was self-destructed
+	Destroyed bool
+}
+
+// Compilation - compilation details of the contract reported by source of ABI
+type Compilation struct {
+	CompilerVersion  string
+	OptimizerEnabled bool
+	OptimizerRuns    uint64
+	EVMVersion       string
+	// License - SPDX identifier, e.g. `MIT`
+	License string
+}
+
+func newMetadata(m *pb.Metadata) *Metadata {
+	metadata := &Metadata{
+		Address:    m.Address,
+		ABI:        m.Metadata,
+		JSONSchema: m.JsonSchema,
+		IsComplete: m.IsComplete,
+		Interfaces: m.Interfaces,
+		Hash:       m.Hash,
+		Creator:    m.Creator,
+		CreationTx: m.CreationTx,
+		Factory:    m.Factory,
+		CodeHash:   m.CodeHash,
+
+		CreationBlock: m.CreationBlock,
+		Tags:          m.Tags,
+		InheritedFrom: m.InheritedFrom,
+		Source:        m.Source,
+		IsProxy:       m.IsProxy,
+		Destroyed:     m.CodeStatus == pb.CodeStatus_DESTROYED,
+	}
+	if m.UpdatedAt > 0 {
+		metadata.UpdatedAt = time.Unix(m.UpdatedAt, 0)
+	}
+	if m.CreatedAt > 0 {
+		metadata.CreatedAt = time.Unix(m.CreatedAt, 0)
+	}
+	if c := m.GetCompilation(); c != nil {
+		metadata.Compilation = &Compilation{
+			CompilerVersion:  c.CompilerVersion,
+			OptimizerEnabled: c.OptimizerEnabled,
+			OptimizerRuns:    c.OptimizerRuns,
+			EVMVersion:       c.EvmVersion,
+			License:          c.License,
+		}
+	}
+	return metadata
+}
+
+func newMetadataList(list []*pb.Metadata) []*Metadata {
+	response := make([]*Metadata, len(list))
+	for i := range list {
+		response[i] = newMetadata(list[i])
+	}
+	return response
+}
+
+// Parse - decodes ABI JSON to go-ethereum type
+func (m *Metadata) Parse() (abi.ABI, error) {
+	return abi.JSON(bytes.NewReader(m.ABI))
+}
+
+// Implements - checks the contract implements standard interface, e.g. `ERC20`
+func (m *Metadata) Implements(iface string) bool {
+	for i := range m.Interfaces {
+		if m.Interfaces[i] == iface {
+			return true
+		}
+	}
+	return false
+}
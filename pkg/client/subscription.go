@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/rs/zerolog/log"
+)
+
+const resumePageSize = 100
+
+// Subscribe - subscribes on new metadata. Subscription is restored automatically if stream is broken and metadata written while client was disconnected are received by `ListMetadata`, so some messages may be delivered twice. Channel is closed when ctx is done.
+func (client *Client) Subscribe(ctx context.Context) <-chan *Metadata {
+	return client.SubscribeInterface(ctx, "")
+}
+
+// SubscribeInterface - subscribes on new metadata implementing standard interface, e.g. `ERC721`. Interface is filtered by server, metadata received by `ListMetadata` on resume are filtered by client. Empty interface means all metadata.
+func (client *Client) SubscribeInterface(ctx context.Context, iface string) <-chan *Metadata {
+	output := make(chan *Metadata, 1024)
+
+	client.wg.Add(1)
+	go client.subscribe(ctx, output, iface)
+
+	return output
+}
+
+func (client *Client) subscribe(ctx context.Context, output chan<- *Metadata, iface string) {
+	defer client.wg.Done()
+	defer close(output)
+
+	// since - update time of the last received metadata. Its precision is second, so it's included in resume request.
+	since := client.serverTime()
+	resume := false
+
+	for {
+		err := client.stream(ctx, output, iface, &since, resume)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Err(err).Dur("delay", client.reconnectDelay).Msg("metadata subscription is broken: reconnecting...")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(client.reconnectDelay):
+		}
+		resume = true
+	}
+}
+
+func (client *Client) stream(ctx context.Context, output chan<- *Metadata, iface string, since *int64, resume bool) error {
+	stream, err := client.api.SubscribeOnMetadata(ctx, &pb.SubscribeOnMetadataRequest{
+		Interface:     iface,
+		BatchSize:     client.batchSize,
+		BatchInterval: client.batchInterval,
+	})
+	if err != nil {
+		return err
+	}
+
+	var subscription generalPB.SubscribeResponse
+	if err := stream.RecvMsg(&subscription); err != nil {
+		return err
+	}
+	defer func() {
+		unsubscribeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, _ = client.api.UnsubscribeFromMetadata(unsubscribeCtx, &generalPB.UnsubscribeRequest{
+			Id: subscription.Id,
+		})
+	}()
+
+	if resume {
+		if err := client.resume(ctx, output, iface, since); err != nil {
+			return err
+		}
+	}
+
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if data.Metadata != nil {
+			if !client.push(ctx, output, data.Metadata, since) {
+				return ctx.Err()
+			}
+		}
+		for i := range data.Batch {
+			if data.Batch[i].Metadata == nil {
+				continue
+			}
+			if !client.push(ctx, output, data.Batch[i].Metadata, since) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// resume - receives metadata updated since the last received one. It's called after subscription is restored, so nothing is missed between them.
+func (client *Client) resume(ctx context.Context, output chan<- *Metadata, iface string, since *int64) error {
+	updatedSince := *since
+	for offset := uint64(0); ; offset += resumePageSize {
+		response, err := client.api.ListMetadata(ctx, &pb.ListMetadataRequest{
+			Page: &generalPB.Page{
+				Limit:  resumePageSize,
+				Offset: offset,
+				Order:  generalPB.SortOrder_ASC,
+			},
+			UpdatedSince: updatedSince,
+		})
+		if err != nil {
+			return err
+		}
+		for i := range response.Metadata {
+			if !implements(response.Metadata[i], iface) {
+				continue
+			}
+			if !client.push(ctx, output, response.Metadata[i], since) {
+				return ctx.Err()
+			}
+		}
+		if len(response.Metadata) < resumePageSize {
+			return nil
+		}
+	}
+}
+
+func (client *Client) push(ctx context.Context, output chan<- *Metadata, data *pb.Metadata, since *int64) bool {
+	if data.UpdatedAt > *since {
+		*since = data.UpdatedAt
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case output <- newMetadata(data):
+		return true
+	}
+}
+
+func implements(data *pb.Metadata, iface string) bool {
+	if iface == "" {
+		return true
+	}
+	for i := range data.Interfaces {
+		if strings.EqualFold(data.Interfaces[i], iface) {
+			return true
+		}
+	}
+	return false
+}
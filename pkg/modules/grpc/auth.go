@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminMethods - methods which require admin token
+var adminMethods = map[string]struct{}{
+	"PauseRefresh":       {},
+	"ResumeRefresh":      {},
+	"SetMaintenanceMode": {},
+	"InvalidateCache":    {},
+	"AddTags":            {},
+	"RemoveTags":         {},
+	"PutMetadata":        {},
+	"ImportMetadata":     {},
+	"ImportSignatures":   {},
+	"ListClients":        {},
+	"Disconnect":         {},
+	"ListDecodeMisses":   {},
+}
+
+const bearerPrefix = "Bearer "
+
+// adminInterceptor - checks admin token passed in `authorization: Bearer <token>` request metadata for admin methods. If token isn't configured admin methods are denied.
+type adminInterceptor struct {
+	token []byte
+}
+
+func newAdminInterceptor(token string) *adminInterceptor {
+	return &adminInterceptor{
+		token: []byte(token),
+	}
+}
+
+func (interceptor *adminInterceptor) check(ctx context.Context, fullMethod string) error {
+	if _, ok := adminMethods[methodName(fullMethod)]; !ok {
+		return nil
+	}
+	return interceptor.authenticate(ctx)
+}
+
+func (interceptor *adminInterceptor) enabled() bool {
+	return len(interceptor.token) > 0
+}
+
+// authenticate - checks that request metadata contains valid admin token
+func (interceptor *adminInterceptor) authenticate(ctx context.Context) error {
+	if !interceptor.enabled() {
+		return status.Error(codes.PermissionDenied, "admin token is not configured")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "admin token is required")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "admin token is required")
+	}
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return status.Error(codes.Unauthenticated, "admin token is required")
+	}
+	token := strings.TrimPrefix(values[0], bearerPrefix)
+	if subtle.ConstantTimeCompare([]byte(token), interceptor.token) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid admin token")
+	}
+	return nil
+}
+
+// Unary -
+func (interceptor *adminInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := interceptor.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream -
+func (interceptor *adminInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := interceptor.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
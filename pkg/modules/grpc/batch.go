@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxSubscriptionBatchSize     = 1000
+	maxSubscriptionBatchInterval = 10000
+	defaultSubscriptionBatchWait = 100
+)
+
+// subscriptionBatch - messages of subscription which are accumulated to be sent by one stream message. Batch is flushed when it's full or when interval since its first message is elapsed.
+type subscriptionBatch struct {
+	subscriptionID uint64
+	size           int
+	interval       time.Duration
+	items          []*pb.SubscriptionMetadata
+}
+
+// newSubscriptionBatch - returns nil if request doesn't ask for batching
+func newSubscriptionBatch(subscriptionID uint64, req *pb.SubscribeOnMetadataRequest) (*subscriptionBatch, error) {
+	if req.BatchSize > maxSubscriptionBatchSize {
+		return nil, errors.Errorf("batch_size should be at most %d", maxSubscriptionBatchSize)
+	}
+	if req.BatchInterval > maxSubscriptionBatchInterval {
+		return nil, errors.Errorf("batch_interval should be at most %d milliseconds", maxSubscriptionBatchInterval)
+	}
+	if req.BatchSize <= 1 {
+		return nil, nil
+	}
+
+	interval := req.BatchInterval
+	if interval == 0 {
+		interval = defaultSubscriptionBatchWait
+	}
+	return &subscriptionBatch{
+		subscriptionID: subscriptionID,
+		size:           int(req.BatchSize),
+		interval:       time.Duration(interval) * time.Millisecond,
+		items:          make([]*pb.SubscriptionMetadata, 0, req.BatchSize),
+	}, nil
+}
+
+// Add - appends message to batch and returns true if batch is full
+func (batch *subscriptionBatch) Add(msg *pb.SubscriptionMetadata) bool {
+	batch.items = append(batch.items, msg)
+	return len(batch.items) >= batch.size
+}
+
+// Len -
+func (batch *subscriptionBatch) Len() int {
+	return len(batch.items)
+}
+
+// Flush - returns accumulated messages wrapped to one message and empties batch. Event id of the batch is event id of its last message.
+func (batch *subscriptionBatch) Flush() *pb.SubscriptionMetadata {
+	msg := &pb.SubscriptionMetadata{
+		Subscription: &generalPB.SubscribeResponse{
+			Id: batch.subscriptionID,
+		},
+		EventId: batch.items[len(batch.items)-1].EventId,
+		Batch:   batch.items,
+	}
+	batch.items = make([]*pb.SubscriptionMetadata, 0, batch.size)
+	return msg
+}
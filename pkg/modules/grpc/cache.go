@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dipdup-net/abi-indexer/internal/cache"
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// allKeys - suffix of invalidation message which evicts all keys with the prefix
+const allKeys = "*"
+
+// cachedMetadata - metadata storage which caches contracts received by address. In-process cache is the first level and shared Redis is the second one. Any of them can be absent. Requests of selected columns bypass cache.
+type cachedMetadata struct {
+	storage.IMetadata
+
+	chain  string
+	memory *cache.Memory
+	shared *cache.Resilient
+}
+
+func newCachedMetadata(repo storage.IMetadata, cfg cache.Config, memory *cache.Memory, shared *cache.Resilient) *cachedMetadata {
+	return &cachedMetadata{
+		IMetadata: repo,
+		chain:     cfg.Chain,
+		memory:    memory,
+		shared:    shared,
+	}
+}
+
+// GetByAddress -
+func (m *cachedMetadata) GetByAddress(ctx context.Context, address string, columns ...string) (*storage.Metadata, error) {
+	if len(columns) > 0 {
+		return m.IMetadata.GetByAddress(ctx, address, columns...)
+	}
+
+	key := m.key(address)
+	if m.memory != nil {
+		if data, err := m.memory.Get(ctx, key); err == nil {
+			if metadata, ok := decodeCached(address, data); ok {
+				return metadata, nil
+			}
+		}
+	}
+	if m.shared != nil {
+		if data, ok := m.shared.Get(ctx, key); ok {
+			if metadata, ok := decodeCached(address, data); ok {
+				if m.memory != nil {
+					_ = m.memory.Set(ctx, key, data, 0)
+				}
+				return metadata, nil
+			}
+		}
+	}
+
+	metadata, err := m.IMetadata.GetByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(metadata); err == nil {
+		if m.memory != nil {
+			_ = m.memory.Set(ctx, key, data, 0)
+		}
+		if m.shared != nil {
+			m.shared.Set(ctx, key, data)
+		}
+	}
+	return metadata, nil
+}
+
+// Invalidate - evicts contract from cache of all replicas. It returns count of entries evicted from in-process cache of the replica and from shared cache.
+func (m *cachedMetadata) Invalidate(ctx context.Context, address string) int {
+	key := m.key(address)
+	var count int
+	if m.memory != nil {
+		count, _ = m.memory.Delete(ctx, key)
+	}
+	if m.shared != nil {
+		count += m.shared.Delete(ctx, key)
+		m.shared.Publish(ctx, key)
+	}
+	return count
+}
+
+// InvalidateAll - evicts all contracts of the chain from cache of all replicas. It returns count of entries evicted from in-process cache of the replica and from shared cache.
+func (m *cachedMetadata) InvalidateAll(ctx context.Context) (int, error) {
+	prefix := m.key("")
+	var count int
+	if m.memory != nil {
+		count, _ = m.memory.DeletePrefix(ctx, prefix)
+	}
+	if m.shared != nil {
+		deleted, err := m.shared.DeletePrefix(ctx, prefix)
+		count += deleted
+		if err != nil {
+			return count, err
+		}
+		m.shared.Publish(ctx, prefix+allKeys)
+	}
+	return count, nil
+}
+
+// listen - evicts keys invalidated by other replicas from in-process cache. Invalidations sent while Redis is unavailable are lost, so staleness of in-process cache is bounded by its TTL.
+func (m *cachedMetadata) listen(ctx context.Context) {
+	if m.memory == nil || m.shared == nil {
+		return
+	}
+	messages := m.shared.Subscribe(ctx)
+	if messages == nil {
+		return
+	}
+	prefix := m.key("")
+	for key := range messages {
+		switch {
+		case key == prefix+allKeys:
+			_, _ = m.memory.DeletePrefix(ctx, prefix)
+		case strings.HasPrefix(key, prefix):
+			_, _ = m.memory.Delete(ctx, key)
+		}
+	}
+}
+
+// Close -
+func (m *cachedMetadata) Close() error {
+	if m.shared != nil {
+		return m.shared.Close()
+	}
+	return nil
+}
+
+func (m *cachedMetadata) key(address string) string {
+	address = strings.ToLower(address)
+	if m.chain == "" {
+		return "metadata:" + address
+	}
+	return "metadata:" + m.chain + ":" + address
+}
+
+func decodeCached(address string, data []byte) (*storage.Metadata, bool) {
+	var metadata storage.Metadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		log.Warn().Str("address", address).Msg("invalid cached metadata")
+		return nil, false
+	}
+	return &metadata, true
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
@@ -11,6 +12,8 @@ import (
 	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client -
@@ -20,7 +23,9 @@ type Client struct {
 	output        *modules.Output
 	client        pb.MetadataServiceClient
 	subscriptions *Subscriptions
-	wg            *sync.WaitGroup
+	// lastEventID - id of the last received subscription event. It's used to skip duplicates and to resume subscription.
+	lastEventID *atomic.Uint64
+	wg          *sync.WaitGroup
 }
 
 // NewClient -
@@ -29,6 +34,7 @@ func NewClient(cfg *ClientConfig) *Client {
 		Client:        grpcModules.NewClient(cfg.ServerAddress),
 		output:        modules.NewOutput(metadata.OutputMetadata),
 		subscriptions: cfg.Subscriptions,
+		lastEventID:   new(atomic.Uint64),
 		wg:            new(sync.WaitGroup),
 	}
 }
@@ -66,25 +72,60 @@ func (client *Client) AttachTo(name string, input *modules.Input) error {
 	return nil
 }
 
-// SubscribeOnMetadata -
+// SubscribeOnMetadata - subscribes on new metadata. If client has already received events, subscription is resumed after the last of them and missed events are replayed by server. Returns ErrResumeExpired if server doesn't have missed events anymore: metadata should be resynced by `ListMetadata` with `updated_since`, the next call subscribes from live events.
 func (client *Client) SubscribeOnMetadata(ctx context.Context) (uint64, error) {
 	if client.subscriptions != nil && !client.subscriptions.Metadata {
 		return 0, nil
 	}
 
-	stream, err := client.client.SubscribeOnMetadata(ctx, MetadataRequest())
+	var iface string
+	if client.subscriptions != nil {
+		iface = client.subscriptions.Interface
+	}
+
+	req := MetadataRequest(iface)
+	req.AfterEventId = client.lastEventID.Load()
+
+	stream, err := client.client.SubscribeOnMetadata(ctx, req)
 	if err != nil {
 		return 0, err
 	}
 
-	return grpcModules.Subscribe[*pb.SubscriptionMetadata](
+	id, err := grpcModules.Subscribe[*pb.SubscriptionMetadata](
 		stream,
 		client.handleNewMetadata,
 		client.wg,
 	)
+	if status.Code(err) == codes.OutOfRange {
+		client.lastEventID.Store(0)
+		return 0, errors.Wrap(ErrResumeExpired, err.Error())
+	}
+	return id, err
 }
 
 func (client *Client) handleNewMetadata(ctx context.Context, data *pb.SubscriptionMetadata, id uint64) error {
+	if len(data.Batch) > 0 {
+		for i := range data.Batch {
+			if err := client.handleNewMetadata(ctx, data.Batch[i], id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if data.EventId > 0 {
+		for {
+			last := client.lastEventID.Load()
+			if data.EventId <= last {
+				log.Trace().Uint64("event_id", data.EventId).Msg("duplicate metadata event is skipped")
+				return nil
+			}
+			if client.lastEventID.CompareAndSwap(last, data.EventId) {
+				break
+			}
+		}
+	}
+
 	log.Trace().Str("contract", data.Metadata.Address).Msg("new metadata")
 	client.output.Push(data)
 	return nil
@@ -119,6 +160,7 @@ func (client *Client) ListMetadata(ctx context.Context, limit, offset uint64, or
 			Offset: offset,
 			Order:  order,
 		},
+		OrderSet: true,
 	})
 	if err != nil {
 		return nil, err
@@ -126,6 +168,23 @@ func (client *Client) ListMetadata(ctx context.Context, limit, offset uint64, or
 	return response.Metadata, nil
 }
 
+// ListMetadataConsistent - receives page of metadata with consistent pagination. Pass empty cursor to receive the first page. Returned cursor is empty on the last page.
+func (client *Client) ListMetadataConsistent(ctx context.Context, limit uint64, order generalPB.SortOrder, cursor string) ([]*pb.Metadata, string, error) {
+	response, err := client.client.ListMetadata(ctx, &pb.ListMetadataRequest{
+		Page: &generalPB.Page{
+			Limit: limit,
+			Order: order,
+		},
+		OrderSet:   true,
+		Consistent: true,
+		Cursor:     cursor,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Metadata, response.NextCursor, nil
+}
+
 // GetMetadataByMethodSinature -
 func (client *Client) GetMetadataByMethodSinature(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, signature string) ([]*pb.Metadata, error) {
 	response, err := client.client.GetMetadataByMethodSinature(ctx, &pb.GetMetadataByMethodSinatureRequest{
@@ -134,6 +193,7 @@ func (client *Client) GetMetadataByMethodSinature(ctx context.Context, limit, of
 			Offset: offset,
 			Order:  order,
 		},
+		OrderSet:  true,
 		Signature: signature,
 	})
 	if err != nil {
@@ -142,6 +202,40 @@ func (client *Client) GetMetadataByMethodSinature(ctx context.Context, limit, of
 	return response.Metadata, nil
 }
 
+// GetMetadataByErrorSelector - returns metadata of contracts declaring custom error with hex-encoded 4-byte selector
+func (client *Client) GetMetadataByErrorSelector(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, selector string) ([]*pb.Metadata, error) {
+	response, err := client.client.GetMetadataByErrorSelector(ctx, &pb.GetMetadataByErrorSelectorRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+		Selector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// GetMetadataByBytecodeHash - returns metadata of contracts with the same hex-encoded keccak256 hash of runtime bytecode
+func (client *Client) GetMetadataByBytecodeHash(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, hash string) ([]*pb.Metadata, error) {
+	response, err := client.client.GetMetadataByBytecodeHash(ctx, &pb.GetMetadataByBytecodeHashRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+		Hash:     hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
 // GetMetadataByTopic -
 func (client *Client) GetMetadataByTopic(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, topic string) ([]*pb.Metadata, error) {
 	response, err := client.client.GetMetadataByTopic(ctx, &pb.GetMetadataByTopicRequest{
@@ -150,10 +244,352 @@ func (client *Client) GetMetadataByTopic(ctx context.Context, limit, offset uint
 			Offset: offset,
 			Order:  order,
 		},
-		Topic: topic,
+		OrderSet: true,
+		Topic:    topic,
 	})
 	if err != nil {
 		return nil, err
 	}
 	return response.Metadata, nil
 }
+
+// GetMetadataBySelectors -
+func (client *Client) GetMetadataBySelectors(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, selectors []string, match pb.MatchType) ([]*pb.Metadata, error) {
+	response, err := client.client.GetMetadataBySelectors(ctx, &pb.GetMetadataBySelectorsRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet:  true,
+		Selectors: selectors,
+		Match:     match,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// RefreshInterfaces -
+func (client *Client) RefreshInterfaces(ctx context.Context, address string) ([]string, error) {
+	response, err := client.client.RefreshInterfaces(ctx, &pb.RefreshInterfacesRequest{
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Interfaces, nil
+}
+
+// GetMetadataByCreator - returns metadata of contracts deployed by creator ordered by creation time
+func (client *Client) GetMetadataByCreator(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, creator string) ([]*pb.Metadata, error) {
+	response, err := client.client.GetMetadataByCreator(ctx, &pb.GetMetadataByCreatorRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+		Creator:  creator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// ListMetadataByBlockRange - returns metadata of contracts created between blocks ordered by creation block. If `to` is zero the range isn't bounded.
+func (client *Client) ListMetadataByBlockRange(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, from, to uint64) ([]*pb.Metadata, error) {
+	response, err := client.client.ListMetadataByBlockRange(ctx, &pb.ListMetadataByBlockRangeRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet:  true,
+		FromBlock: from,
+		ToBlock:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// GetMetadataByTag - returns metadata of contracts marked by any or all of tags
+func (client *Client) GetMetadataByTag(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, tags []string, match pb.MatchType) ([]*pb.Metadata, error) {
+	response, err := client.client.GetMetadataByTag(ctx, &pb.GetMetadataByTagRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+		Tags:     tags,
+		Match:    match,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// ListTags - returns all known tags with count of marked contracts
+func (client *Client) ListTags(ctx context.Context) ([]*pb.TagCount, error) {
+	response, err := client.client.ListTags(ctx, new(pb.ListTagsRequest))
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
+// ListIncompleteMetadata - returns metadata of contracts without full ABI ordered by time of the last fetch attempt, so the least recently requested contracts are returned first with ascending order
+func (client *Client) ListIncompleteMetadata(ctx context.Context, limit, offset uint64, order generalPB.SortOrder) ([]*pb.Metadata, error) {
+	response, err := client.client.ListIncompleteMetadata(ctx, &pb.ListIncompleteMetadataRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// ListByFactory - returns metadata of contracts deployed by factory
+func (client *Client) ListByFactory(ctx context.Context, limit, offset uint64, order generalPB.SortOrder, factory string) ([]*pb.Metadata, error) {
+	response, err := client.client.ListByFactory(ctx, &pb.ListByFactoryRequest{
+		Page: &generalPB.Page{
+			Limit:  limit,
+			Offset: offset,
+			Order:  order,
+		},
+		OrderSet: true,
+		Factory:  factory,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// GetMetadataByTopicsBatch - returns events matching each of topics. Up to limit events are returned per topic.
+func (client *Client) GetMetadataByTopicsBatch(ctx context.Context, topics []string, limit uint64) ([]*pb.TopicMatches, error) {
+	response, err := client.client.GetMetadataByTopicsBatch(ctx, &pb.GetMetadataByTopicsBatchRequest{
+		Topics: topics,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Topics, nil
+}
+
+// ListClients - returns clients which have active metadata subscriptions. It's an admin method.
+func (client *Client) ListClients(ctx context.Context) ([]*pb.ConnectedClient, error) {
+	response, err := client.client.ListClients(ctx, new(pb.ListClientsRequest))
+	if err != nil {
+		return nil, err
+	}
+	return response.Clients, nil
+}
+
+// Disconnect - closes subscriptions and connections of the client. It's an admin method.
+func (client *Client) Disconnect(ctx context.Context, clientID string) (*pb.DisconnectResponse, error) {
+	return client.client.Disconnect(ctx, &pb.DisconnectRequest{
+		ClientId: clientID,
+	})
+}
+
+// ListSubscriptions - returns stats of active metadata subscriptions
+func (client *Client) ListSubscriptions(ctx context.Context) ([]*pb.SubscriptionStats, error) {
+	response, err := client.client.ListSubscriptions(ctx, new(pb.ListSubscriptionsRequest))
+	if err != nil {
+		return nil, err
+	}
+	return response.Subscriptions, nil
+}
+
+// SampleMetadata - returns pseudo-random set of indexed metadata
+func (client *Client) SampleMetadata(ctx context.Context, count uint64) ([]*pb.Metadata, error) {
+	response, err := client.client.SampleMetadata(ctx, &pb.SampleMetadataRequest{
+		Count: count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Metadata, nil
+}
+
+// FindSimilarContracts -
+func (client *Client) FindSimilarContracts(ctx context.Context, address string, minSimilarity float64, limit uint64) (*pb.FindSimilarContractsResponse, error) {
+	return client.client.FindSimilarContracts(ctx, &pb.FindSimilarContractsRequest{
+		Address:       address,
+		MinSimilarity: minSimilarity,
+		Limit:         limit,
+	})
+}
+
+// AnalyzeToken -
+func (client *Client) AnalyzeToken(ctx context.Context, address string) (*pb.AnalyzeTokenResponse, error) {
+	return client.client.AnalyzeToken(ctx, &pb.AnalyzeTokenRequest{
+		Address: address,
+	})
+}
+
+// DecodeConstructorArgs - decodes hex-encoded constructor arguments of the contract
+func (client *Client) DecodeConstructorArgs(ctx context.Context, address, data string) ([]*pb.DecodedArgument, error) {
+	response, err := client.client.DecodeConstructorArgs(ctx, &pb.DecodeConstructorArgsRequest{
+		Address: address,
+		Data:    data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Arguments, nil
+}
+
+// DecodeError - decodes hex-encoded revert data. Address can be empty for standard `Error(string)` and `Panic(uint256)`.
+func (client *Client) DecodeError(ctx context.Context, address, data string) (*pb.DecodeErrorResponse, error) {
+	return client.client.DecodeError(ctx, &pb.DecodeErrorRequest{
+		Address: address,
+		Data:    data,
+	})
+}
+
+// GetInputSchema - returns JSON schema of inputs of the contract method with hex-encoded selector
+func (client *Client) GetInputSchema(ctx context.Context, address, selector string) (*pb.GetInputSchemaResponse, error) {
+	return client.client.GetInputSchema(ctx, &pb.GetInputSchemaRequest{
+		Address:  address,
+		Selector: selector,
+	})
+}
+
+// PauseRefresh - pauses background refresh. Context should contain admin token in `authorization` metadata.
+func (client *Client) PauseRefresh(ctx context.Context) (*pb.RefreshStatus, error) {
+	return client.client.PauseRefresh(ctx, new(pb.RefreshStatusRequest))
+}
+
+// ResumeRefresh - resumes background refresh. Context should contain admin token in `authorization` metadata.
+func (client *Client) ResumeRefresh(ctx context.Context) (*pb.RefreshStatus, error) {
+	return client.client.ResumeRefresh(ctx, new(pb.RefreshStatusRequest))
+}
+
+// SetMaintenanceMode - enables or disables maintenance mode of indexer. If shedWrites is set, write methods are rejected while mode is enabled. Context should contain admin token in `authorization` metadata.
+func (client *Client) SetMaintenanceMode(ctx context.Context, enabled, shedWrites bool) (*pb.MaintenanceMode, error) {
+	return client.client.SetMaintenanceMode(ctx, &pb.SetMaintenanceModeRequest{
+		Enabled:    enabled,
+		ShedWrites: shedWrites,
+	})
+}
+
+// InvalidateCache - evicts the contract or all contracts if address is empty from cache of server. Context should contain admin token in `authorization` metadata.
+func (client *Client) InvalidateCache(ctx context.Context, address string) (uint64, error) {
+	response, err := client.client.InvalidateCache(ctx, &pb.InvalidateCacheRequest{
+		Address: address,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.Evicted, nil
+}
+
+// GetRefreshStatus -
+func (client *Client) GetRefreshStatus(ctx context.Context) (*pb.RefreshStatus, error) {
+	return client.client.GetRefreshStatus(ctx, new(pb.RefreshStatusRequest))
+}
+
+// ListSelectorSignatures - returns page of selector signatures and cursor of the next page. Cursor is empty on the last page.
+func (client *Client) ListSelectorSignatures(ctx context.Context, limit uint64, cursor string) ([]*pb.SelectorSignature, string, error) {
+	response, err := client.client.ListSelectorSignatures(ctx, &pb.ListSelectorSignaturesRequest{
+		Limit:  limit,
+		Cursor: cursor,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Items, response.NextCursor, nil
+}
+
+// AddTags - adds tags to the contract and returns its tags. Context should contain admin token in `authorization` metadata.
+func (client *Client) AddTags(ctx context.Context, address string, tags ...string) ([]string, error) {
+	response, err := client.client.AddTags(ctx, &pb.TagsRequest{
+		Address: address,
+		Tags:    tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
+// RemoveTags - removes tags from the contract and returns its remaining tags. Context should contain admin token in `authorization` metadata.
+func (client *Client) RemoveTags(ctx context.Context, address string, tags ...string) ([]string, error) {
+	response, err := client.client.RemoveTags(ctx, &pb.TagsRequest{
+		Address: address,
+		Tags:    tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
+// PutMetadata - saves ABI of the contract. If merge is set, ABI is merged with stored one and signatures of conflicting entries which were skipped are returned. Context should contain admin token in `authorization` metadata.
+func (client *Client) PutMetadata(ctx context.Context, address string, data []byte, merge bool) (*pb.PutMetadataResponse, error) {
+	return client.client.PutMetadata(ctx, &pb.PutMetadataRequest{
+		Address:  address,
+		Metadata: data,
+		Merge:    merge,
+	})
+}
+
+// PutMetadataIfMatch - saves ABI of the contract only if hash of stored metadata is equal to hash, e.g. received by `GetMetadata` before editing. If metadata was changed, `Aborted` status is returned and current hash is in `current_hash` of its `ErrorInfo` details. Context should contain admin token in `authorization` metadata.
+func (client *Client) PutMetadataIfMatch(ctx context.Context, address string, data []byte, merge bool, hash string) (*pb.PutMetadataResponse, error) {
+	return client.client.PutMetadata(ctx, &pb.PutMetadataRequest{
+		Address:  address,
+		Metadata: data,
+		Merge:    merge,
+		IfMatch:  hash,
+	})
+}
+
+// GetCoverageStats - returns counts of distinct selectors, topics and signatures known by indexer and share of ambiguous selectors
+func (client *Client) GetCoverageStats(ctx context.Context) (*pb.GetCoverageStatsResponse, error) {
+	return client.client.GetCoverageStats(ctx, new(pb.GetCoverageStatsRequest))
+}
+
+// Keccak256 - returns hex-encoded keccak256 hash of text. Text isn't canonicalized as signature.
+func (client *Client) Keccak256(ctx context.Context, text string) (string, error) {
+	response, err := client.client.Keccak256(ctx, &pb.Keccak256Request{
+		Text: text,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Hash, nil
+}
+
+// ParseSignature - parses human-readable signature of function, event or error to JSON ABI entry
+func (client *Client) ParseSignature(ctx context.Context, signature string) (*pb.ParseSignatureResponse, error) {
+	return client.client.ParseSignature(ctx, &pb.ParseSignatureRequest{
+		Signature: signature,
+	})
+}
+
+// ImportSignatures - stores textual signatures of functions or errors for reverse lookup of selectors and returns counts of inserted, existing and invalid ones. Context should contain admin token in `authorization` metadata.
+func (client *Client) ImportSignatures(ctx context.Context, signatures []string, kind pb.SignatureKind) (*pb.ImportSignaturesResponse, error) {
+	return client.client.ImportSignatures(ctx, &pb.ImportSignaturesRequest{
+		Signatures: signatures,
+		Kind:       kind,
+	})
+}
+
+// ImportMetadata - opens bulk import stream. Send records and receive one ack per record in the same order: `sequence` of ack is equal to `sequence` of record, so the last acknowledged sequence can be saved as checkpoint of import. Context should contain admin token in `authorization` metadata.
+func (client *Client) ImportMetadata(ctx context.Context) (pb.MetadataService_ImportMetadataClient, error) {
+	return client.client.ImportMetadata(ctx)
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"sort"
+	"time"
+)
+
+// ConnectedClient - client which has active metadata subscriptions
+type ConnectedClient struct {
+	ID string
+	// ConnectedAt - time when the oldest connection of the client was accepted. Creation time of the oldest subscription is used if connections are unknown.
+	ConnectedAt time.Time
+	// Addresses - remote addresses of connections of the client
+	Addresses     []string
+	Subscriptions []*MetadataSubscription
+}
+
+// connectedClients - groups active subscriptions by client id. Clients are sorted by id, their subscriptions by id.
+func (server *Server) connectedClients() []ConnectedClient {
+	subs := server.activeSubscriptions.All()
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].id < subs[j].id
+	})
+
+	byID := make(map[string]*ConnectedClient)
+	for _, sub := range subs {
+		client, ok := byID[sub.clientID]
+		if !ok {
+			client = &ConnectedClient{
+				ID:        sub.clientID,
+				Addresses: make([]string, 0),
+			}
+			byID[sub.clientID] = client
+		}
+		client.Subscriptions = append(client.Subscriptions, sub)
+
+		connectedAt := sub.createdAt
+		if sub.peer != "" {
+			if !contains(client.Addresses, sub.peer) {
+				client.Addresses = append(client.Addresses, sub.peer)
+			}
+			if acceptedAt, ok := server.connections.AcceptedAt(sub.peer); ok {
+				connectedAt = acceptedAt
+			}
+		}
+		if client.ConnectedAt.IsZero() || connectedAt.Before(client.ConnectedAt) {
+			client.ConnectedAt = connectedAt
+		}
+	}
+
+	clients := make([]ConnectedClient, 0, len(byID))
+	for _, client := range byID {
+		sort.Strings(client.Addresses)
+		clients = append(clients, *client)
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].ID < clients[j].ID
+	})
+	return clients
+}
+
+// DisconnectResult - count of subscriptions and connections closed by disconnection of client
+type DisconnectResult struct {
+	Subscriptions int
+	Connections   int
+}
+
+// disconnect - evicts all subscriptions of the client and closes their connections. Other clients sharing the same connection are disconnected too.
+func (server *Server) disconnect(clientID string) (DisconnectResult, error) {
+	var result DisconnectResult
+
+	subs := server.activeSubscriptions.ByClient(clientID)
+	addresses := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		sub.Evict()
+		result.Subscriptions++
+		if sub.peer != "" && !contains(addresses, sub.peer) {
+			addresses = append(addresses, sub.peer)
+		}
+	}
+
+	for i := range addresses {
+		closed, err := server.connections.Close(addresses[i])
+		if err != nil {
+			return result, err
+		}
+		if closed {
+			result.Connections++
+		}
+	}
+	return result, nil
+}
+
+func contains(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
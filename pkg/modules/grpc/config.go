@@ -1,6 +1,12 @@
 package grpc
 
-import "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc"
+import (
+	"github.com/dipdup-net/abi-indexer/internal/cache"
+	"github.com/dipdup-net/abi-indexer/internal/publisher"
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules/grpc"
+	sdkStorage "github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
 
 // ClientConfig -
 type ClientConfig struct {
@@ -11,10 +17,78 @@ type ClientConfig struct {
 // Subscriptions -
 type Subscriptions struct {
 	Metadata bool `yaml:"head,omitempty"`
+	// Interface - if it's set only metadata implementing the standard interface (e.g. `ERC721`) are received
+	Interface string `yaml:"interface,omitempty"`
+}
+
+// ServerConfig -
+type ServerConfig struct {
+	grpc.ServerConfig `yaml:",inline"`
+
+	Log       *LogConfig        `yaml:"log" validate:"omitempty"`
+	Publisher *publisher.Config `yaml:"publisher" validate:"omitempty"`
+	Cache     *cache.Config     `yaml:"cache" validate:"omitempty"`
+	Standby   *StandbyConfig    `yaml:"standby" validate:"omitempty"`
+	V2        *V2Config         `yaml:"v2" validate:"omitempty"`
+	Health    *HealthConfig     `yaml:"health" validate:"omitempty"`
+
+	// ReadAfterWriteWindow - count of seconds during which reads of newly written contract are routed to primary storage instead of replica
+	ReadAfterWriteWindow int `yaml:"read_after_write_window" validate:"omitempty,min=0"`
+
+	// PrimaryReads - names of methods which always read contracts from primary storage instead of replica, e.g. `GetMetadata`. Other methods read from primary if client passes `x-read-consistency: primary` metadata.
+	PrimaryReads []string `yaml:"primary_reads" validate:"omitempty"`
+
+	// GetMetadataTimeout - timeout of `GetMetadata` request in milliseconds. Default: 10000. Shorter deadline of client is respected.
+	GetMetadataTimeout int `yaml:"get_metadata_timeout" validate:"omitempty,min=1"`
+
+	// MaxLimit - maximum page size of paginated requests which return contracts. Larger limit is truncated to it. Default: 1000.
+	MaxLimit uint64 `yaml:"max_limit" validate:"omitempty,min=1"`
+
+	// MaxLimits - maximum page size by method name, e.g. `ListIncompleteMetadata: 100`. It overrides `max_limit` and maximum of `ListSelectorSignatures` (default: 1000). Method which isn't paginated fails server start.
+	MaxLimits map[string]uint64 `yaml:"max_limits" validate:"omitempty,dive,min=1"`
+
+	// MaxOffset - maximum offset of paginated requests. Requests with larger offset are rejected with `InvalidArgument`. Default: 100000.
+	MaxOffset uint64 `yaml:"max_offset" validate:"omitempty,min=1"`
+
+	// ReplayOverflow - behavior of resumed subscription when client doesn't read replayed events in time: `throttle` (default) sends replay at pace of client without buffering it, `fail` finishes subscription with `ResourceExhausted` if buffer overflows during replay. Live events are dropped when buffer is full regardless of it.
+	ReplayOverflow ReplayOverflowPolicy `yaml:"replay_overflow" validate:"omitempty,oneof=throttle fail"`
+
+	// DefaultOrder - sort order of paginated requests which don't set order of page: `asc` (default) or `desc`. Client requests ascending order explicitly by `ASC` with `order_set` of request.
+	DefaultOrder sdkStorage.SortOrder `yaml:"default_order" validate:"omitempty,oneof=asc desc"`
+
+	// SampleMethod - method of `SampleMetadata` sampling: `approximate` (default) or `exact`
+	SampleMethod storage.SampleMethod `yaml:"sample_method" validate:"omitempty,oneof=approximate exact"`
+
+	// AdminToken - token which should be passed in `authorization: Bearer <token>` metadata of admin requests. Admin requests are denied if it's empty.
+	AdminToken string `yaml:"admin_token" validate:"omitempty"`
+
+	// DisabledMethods - names of methods which return `Unimplemented`, e.g. `SampleMetadata`. All methods are enabled by default. `Hello` can't be disabled.
+	DisabledMethods []string `yaml:"disabled_methods" validate:"omitempty"`
+
+	// MaxInFlight - maximum count of unary requests which are handled concurrently. Requests above it are rejected with `ResourceExhausted`. Subscriptions and admin methods aren't counted. 0 - unlimited.
+	MaxInFlight int `yaml:"max_in_flight" validate:"omitempty,min=0"`
+
+	// ResultCache - TTL of cached responses in seconds by method name, e.g. `ListTags: 30`. Identical requests of the method are served from memory during TTL. Responses aren't cached by default.
+	ResultCache map[string]int `yaml:"result_cache" validate:"omitempty,dive,min=1"`
+
+	// ExcludedMethods - hex-encoded selectors or signatures of methods which are skipped by `ListSelectorSignatures` with `exclude_system`. Default: plumbing methods of proxies.
+	ExcludedMethods []string `yaml:"excluded_methods" validate:"omitempty"`
+
+	// DecodeMisses - settings of recording selectors which aren't found in stored ABI by `DecodeError` and `GetInputSchema`
+	DecodeMisses *DecodeMissesConfig `yaml:"decode_misses" validate:"omitempty"`
+}
+
+// LogConfig - settings of requests logging. Errors and slow calls are always logged. Other successful calls are logged 1-in-N where N is sample rate of the method.
+type LogConfig struct {
+	SampleRate uint32 `yaml:"sample_rate" validate:"omitempty,min=1"`
+	// SampleRates - sample rate by method name, e.g. `GetMetadata: 1000`. Unknown method fails server start.
+	SampleRates map[string]uint32 `yaml:"sample_rates" validate:"omitempty,dive,min=1"`
+	// SlowThreshold - duration of call in milliseconds above which it's logged regardless of sampling. Zero disables it.
+	SlowThreshold int `yaml:"slow_threshold" validate:"omitempty,min=0"`
 }
 
 // Config -
 type Config struct {
-	Server *grpc.ServerConfig `yaml:"server" validate:"omitempty"`
-	Client *ClientConfig      `yaml:"client" validate:"omitempty"`
+	Server *ServerConfig `yaml:"server" validate:"omitempty"`
+	Client *ClientConfig `yaml:"client" validate:"omitempty"`
 }
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// connections - registry of accepted connections by remote address. It lets admin close connection of evicted client: gRPC server doesn't expose its connections.
+type connections struct {
+	m  map[string]*trackedConn
+	mx *sync.RWMutex
+}
+
+func newConnections() *connections {
+	return &connections{
+		m:  make(map[string]*trackedConn),
+		mx: new(sync.RWMutex),
+	}
+}
+
+func (c *connections) add(conn *trackedConn) {
+	c.mx.Lock()
+	c.m[conn.RemoteAddr().String()] = conn
+	c.mx.Unlock()
+}
+
+func (c *connections) remove(conn *trackedConn) {
+	c.mx.Lock()
+	if c.m[conn.RemoteAddr().String()] == conn {
+		delete(c.m, conn.RemoteAddr().String())
+	}
+	c.mx.Unlock()
+}
+
+// AcceptedAt - returns time when connection with the remote address was accepted
+func (c *connections) AcceptedAt(address string) (time.Time, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	conn, ok := c.m[address]
+	if !ok {
+		return time.Time{}, false
+	}
+	return conn.acceptedAt, true
+}
+
+// Close - closes connection with the remote address. All streams and requests of the connection are terminated. False is returned if there is no such connection.
+func (c *connections) Close(address string) (bool, error) {
+	c.mx.RLock()
+	conn, ok := c.m[address]
+	c.mx.RUnlock()
+
+	if !ok {
+		return false, nil
+	}
+	return true, conn.Close()
+}
+
+// Listener - wraps listener to track connections accepted by it
+func (c *connections) Listener(listener net.Listener) net.Listener {
+	return &trackedListener{
+		Listener: listener,
+		conns:    c,
+	}
+}
+
+type trackedListener struct {
+	net.Listener
+	conns *connections
+}
+
+// Accept -
+func (l *trackedListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	tracked := &trackedConn{
+		Conn:       conn,
+		acceptedAt: time.Now(),
+		conns:      l.conns,
+		once:       new(sync.Once),
+	}
+	l.conns.add(tracked)
+	return tracked, nil
+}
+
+type trackedConn struct {
+	net.Conn
+	acceptedAt time.Time
+	conns      *connections
+	once       *sync.Once
+}
+
+// Close -
+func (c *trackedConn) Close() error {
+	c.once.Do(func() {
+		c.conns.remove(c)
+	})
+	return c.Conn.Close()
+}
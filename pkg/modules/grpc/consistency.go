@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metric names
+const (
+	MetricPrimaryReads = "abi_indexer_primary_reads_total"
+)
+
+// readConsistencyHeader - request metadata key which clients can use to choose storage of reads
+const readConsistencyHeader = "x-read-consistency"
+
+// values of `x-read-consistency` request metadata
+const (
+	ReadConsistencyReplica = "replica"
+	ReadConsistencyPrimary = "primary"
+)
+
+type primaryReadKey struct{}
+
+// withPrimaryRead - marks request context, so contracts are read from primary storage
+func withPrimaryRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadKey{}, true)
+}
+
+// isPrimaryRead - checks that request should read contracts from primary storage
+func isPrimaryRead(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadKey{}).(bool)
+	return primary
+}
+
+// consistencyInterceptor - routes reads of request to primary storage if client passed `x-read-consistency: primary` metadata or method is listed in `primary_reads` of config. Hint is ignored if server doesn't have separate primary storage.
+type consistencyInterceptor struct {
+	methods   map[string]struct{}
+	available bool
+	metrics   *prometheus.Service
+}
+
+func newConsistencyInterceptor(methods []string, available bool, metrics *prometheus.Service) (*consistencyInterceptor, error) {
+	supported := make(map[string]struct{})
+	for _, method := range supportedMethods() {
+		supported[method] = struct{}{}
+	}
+
+	interceptor := &consistencyInterceptor{
+		methods:   make(map[string]struct{}, len(methods)),
+		available: available,
+		metrics:   metrics,
+	}
+	for i := range methods {
+		if _, ok := supported[methods[i]]; !ok {
+			return nil, errors.Errorf("unknown method can't read from primary: %s", methods[i])
+		}
+		interceptor.methods[methods[i]] = struct{}{}
+	}
+
+	if metrics != nil && available {
+		metrics.RegisterCounter(MetricPrimaryReads, "count of requests which read from primary storage instead of replica", "method")
+	}
+	return interceptor, nil
+}
+
+// primary - checks that reads of the call should go to primary storage. Unknown consistency in request metadata is rejected.
+func (interceptor *consistencyInterceptor) primary(ctx context.Context, method string) (bool, error) {
+	primary := false
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(readConsistencyHeader); len(values) > 0 {
+			switch values[0] {
+			case ReadConsistencyPrimary:
+				primary = true
+			case ReadConsistencyReplica, "":
+			default:
+				return false, status.Errorf(codes.InvalidArgument, "invalid %s: %s", readConsistencyHeader, values[0])
+			}
+		}
+	}
+	if _, ok := interceptor.methods[method]; ok {
+		primary = true
+	}
+	return primary && interceptor.available, nil
+}
+
+// Unary -
+func (interceptor *consistencyInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := methodName(info.FullMethod)
+	primary, err := interceptor.primary(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	if !primary {
+		return handler(ctx, req)
+	}
+
+	if interceptor.metrics != nil {
+		interceptor.metrics.IncrementCounter(MetricPrimaryReads, map[string]string{"method": method})
+	}
+	return handler(withPrimaryRead(ctx), req)
+}
+
+// store - returns storage which should be used for reads of the request. Primary is used only if request asks for it, so it bypasses cache and standby snapshot.
+func (server *Server) store(ctx context.Context) storage.IMetadata {
+	if isPrimaryRead(ctx) {
+		return server.primary
+	}
+	return server.metadata
+}
@@ -1,20 +1,141 @@
 package grpc
 
 import (
+	"sort"
+	"time"
+
 	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
 	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
 // Metadata -
 func Metadata(metadata *storage.Metadata) *pb.Metadata {
 	return &pb.Metadata{
-		Address:    metadata.Contract,
+		Address:    checksum(metadata.Contract),
 		Metadata:   metadata.Metadata,
 		JsonSchema: metadata.JSONSchema,
+		IsComplete: metadata.IsComplete,
+		Interfaces: metadata.Interfaces,
+		UpdatedAt:  updatedAt(metadata),
+		Hash:       hash(metadata),
+		Creator:    checksum(metadata.Creator),
+		CreationTx: metadata.CreationTx,
+		CreatedAt:  createdAt(metadata),
+		Factory:    checksum(metadata.Factory),
+		CodeHash:   codeHash(metadata),
+
+		CreationBlock: metadata.CreationBlock,
+		Tags:          metadata.Tags,
+		Source:        metadata.Source,
+		Compilation:   compilation(metadata),
+
+		LastFetchAttempt: lastFetchAttempt(metadata),
+		IsProxy:          metadata.IsProxy,
+		CodeStatus:       CodeStatus(metadata.CodeStatus),
+		CodeCheckedAt:    codeCheckedAt(metadata),
+	}
+}
+
+// compilation - returns compilation details of the contract. It's nil if source of ABI didn't provide them.
+func compilation(metadata *storage.Metadata) *pb.Compilation {
+	if metadata.CompilerVersion == "" {
+		return nil
+	}
+	return &pb.Compilation{
+		CompilerVersion:  metadata.CompilerVersion,
+		OptimizerEnabled: metadata.OptimizerEnabled,
+		OptimizerRuns:    metadata.OptimizerRuns,
+		EvmVersion:       metadata.EVMVersion,
+		License:          metadata.License,
 	}
 }
 
+// AbiCandidates -
+func AbiCandidates(candidates []*storage.Candidate) []*pb.AbiCandidate {
+	result := make([]*pb.AbiCandidate, len(candidates))
+	for i := range candidates {
+		result[i] = &pb.AbiCandidate{
+			Source:   candidates[i].Source,
+			Metadata: candidates[i].Metadata,
+			Hash:     hexutil.Encode(candidates[i].Hash),
+		}
+		if !candidates[i].UpdatedAt.IsZero() {
+			result[i].UpdatedAt = candidates[i].UpdatedAt.Unix()
+		}
+	}
+	return result
+}
+
+// NotModifiedMetadata - short response for conditional request of unchanged metadata
+func NotModifiedMetadata(metadata *storage.Metadata) *pb.Metadata {
+	return &pb.Metadata{
+		Address:     checksum(metadata.Contract),
+		UpdatedAt:   updatedAt(metadata),
+		Hash:        hash(metadata),
+		NotModified: true,
+	}
+}
+
+func updatedAt(metadata *storage.Metadata) int64 {
+	if metadata.UpdatedAt.IsZero() {
+		return 0
+	}
+	return metadata.UpdatedAt.Unix()
+}
+
+func lastFetchAttempt(metadata *storage.Metadata) int64 {
+	if metadata.LastFetchAttempt.IsZero() {
+		return 0
+	}
+	return metadata.LastFetchAttempt.Unix()
+}
+
+func codeCheckedAt(metadata *storage.Metadata) int64 {
+	if metadata.CodeCheckedAt.IsZero() {
+		return 0
+	}
+	return metadata.CodeCheckedAt.Unix()
+}
+
+func createdAt(metadata *storage.Metadata) int64 {
+	if metadata.CreatedAt.IsZero() {
+		return 0
+	}
+	return metadata.CreatedAt.Unix()
+}
+
+func hash(metadata *storage.Metadata) string {
+	if len(metadata.Hash) == 0 {
+		return ""
+	}
+	return hexutil.Encode(metadata.Hash)
+}
+
+func codeHash(metadata *storage.Metadata) string {
+	if len(metadata.CodeHash) == 0 {
+		return ""
+	}
+	return hexutil.Encode(metadata.CodeHash)
+}
+
+// checksum - returns EIP-55 checksummed form of hex address. Addresses are stored in lower case and checksummed on output only. Other values are returned as is.
+func checksum(address string) string {
+	if !common.IsHexAddress(address) {
+		return address
+	}
+	return common.HexToAddress(address).Hex()
+}
+
 // SubscriptionMetadata -
 func SubscriptionMetadata(id uint64, metadata *storage.Metadata) *pb.SubscriptionMetadata {
 	return &pb.SubscriptionMetadata{
@@ -25,18 +146,348 @@ func SubscriptionMetadata(id uint64, metadata *storage.Metadata) *pb.Subscriptio
 	}
 }
 
-// HeadRequest -
-func MetadataRequest() *generalPB.DefaultRequest {
-	return new(generalPB.DefaultRequest)
+// MetadataRequest - request of metadata subscription. If iface is set only metadata implementing the interface are received.
+func MetadataRequest(iface string) *pb.SubscribeOnMetadataRequest {
+	return &pb.SubscribeOnMetadataRequest{
+		Interface: iface,
+	}
 }
 
 // ListMetadataResponse -
-func ListMetadataResponse(metadata []*storage.Metadata) *pb.ListMetadataResponse {
+func ListMetadataResponse(metadata []*storage.Metadata, p *page) *pb.ListMetadataResponse {
 	response := &pb.ListMetadataResponse{
 		Metadata: make([]*pb.Metadata, 0),
+		Page:     p.Proto(),
+		MaxLimit: p.maxLimit,
 	}
 	for i := range metadata {
 		response.Metadata = append(response.Metadata, Metadata(metadata[i]))
 	}
 	return response
 }
+
+// GetMetadataByTopicsBatchResponse - groups events by topic. Every requested topic is returned in request order even if nothing matches it.
+func GetMetadataByTopicsBatchResponse(topics [][]byte, events []storage.TopicEvent) *pb.GetMetadataByTopicsBatchResponse {
+	response := &pb.GetMetadataByTopicsBatchResponse{
+		Topics: make([]*pb.TopicMatches, len(topics)),
+	}
+	index := make(map[string]*pb.TopicMatches, len(topics))
+	for i := range topics {
+		response.Topics[i] = &pb.TopicMatches{
+			Topic:  hexutil.Encode(topics[i]),
+			Events: make([]*pb.TopicEvent, 0),
+		}
+		index[string(topics[i])] = response.Topics[i]
+	}
+	for i := range events {
+		matches, ok := index[string(events[i].SignatureID)]
+		if !ok {
+			continue
+		}
+		matches.Events = append(matches.Events, &pb.TopicEvent{
+			Address:      checksum(events[i].Contract),
+			Name:         events[i].Name,
+			Signature:    events[i].Signature,
+			Anonymous:    events[i].Anonymous,
+			Indexed:      events[i].Indexed,
+			IndexedKnown: events[i].Indexed != nil,
+		})
+	}
+	return response
+}
+
+// ListSubscriptionsResponse -
+func ListSubscriptionsResponse(stats []SubscriptionStats) *pb.ListSubscriptionsResponse {
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ID < stats[j].ID
+	})
+
+	response := &pb.ListSubscriptionsResponse{
+		Subscriptions: make([]*pb.SubscriptionStats, len(stats)),
+	}
+	for i := range stats {
+		response.Subscriptions[i] = &pb.SubscriptionStats{
+			Id:         stats[i].ID,
+			ClientId:   stats[i].ClientID,
+			CreatedAt:  stats[i].CreatedAt.Unix(),
+			Sent:       stats[i].Sent,
+			Dropped:    stats[i].Dropped,
+			Queued:     stats[i].Queued,
+			LagSeconds: stats[i].Lag.Seconds(),
+		}
+	}
+	return response
+}
+
+// ListClientsResponse -
+func ListClientsResponse(clients []ConnectedClient) *pb.ListClientsResponse {
+	response := &pb.ListClientsResponse{
+		Clients: make([]*pb.ConnectedClient, len(clients)),
+	}
+	for i := range clients {
+		client := &pb.ConnectedClient{
+			ClientId:      clients[i].ID,
+			ConnectedAt:   clients[i].ConnectedAt.Unix(),
+			Addresses:     clients[i].Addresses,
+			Subscriptions: make([]*pb.ClientSubscription, len(clients[i].Subscriptions)),
+		}
+		for j, sub := range clients[i].Subscriptions {
+			client.Subscriptions[j] = &pb.ClientSubscription{
+				Id:        sub.id,
+				Interface: sub.iface,
+				CreatedAt: sub.createdAt.Unix(),
+			}
+		}
+		response.Clients[i] = client
+	}
+	return response
+}
+
+// SampleMetadataResponse -
+func SampleMetadataResponse(metadata []*storage.Metadata) *pb.SampleMetadataResponse {
+	response := &pb.SampleMetadataResponse{
+		Metadata: make([]*pb.Metadata, len(metadata)),
+	}
+	for i := range metadata {
+		response.Metadata[i] = Metadata(metadata[i])
+	}
+	return response
+}
+
+// FindSimilarContractsResponse -
+func FindSimilarContractsResponse(fingerprint []byte, similar []storage.SimilarMetadata) *pb.FindSimilarContractsResponse {
+	response := &pb.FindSimilarContractsResponse{
+		Contracts: make([]*pb.SimilarContract, len(similar)),
+	}
+	if len(fingerprint) > 0 {
+		response.Fingerprint = hexutil.Encode(fingerprint)
+	}
+	for i := range similar {
+		response.Contracts[i] = &pb.SimilarContract{
+			Metadata:   Metadata(similar[i].Metadata),
+			Similarity: similar[i].Similarity,
+		}
+	}
+	return response
+}
+
+// ListSelectorSignaturesResponse -
+func ListSelectorSignaturesResponse(items []storage.SelectorSignature, limit uint64) *pb.ListSelectorSignaturesResponse {
+	response := &pb.ListSelectorSignaturesResponse{
+		Items: make([]*pb.SelectorSignature, len(items)),
+	}
+	for i := range items {
+		response.Items[i] = &pb.SelectorSignature{
+			Selector:  hexutil.Encode(items[i].Selector),
+			Signature: items[i].Signature,
+			Kind:      SignatureKind(items[i].Kind),
+		}
+	}
+	if uint64(len(items)) == limit {
+		last := items[len(items)-1]
+		response.NextCursor = selectorCursor{last.Selector, last.Signature, last.Kind}.String()
+	}
+	return response
+}
+
+// DecodeTableEntries -
+func DecodeTableEntries(entries map[string]evm.DecodeTableEntry) map[string]*pb.DecodeTableEntry {
+	result := make(map[string]*pb.DecodeTableEntry, len(entries))
+	for id, entry := range entries {
+		result[id] = &pb.DecodeTableEntry{
+			Name:      entry.Name,
+			Signature: entry.Signature,
+			Fragment:  entry.Fragment,
+			Anonymous: entry.Anonymous,
+		}
+	}
+	return result
+}
+
+// TypedDataStructs -
+func TypedDataStructs(types map[string][]evm.TypedDataField) map[string]*pb.TypedDataStruct {
+	result := make(map[string]*pb.TypedDataStruct, len(types))
+	for name, fields := range types {
+		item := &pb.TypedDataStruct{
+			Fields: make([]*pb.TypedDataField, len(fields)),
+		}
+		for i := range fields {
+			item.Fields[i] = &pb.TypedDataField{
+				Name: fields[i].Name,
+				Type: fields[i].Type,
+			}
+		}
+		result[name] = item
+	}
+	return result
+}
+
+// GetCoverageStatsResponse -
+func GetCoverageStatsResponse(stats storage.CoverageStats, computedAt time.Time) *pb.GetCoverageStatsResponse {
+	response := &pb.GetCoverageStatsResponse{
+		Selectors:          stats.Selectors,
+		Topics:             stats.Topics,
+		Signatures:         stats.Signatures,
+		CollidingSelectors: stats.CollidingSelectors,
+		ComputedAt:         computedAt.Unix(),
+	}
+	if stats.Selectors > 0 {
+		response.CollisionRate = float64(stats.CollidingSelectors) / float64(stats.Selectors)
+	}
+	return response
+}
+
+// SignatureKind -
+func SignatureKind(kind storage.SignatureKind) pb.SignatureKind {
+	if kind == storage.KindError {
+		return pb.SignatureKind_ERROR
+	}
+	return pb.SignatureKind_FUNCTION
+}
+
+// CodeStatus -
+func CodeStatus(status storage.CodeStatus) pb.CodeStatus {
+	switch status {
+	case storage.CodeStatusDeployed:
+		return pb.CodeStatus_DEPLOYED
+	case storage.CodeStatusDestroyed:
+		return pb.CodeStatus_DESTROYED
+	default:
+		return pb.CodeStatus_UNCHECKED
+	}
+}
+
+// AnalyzeTokenResponse -
+func AnalyzeTokenResponse(analysis *evm.TokenAnalysis) *pb.AnalyzeTokenResponse {
+	response := &pb.AnalyzeTokenResponse{
+		IsToken: analysis.IsToken,
+		Issues:  make([]*pb.TokenIssue, len(analysis.Issues)),
+	}
+	for i := range analysis.Issues {
+		response.Issues[i] = &pb.TokenIssue{
+			Code:    analysis.Issues[i].Code,
+			Message: analysis.Issues[i].Message,
+		}
+	}
+	return response
+}
+
+// ListTagsResponse -
+func ListTagsResponse(tags []storage.TagCount) *pb.ListTagsResponse {
+	response := &pb.ListTagsResponse{
+		Tags: make([]*pb.TagCount, len(tags)),
+	}
+	for i := range tags {
+		response.Tags[i] = &pb.TagCount{
+			Tag:   tags[i].Tag,
+			Count: tags[i].Count,
+		}
+	}
+	return response
+}
+
+// DecodeConstructorArgsResponse - converts decoded arguments to response. Values are encoded to JSON.
+func DecodeConstructorArgsResponse(args []evm.Argument) (*pb.DecodeConstructorArgsResponse, error) {
+	arguments, err := DecodedArguments(args)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DecodeConstructorArgsResponse{
+		Arguments: arguments,
+	}, nil
+}
+
+// DecodeErrorResponse -
+func DecodeErrorResponse(decoded *evm.DecodedError) (*pb.DecodeErrorResponse, error) {
+	arguments, err := DecodedArguments(decoded.Args)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DecodeErrorResponse{
+		Name:        decoded.Name,
+		Signature:   decoded.Signature,
+		Arguments:   arguments,
+		Description: decoded.Description,
+	}, nil
+}
+
+// DecodedArguments - converts decoded arguments. Values are encoded to JSON.
+func DecodedArguments(args []evm.Argument) ([]*pb.DecodedArgument, error) {
+	arguments := make([]*pb.DecodedArgument, len(args))
+	for i := range args {
+		value, err := json.Marshal(args[i].Value)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		arguments[i] = &pb.DecodedArgument{
+			Name:       args[i].Name,
+			Type:       args[i].Type,
+			Value:      string(value),
+			TypedValue: AbiValue(args[i].Typed),
+		}
+	}
+	return arguments, nil
+}
+
+// AbiValue - converts decoded value to structured value. Integers are decimal strings to keep precision of 256-bit values, addresses are checksummed.
+func AbiValue(value evm.Value) *pb.AbiValue {
+	result := &pb.AbiValue{
+		Type: value.Type,
+	}
+	switch value.Kind {
+	case evm.KindUint:
+		result.Value = &pb.AbiValue_UintValue{UintValue: value.Number.String()}
+	case evm.KindInt:
+		result.Value = &pb.AbiValue_IntValue{IntValue: value.Number.String()}
+	case evm.KindBool:
+		result.Value = &pb.AbiValue_BoolValue{BoolValue: value.Bool}
+	case evm.KindAddress:
+		result.Value = &pb.AbiValue_AddressValue{AddressValue: value.Address.Hex()}
+	case evm.KindBytes:
+		result.Value = &pb.AbiValue_BytesValue{BytesValue: value.Bytes}
+	case evm.KindArray:
+		items := make([]*pb.AbiValue, len(value.Items))
+		for i := range value.Items {
+			items[i] = AbiValue(value.Items[i])
+		}
+		result.Value = &pb.AbiValue_ArrayValue{ArrayValue: &pb.AbiArray{Items: items}}
+	case evm.KindTuple:
+		components := make([]*pb.AbiTupleComponent, len(value.Components))
+		for i := range value.Components {
+			components[i] = &pb.AbiTupleComponent{
+				Name:  value.Components[i].Name,
+				Value: AbiValue(value.Components[i].Value),
+			}
+		}
+		result.Value = &pb.AbiValue_TupleValue{TupleValue: &pb.AbiTuple{Components: components}}
+	default:
+		result.Value = &pb.AbiValue_StringValue{StringValue: value.String}
+	}
+	return result
+}
+
+// MaintenanceMode -
+func MaintenanceMode(maintenance metadata.Maintenance) *pb.MaintenanceMode {
+	response := &pb.MaintenanceMode{
+		Enabled:    maintenance.Enabled,
+		ShedWrites: maintenance.ShedWrites,
+	}
+	if !maintenance.Since.IsZero() {
+		response.Since = maintenance.Since.Unix()
+	}
+	return response
+}
+
+// RefreshStatus -
+func RefreshStatus(refreshStatus metadata.RefreshStatus) *pb.RefreshStatus {
+	response := &pb.RefreshStatus{
+		Enabled: refreshStatus.Enabled,
+		Paused:  refreshStatus.Paused,
+		Checked: refreshStatus.Checked,
+		Updated: refreshStatus.Updated,
+	}
+	if !refreshStatus.LastRun.IsZero() {
+		response.LastRun = refreshStatus.LastRun.Unix()
+	}
+	return response
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+func TestChecksum(t *testing.T) {
+	// test vectors of EIP-55
+	vectors := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+		"0xde709f2102306220921060314715629080e2fb77",
+		"0x27b1fdb04752bbc536007a920d24acb045561c26",
+	}
+	for _, want := range vectors {
+		for _, input := range []string{want, strings.ToLower(want), "0x" + strings.ToUpper(want[2:])} {
+			if got := checksum(input); got != want {
+				t.Errorf("checksum(%s) = %s, want %s", input, got, want)
+			}
+		}
+	}
+}
+
+func TestChecksumNotAddress(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"0x",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beae",
+		"not an address",
+	} {
+		if got := checksum(value); got != value {
+			t.Errorf("checksum(%q) = %q, want value as is", value, got)
+		}
+	}
+}
+
+func TestGetMetadataByTopicsBatchResponseIndexed(t *testing.T) {
+	var (
+		transfer = []byte{0xdd, 0xf2, 0x52, 0xad}
+		deposit  = []byte{0xe1, 0xff, 0xfc, 0xc4}
+		unknown  = []byte{0x01}
+	)
+	const contract = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+
+	response := GetMetadataByTopicsBatchResponse([][]byte{transfer, unknown, deposit}, []storage.TopicEvent{
+		{SignatureID: transfer, Contract: contract, Name: "Transfer", Indexed: []string{"address", "address"}},
+		{SignatureID: transfer, Contract: contract, Name: "TransferLegacy"},
+		{SignatureID: deposit, Contract: contract, Name: "Deposit", Indexed: []string{}},
+	})
+
+	tests := []struct {
+		topic   int
+		event   int
+		name    string
+		indexed []string
+		known   bool
+	}{
+		{0, 0, "Transfer", []string{"address", "address"}, true},
+		{0, 1, "TransferLegacy", nil, false},
+		{2, 0, "Deposit", nil, true},
+	}
+	if len(response.Topics) != 3 || len(response.Topics[1].Events) != 0 {
+		t.Fatalf("unexpected topics: %v", response.Topics)
+	}
+	for _, tt := range tests {
+		event := response.Topics[tt.topic].Events[tt.event]
+		if event.Name != tt.name {
+			t.Fatalf("event = %s, want %s", event.Name, tt.name)
+		}
+		// empty list isn't distinguished from nil on the wire, so it's reported by indexed_known
+		if len(event.Indexed) != len(tt.indexed) || (len(tt.indexed) > 0 && !reflect.DeepEqual(event.Indexed, tt.indexed)) {
+			t.Fatalf("%s: indexed = %v, want %v", tt.name, event.Indexed, tt.indexed)
+		}
+		if event.IndexedKnown != tt.known {
+			t.Fatalf("%s: indexed_known = %v, want %v", tt.name, event.IndexedKnown, tt.known)
+		}
+	}
+}
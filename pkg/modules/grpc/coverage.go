@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+)
+
+const coverageTTL = time.Minute
+
+// coverage - caches stats of reverse lookup corpus. Concurrent requests after expiration wait for one computation instead of scanning tables in parallel.
+type coverage struct {
+	methods storage.IMethod
+	ttl     time.Duration
+
+	mx         sync.Mutex
+	stats      storage.CoverageStats
+	computedAt time.Time
+}
+
+func newCoverage(methods storage.IMethod) *coverage {
+	return &coverage{
+		methods: methods,
+		ttl:     coverageTTL,
+	}
+}
+
+// Get - returns cached stats and time of their computation. Stats are computed again if they're older than TTL.
+func (c *coverage) Get(ctx context.Context) (storage.CoverageStats, time.Time, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if !c.computedAt.IsZero() && time.Since(c.computedAt) < c.ttl {
+		return c.stats, c.computedAt, nil
+	}
+
+	stats, err := c.methods.CoverageStats(ctx)
+	if err != nil {
+		return stats, time.Time{}, err
+	}
+	c.stats = stats
+	c.computedAt = time.Now()
+	return c.stats, c.computedAt, nil
+}
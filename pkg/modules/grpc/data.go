@@ -1,30 +1,295 @@
 package grpc
 
 import (
+	"context"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	metadataPB "github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 )
 
 type page struct {
-	limit  uint64
-	offset uint64
-	order  storage.SortOrder
+	limit    uint64
+	offset   uint64
+	order    storage.SortOrder
+	maxLimit uint64
 }
 
-func newPage(req *pb.Page) *page {
-	p := new(page)
+const (
+	defaultLimit = 10
+)
+
+// newPage - validates pagination of request. Unset limit means default limit, limit above maxLimit is truncated to it. Unset order means defaultOrder regardless of limit and offset, so all pages of one query are sorted the same way. `ASC` is zero value of enum, so order of page is explicit only if it's `DESC` or orderSet is true: `order_set` of request is the only way to request ascending order while defaultOrder is descending. Unknown order value is an error. Offset above maxOffset is an error: database scans all skipped rows, so deep pages are expensive even if they are empty. Limit and offset are passed to database as signed 64-bit integers, so their sum can't exceed it.
+func newPage(req *pb.Page, orderSet bool, maxLimit, maxOffset uint64, defaultOrder storage.SortOrder) (*page, error) {
+	p := &page{
+		limit:    defaultLimit,
+		order:    defaultOrder,
+		maxLimit: maxLimit,
+	}
 	if req != nil {
-		p.limit = req.Limit
+		if req.Limit > 0 {
+			p.limit = req.Limit
+		}
 		p.offset = req.Offset
 
-		switch req.Order {
-		case pb.SortOrder_ASC:
-			p.order = storage.SortOrderAsc
-		case pb.SortOrder_DESC:
-			p.order = storage.SortOrderDesc
-		default:
-			p.order = storage.SortOrderAsc
+		if orderSet || req.Order != pb.SortOrder_ASC {
+			order, err := sortOrder(req.Order)
+			if err != nil {
+				return nil, err
+			}
+			p.order = order
+		}
+	}
+	// limit is truncated before the sum is checked, so huge limit is capped instead of rejected
+	if p.limit > maxLimit {
+		p.limit = maxLimit
+	}
+	if p.offset > maxOffset {
+		return nil, errors.Errorf("offset %d is greater than maximum %d: use cursor or filters to receive deep pages", p.offset, maxOffset)
+	}
+	if p.limit > math.MaxInt64-p.offset {
+		return nil, errors.Errorf("limit %d is too large", p.limit)
+	}
+	return p, nil
+}
+
+// sortOrder - converts order of request to storage order. `ASC` is zero value of enum, so unset order is ascending.
+func sortOrder(order pb.SortOrder) (storage.SortOrder, error) {
+	switch order {
+	case pb.SortOrder_ASC:
+		return storage.SortOrderAsc, nil
+	case pb.SortOrder_DESC:
+		return storage.SortOrderDesc, nil
+	default:
+		return "", errors.Errorf("invalid sort order: %d", order)
+	}
+}
+
+// protoSortOrder - converts storage sort order to proto enum
+func protoSortOrder(order storage.SortOrder) pb.SortOrder {
+	if order == storage.SortOrderDesc {
+		return pb.SortOrder_DESC
+	}
+	return pb.SortOrder_ASC
+}
+
+// Proto - returns applied pagination parameters
+func (p *page) Proto() *pb.Page {
+	return &pb.Page{
+		Limit:  p.limit,
+		Offset: p.offset,
+		Order:  protoSortOrder(p.order),
+	}
+}
+
+func matchType(match metadataPB.MatchType) models.MatchType {
+	if match == metadataPB.MatchType_ALL {
+		return models.MatchAll
+	}
+	return models.MatchAny
+}
+
+// proxyFilter - returns proxy condition of metadata filter. Nil means proxies aren't filtered.
+func proxyFilter(filter metadataPB.ProxyFilter) *bool {
+	var isProxy bool
+	switch filter {
+	case metadataPB.ProxyFilter_ONLY_PROXIES:
+		isProxy = true
+	case metadataPB.ProxyFilter_WITHOUT_PROXIES:
+		isProxy = false
+	default:
+		return nil
+	}
+	return &isProxy
+}
+
+func signatureKind(kind metadataPB.SignatureKind) models.SignatureKind {
+	if kind == metadataPB.SignatureKind_ERROR {
+		return models.KindError
+	}
+	return models.KindFunction
+}
+
+// selectorCursor - keyset pagination cursor of selector signatures. It's encoded as `<hex selector>:<signature>:<kind>`. Cursors without kind issued by previous versions are accepted.
+type selectorCursor struct {
+	selector  []byte
+	signature string
+	kind      models.SignatureKind
+}
+
+func parseSelectorCursor(cursor string) (selectorCursor, error) {
+	var c selectorCursor
+	if cursor == "" {
+		return c, nil
+	}
+
+	selector, signature, ok := strings.Cut(cursor, ":")
+	if !ok {
+		return c, errors.Errorf("invalid cursor: %s", cursor)
+	}
+	decoded, err := hexutil.Decode(selector)
+	if err != nil {
+		return c, errors.Wrapf(err, "invalid cursor: %s", cursor)
+	}
+	signature, kind, _ := strings.Cut(signature, ":")
+	switch models.SignatureKind(kind) {
+	case "", models.KindFunction, models.KindError:
+	default:
+		return c, errors.Errorf("invalid cursor: %s", cursor)
+	}
+	c.selector = decoded
+	c.signature = signature
+	c.kind = models.SignatureKind(kind)
+	return c, nil
+}
+
+func (c selectorCursor) String() string {
+	return hexutil.Encode(c.selector) + ":" + c.signature + ":" + string(c.kind)
+}
+
+// listCursor - consistent pagination cursor of metadata list. It's encoded as `<order>:<last id>:<snapshot id>`. Snapshot id is the greatest id at the moment of the first page request, so rows inserted later aren't returned.
+type listCursor struct {
+	order    storage.SortOrder
+	lastID   uint64
+	snapshot uint64
+}
+
+func parseListCursor(cursor string) (listCursor, error) {
+	var c listCursor
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 3 {
+		return c, errors.Errorf("invalid cursor: %s", cursor)
+	}
+
+	switch storage.SortOrder(parts[0]) {
+	case storage.SortOrderAsc, storage.SortOrderDesc:
+		c.order = storage.SortOrder(parts[0])
+	default:
+		return c, errors.Errorf("invalid cursor: %s", cursor)
+	}
+
+	lastID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return c, errors.Wrapf(err, "invalid cursor: %s", cursor)
+	}
+	snapshot, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return c, errors.Wrapf(err, "invalid cursor: %s", cursor)
+	}
+	c.lastID = lastID
+	c.snapshot = snapshot
+	return c, nil
+}
+
+// Apply - sets id bounds of the next page to filter
+func (c listCursor) Apply(filter *models.MetadataFilter) {
+	if c.order == storage.SortOrderDesc {
+		filter.BeforeID = c.snapshot + 1
+		if c.lastID > 0 {
+			filter.BeforeID = c.lastID
+		}
+		return
+	}
+	filter.AfterID = c.lastID
+	filter.BeforeID = c.snapshot + 1
+}
+
+func (c listCursor) String() string {
+	return string(c.order) + ":" + strconv.FormatUint(c.lastID, 10) + ":" + strconv.FormatUint(c.snapshot, 10)
+}
+
+// maxIndexedTopics - count of topics of event except signature topic
+const maxIndexedTopics = 3
+
+// indexedTopics - converts hex-encoded values of topic1..topic3 to storage conditions. Empty value matches any value of the topic.
+func indexedTopics(values []string) ([]models.IndexedTopic, error) {
+	if len(values) > maxIndexedTopics {
+		return nil, errors.Errorf("too many indexed topics: %d, maximum is %d", len(values), maxIndexedTopics)
+	}
+
+	conditions := make([]models.IndexedTopic, 0, len(values))
+	for i := range values {
+		if values[i] == "" {
+			continue
+		}
+		value, err := hexutil.Decode(values[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid topic %s", values[i])
+		}
+		if len(value) != 32 {
+			return nil, errors.Errorf("invalid topic %s: it should be 32 bytes", values[i])
+		}
+		conditions = append(conditions, models.IndexedTopic{
+			Position: i + 1,
+			Types:    evm.TopicTypes(value),
+		})
+	}
+	return conditions, nil
+}
+
+// interfaceName - returns name of detected standard interface ignoring case, e.g. `ERC721` for `erc721`. Empty name is returned as is.
+func interfaceName(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	for i := range evm.Interfaces {
+		if strings.EqualFold(evm.Interfaces[i].Name, name) {
+			return evm.Interfaces[i].Name, nil
+		}
+	}
+	return "", errors.Errorf("unknown interface: %s", name)
+}
+
+// tagPattern - allowed format of tag
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:-]{0,63}$`)
+
+// tagNames - validates tags and returns them in lower case without duplicates
+func tagNames(tags []string) ([]string, error) {
+	if len(tags) == 0 || len(tags) > maxTagsCount {
+		return nil, errors.Errorf("tags count should be between 1 and %d", maxTagsCount)
+	}
+	names := make([]string, 0, len(tags))
+	unique := make(map[string]struct{}, len(tags))
+	for i := range tags {
+		name := strings.ToLower(strings.TrimSpace(tags[i]))
+		if !tagPattern.MatchString(name) {
+			return nil, errors.Errorf("invalid tag: %s", tags[i])
+		}
+		if _, ok := unique[name]; ok {
+			continue
+		}
+		unique[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// notModified - checks conditions of GetMetadata request. Entries written before hash and update time were stored are always considered as modified.
+func notModified(metadata *models.Metadata, req *metadataPB.GetMetadataRequest) bool {
+	if req.IfNoneMatch != "" && len(metadata.Hash) > 0 {
+		return strings.EqualFold(req.IfNoneMatch, hexutil.Encode(metadata.Hash))
+	}
+	if req.IfUpdatedAfter > 0 && !metadata.UpdatedAt.IsZero() {
+		return metadata.UpdatedAt.Unix() <= req.IfUpdatedAfter
+	}
+	return false
+}
+
+// requestTimeout - returns the minimum of timeout and time left to the deadline of the incoming request. Handlers never extend deadline of the client.
+func requestTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline); left < timeout {
+			return left
 		}
 	}
-	return p
+	return timeout
 }
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func TestNewPageDefaultOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *pb.Page
+		orderSet     bool
+		defaultOrder storage.SortOrder
+		want         storage.SortOrder
+	}{
+		{"nil page", nil, false, storage.SortOrderDesc, storage.SortOrderDesc},
+		{"empty page", &pb.Page{}, false, storage.SortOrderDesc, storage.SortOrderDesc},
+		{"next page", &pb.Page{Offset: 10}, false, storage.SortOrderDesc, storage.SortOrderDesc},
+		{"only limit", &pb.Page{Limit: 5}, false, storage.SortOrderDesc, storage.SortOrderDesc},
+		{"explicit desc", &pb.Page{Limit: 5, Order: pb.SortOrder_DESC}, false, storage.SortOrderAsc, storage.SortOrderDesc},
+		{"ascending default", &pb.Page{Limit: 5, Offset: 10}, false, storage.SortOrderAsc, storage.SortOrderAsc},
+		{"explicit asc", &pb.Page{Limit: 5, Order: pb.SortOrder_ASC}, true, storage.SortOrderDesc, storage.SortOrderAsc},
+		{"explicit asc of next page", &pb.Page{Offset: 10}, true, storage.SortOrderDesc, storage.SortOrderAsc},
+		{"explicit desc with marker", &pb.Page{Order: pb.SortOrder_DESC}, true, storage.SortOrderAsc, storage.SortOrderDesc},
+		{"marker without page", nil, true, storage.SortOrderDesc, storage.SortOrderDesc},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newPage(tt.req, tt.orderSet, defaultMaxLimit, defaultMaxOffset, tt.defaultOrder)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.order != tt.want {
+				t.Fatalf("order = %s, want %s", p.order, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPageInvalidOrder(t *testing.T) {
+	if _, err := newPage(&pb.Page{Order: pb.SortOrder(10)}, true, defaultMaxLimit, defaultMaxOffset, storage.SortOrderAsc); err == nil {
+		t.Fatal("undeclared order is accepted")
+	}
+}
+
+func TestNewPageLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *pb.Page
+		maxLimit   uint64
+		maxOffset  uint64
+		wantLimit  uint64
+		wantOffset uint64
+		wantErr    bool
+	}{
+		{
+			name:      "default limit",
+			req:       &pb.Page{},
+			maxLimit:  100,
+			maxOffset: 1000,
+			wantLimit: defaultLimit,
+		}, {
+			name:      "default limit above maximum",
+			req:       nil,
+			maxLimit:  5,
+			maxOffset: 1000,
+			wantLimit: 5,
+		}, {
+			name:       "limit and offset",
+			req:        &pb.Page{Limit: 50, Offset: 1000},
+			maxLimit:   100,
+			maxOffset:  1000,
+			wantLimit:  50,
+			wantOffset: 1000,
+		}, {
+			name:       "limit above maximum",
+			req:        &pb.Page{Limit: 500, Offset: 10},
+			maxLimit:   100,
+			maxOffset:  1000,
+			wantLimit:  100,
+			wantOffset: 10,
+		}, {
+			name:       "huge limit is truncated",
+			req:        &pb.Page{Limit: math.MaxUint64, Offset: 10},
+			maxLimit:   100,
+			maxOffset:  1000,
+			wantLimit:  100,
+			wantOffset: 10,
+		}, {
+			name:      "offset above maximum",
+			req:       &pb.Page{Limit: 10, Offset: 1001},
+			maxLimit:  100,
+			maxOffset: 1000,
+			wantErr:   true,
+		}, {
+			name:      "sum overflows signed integer",
+			req:       &pb.Page{Limit: math.MaxInt64, Offset: 1},
+			maxLimit:  math.MaxUint64,
+			maxOffset: math.MaxUint64,
+			wantErr:   true,
+		}, {
+			name:       "sum fits signed integer",
+			req:        &pb.Page{Limit: math.MaxInt64 - 1, Offset: 1},
+			maxLimit:   math.MaxUint64,
+			maxOffset:  math.MaxUint64,
+			wantLimit:  math.MaxInt64 - 1,
+			wantOffset: 1,
+		}, {
+			name:      "limit above signed integer",
+			req:       &pb.Page{Limit: math.MaxUint64},
+			maxLimit:  math.MaxUint64,
+			maxOffset: math.MaxUint64,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newPage(tt.req, false, tt.maxLimit, tt.maxOffset, storage.SortOrderAsc)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("page %d/%d is accepted", p.limit, p.offset)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.limit != tt.wantLimit || p.offset != tt.wantOffset {
+				t.Fatalf("page = %d/%d, want %d/%d", p.limit, p.offset, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
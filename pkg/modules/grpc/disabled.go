@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// disabledInterceptor - rejects calls of methods which are disabled in config with `Unimplemented`, as if server doesn't have them. Methods are matched by short name, so the method is disabled in all services.
+type disabledInterceptor struct {
+	methods map[string]struct{}
+}
+
+func newDisabledInterceptor(methods []string) (*disabledInterceptor, error) {
+	supported := make(map[string]struct{})
+	for _, name := range supportedMethods() {
+		supported[name] = struct{}{}
+	}
+
+	interceptor := &disabledInterceptor{
+		methods: make(map[string]struct{}, len(methods)),
+	}
+	for i := range methods {
+		if _, ok := supported[methods[i]]; !ok {
+			return nil, errors.Errorf("unknown method can't be disabled: %s", methods[i])
+		}
+		if methods[i] == "Hello" {
+			return nil, errors.New("Hello method can't be disabled")
+		}
+		interceptor.methods[methods[i]] = struct{}{}
+	}
+	return interceptor, nil
+}
+
+func (interceptor *disabledInterceptor) disabled(method string) bool {
+	_, ok := interceptor.methods[method]
+	return ok
+}
+
+func (interceptor *disabledInterceptor) check(fullMethod string) error {
+	if name := methodName(fullMethod); interceptor.disabled(name) {
+		return status.Errorf(codes.Unimplemented, "method %s is disabled", name)
+	}
+	return nil
+}
+
+// Unary -
+func (interceptor *disabledInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := interceptor.check(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream -
+func (interceptor *disabledInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := interceptor.check(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"syscall"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageError - converts error received from storage to gRPC status. Missing entity is reported as `NotFound`, connection problems as `Unavailable` and other errors as `Internal`.
+func storageError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case isConnectionError(err):
+		log.Err(err).Msg("storage is unavailable")
+		return status.Error(codes.Unavailable, "storage is unavailable")
+	default:
+		log.Err(err).Msg("storage error")
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// decodeError - converts error of decoding by ABI to gRPC status. Failure of decoding by incomplete ABI results in `FailedPrecondition`, data which doesn't match ABI in `InvalidArgument`, unknown selector in `NotFound`.
+func decodeError(err error) error {
+	switch {
+	case errors.Is(err, metadata.ErrIncompleteABI):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, evm.ErrInvalidArgs):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, evm.ErrUnknownSelector):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return storageError(err)
+	}
+}
+
+// details of errors
+const (
+	errorDomain           = "abi-indexer"
+	versionConflictReason = "VERSION_CONFLICT"
+)
+
+// putError - converts error of manual metadata write to gRPC status. Rejected ABI is reported as `InvalidArgument`, failed conditional write as `Aborted` with current hash in `ErrorInfo` details.
+func putError(err error) error {
+	var conflict *metadata.VersionConflictError
+	switch {
+	case errors.Is(err, metadata.ErrTooLargeABI), errors.Is(err, metadata.ErrInvalidABI):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &conflict):
+		return versionConflictError(conflict)
+	default:
+		return storageError(err)
+	}
+}
+
+// versionConflictError - `Aborted` status of failed conditional write. Client can merge its changes with metadata of `current_hash` and retry.
+func versionConflictError(conflict *metadata.VersionConflictError) error {
+	var current string
+	if len(conflict.Current) > 0 {
+		current = hexutil.Encode(conflict.Current)
+	}
+	st, err := status.New(codes.Aborted, conflict.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason:   versionConflictReason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"current_hash": current},
+	})
+	if err != nil {
+		return status.Error(codes.Aborted, conflict.Error())
+	}
+	return st.Err()
+}
+
+// resumeError - converts error of subscription resume to gRPC status. Expired resume point is reported as `OutOfRange`.
+func resumeError(err error) error {
+	if errors.Is(err, ErrResumeExpired) {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// refreshError - converts error of background refresh management to gRPC status
+func refreshError(err error) error {
+	if errors.Is(err, metadata.ErrRefreshDisabled) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// candidatesError - converts error of receiving ABI candidates to gRPC status
+func candidatesError(err error) error {
+	if errors.Is(err, metadata.ErrCandidatesDisabled) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return storageError(err)
+}
+
+// requireIndex - returns `FailedPrecondition` if some of auxiliary indexes are disabled in config. Lookups by them would return incomplete results.
+func (server *Server) requireIndex(indexes ...metadata.Index) error {
+	for _, index := range indexes {
+		if !server.indexer.Indexed(index) {
+			return status.Errorf(codes.FailedPrecondition, "%s index is disabled in config", index)
+		}
+	}
+	return nil
+}
+
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"incomplete ABI", &metadata.IncompleteABIError{Err: evm.ErrUnknownSelector}, codes.FailedPrecondition},
+		{"unknown selector", errors.Wrap(evm.ErrUnknownSelector, "0xdeadbeef"), codes.NotFound},
+		{"invalid arguments", evm.ErrInvalidArgs, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(decodeError(tt.err)); code != tt.want {
+				t.Fatalf("code = %s, want %s", code, tt.want)
+			}
+		})
+	}
+}
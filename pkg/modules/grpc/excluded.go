@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// defaultExcludedMethods - plumbing methods of proxies which are declared by ABI of many contracts but aren't called by users: EIP-1967 transparent proxies, EIP-1822 (UUPS) implementations and EIP-2535 diamonds
+var defaultExcludedMethods = []string{
+	"implementation()",
+	"admin()",
+	"changeAdmin(address)",
+	"upgradeTo(address)",
+	"upgradeToAndCall(address,bytes)",
+	"proxiableUUID()",
+	"facets()",
+	"facetAddress(bytes4)",
+	"facetAddresses()",
+	"facetFunctionSelectors(address)",
+	"diamondCut((address,uint8,bytes4[])[],address,bytes)",
+}
+
+// excludedSelectors - denylist of method selectors which are skipped by listings on request
+type excludedSelectors struct {
+	// selectors - hex-encoded selectors of denylist
+	selectors map[string]struct{}
+	// list - hex-encoded selectors of denylist in ascending order
+	list []string
+}
+
+// newExcludedSelectors - builds denylist from hex-encoded 4-byte selectors or textual signatures, e.g. `0x5c60da1b` or `implementation()`. Default list is used if entries are empty.
+func newExcludedSelectors(entries []string) (*excludedSelectors, error) {
+	if len(entries) == 0 {
+		entries = defaultExcludedMethods
+	}
+
+	excluded := &excludedSelectors{
+		selectors: make(map[string]struct{}, len(entries)),
+		list:      make([]string, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		var selector []byte
+		if strings.HasPrefix(entry, "0x") {
+			decoded, err := hexutil.Decode(entry)
+			if err != nil || len(decoded) != 4 {
+				return nil, errors.Errorf("invalid excluded selector: %s", entry)
+			}
+			selector = decoded
+		} else {
+			signature, err := evm.ParseSignature(entry)
+			if err != nil {
+				return nil, errors.Wrap(err, "excluded method")
+			}
+			selector = signature.Selector
+		}
+
+		encoded := hexutil.Encode(selector)
+		if _, ok := excluded.selectors[encoded]; ok {
+			continue
+		}
+		excluded.selectors[encoded] = struct{}{}
+		excluded.list = append(excluded.list, encoded)
+	}
+	sort.Strings(excluded.list)
+	return excluded, nil
+}
+
+// Filter - removes functions with excluded selectors. Errors are kept, because denylist contains methods only.
+func (excluded *excludedSelectors) Filter(items []*pb.SelectorSignature) []*pb.SelectorSignature {
+	filtered := make([]*pb.SelectorSignature, 0, len(items))
+	for i := range items {
+		if items[i].Kind == pb.SignatureKind_FUNCTION {
+			if _, ok := excluded.selectors[items[i].Selector]; ok {
+				continue
+			}
+		}
+		filtered = append(filtered, items[i])
+	}
+	return filtered
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricIngestionLag = "abi_indexer_ingestion_lag_seconds"
+)
+
+const (
+	freshnessReportInterval = 5 * time.Second
+	// freshnessQueryInterval - latest write time is read from database not more often. Writes of this instance are observed immediately, database catches writes of other instances.
+	freshnessQueryInterval = 30 * time.Second
+)
+
+// freshness - tracks write time of the most recently ingested metadata. Stalled ingestion is visible as growing lag.
+type freshness struct {
+	repo    storage.IMetadata
+	metrics *prometheus.Service
+	// latest - unix time in nanoseconds of the latest write. Zero means it's unknown.
+	latest *atomic.Int64
+}
+
+func newFreshness(repo storage.IMetadata, metrics *prometheus.Service) *freshness {
+	if metrics != nil {
+		metrics.RegisterGauge(MetricIngestionLag, "seconds since write of the most recently ingested metadata")
+	}
+	return &freshness{
+		repo:    repo,
+		metrics: metrics,
+		latest:  new(atomic.Int64),
+	}
+}
+
+// Observe - moves latest write time forward. Earlier times are ignored.
+func (f *freshness) Observe(updatedAt time.Time) {
+	if updatedAt.IsZero() {
+		return
+	}
+	value := updatedAt.UnixNano()
+	for {
+		latest := f.latest.Load()
+		if value <= latest || f.latest.CompareAndSwap(latest, value) {
+			return
+		}
+	}
+}
+
+// Latest - returns time of the latest write. Zero time is returned if nothing was written yet.
+func (f *freshness) Latest() time.Time {
+	latest := f.latest.Load()
+	if latest == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, latest)
+}
+
+// Lag - returns time since the latest write. Zero is returned if it's unknown.
+func (f *freshness) Lag() time.Duration {
+	latest := f.Latest()
+	if latest.IsZero() {
+		return 0
+	}
+	if lag := time.Since(latest); lag > 0 {
+		return lag
+	}
+	return 0
+}
+
+func (f *freshness) load(ctx context.Context) {
+	latest, err := f.repo.LatestUpdate(ctx)
+	if err != nil {
+		log.Err(err).Msg("receiving latest update of metadata")
+		return
+	}
+	f.Observe(latest)
+}
+
+func (f *freshness) run(ctx context.Context) {
+	f.load(ctx)
+	queriedAt := time.Now()
+
+	ticker := time.NewTicker(freshnessReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Since(queriedAt) >= freshnessQueryInterval {
+				f.load(ctx)
+				queriedAt = time.Now()
+			}
+			if f.metrics != nil {
+				f.metrics.SetGaugeValue(MetricIngestionLag, nil, f.Lag().Seconds())
+			}
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// metric names
+const (
+	MetricReady = "abi_indexer_ready"
+)
+
+// names of services of gRPC health check
+const (
+	HealthLiveness  = "liveness"
+	HealthReadiness = "readiness"
+)
+
+const (
+	defaultHealthInterval = 5
+	defaultHealthTimeout  = 2
+)
+
+// HealthConfig - settings of readiness check. Durations are in seconds. Zero value means default.
+type HealthConfig struct {
+	// Interval - period of storage ping. Default: 5
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// Timeout - timeout of storage ping. Default: 2
+	Timeout int `yaml:"timeout" validate:"omitempty,min=1"`
+}
+
+// readiness - serves gRPC health check. Liveness is serving while server runs. Readiness is serving only if storage answers ping and standby snapshot is available in standby mode. Empty service name reports readiness.
+type readiness struct {
+	health   *health.Server
+	pinger   storage.Pinger
+	standby  *standbyMetadata
+	interval time.Duration
+	timeout  time.Duration
+	metrics  *prometheus.Service
+
+	ready *atomic.Bool
+}
+
+func newReadiness(repo storage.IMetadata, standby *standbyMetadata, cfg *HealthConfig, metrics *prometheus.Service) *readiness {
+	r := &readiness{
+		health:   health.NewServer(),
+		standby:  standby,
+		interval: time.Duration(defaultHealthInterval) * time.Second,
+		timeout:  time.Duration(defaultHealthTimeout) * time.Second,
+		metrics:  metrics,
+		ready:    new(atomic.Bool),
+	}
+	if pinger, ok := repo.(storage.Pinger); ok {
+		r.pinger = pinger
+	}
+	if cfg != nil {
+		if cfg.Interval > 0 {
+			r.interval = time.Duration(cfg.Interval) * time.Second
+		}
+		if cfg.Timeout > 0 {
+			r.timeout = time.Duration(cfg.Timeout) * time.Second
+		}
+	}
+	if metrics != nil {
+		metrics.RegisterGauge(MetricReady, "1 if server is ready to serve requests: storage is reachable and standby snapshot is available, 0 otherwise")
+	}
+
+	r.health.SetServingStatus(HealthLiveness, healthpb.HealthCheckResponse_SERVING)
+	r.set(false)
+	return r
+}
+
+// run - periodically checks readiness until context is cancelled
+func (r *readiness) run(ctx context.Context) {
+	r.check(ctx)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+func (r *readiness) check(ctx context.Context) {
+	err := r.ping(ctx)
+	ready := err == nil
+	// reads of contracts are served from snapshot during outage of database in standby mode
+	if r.standby != nil {
+		ready = r.standby.Age() > 0
+	}
+
+	if ready != r.ready.Load() {
+		if ready {
+			log.Info().Msg("server is ready")
+		} else {
+			log.Warn().Err(err).Msg("server isn't ready")
+		}
+	}
+	r.set(ready)
+}
+
+func (r *readiness) ping(ctx context.Context) error {
+	if r.pinger == nil {
+		return nil
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	return r.pinger.Ping(pingCtx)
+}
+
+func (r *readiness) set(ready bool) {
+	r.ready.Store(ready)
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if ready {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	r.health.SetServingStatus(HealthReadiness, status)
+	r.health.SetServingStatus("", status)
+
+	if r.metrics != nil {
+		var value float64
+		if ready {
+			value = 1
+		}
+		r.metrics.SetGaugeValue(MetricReady, nil, value)
+	}
+}
+
+// isHealthMethod - checks that method belongs to gRPC health service
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// Shutdown - reports all services as not serving, so server is removed from load balancer before it stops
+func (r *readiness) Shutdown() {
+	r.ready.Store(false)
+	r.health.Shutdown()
+	if r.metrics != nil {
+		r.metrics.SetGaugeValue(MetricReady, nil, 0)
+	}
+}
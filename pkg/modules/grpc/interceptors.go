@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// methodName - returns short method name from full gRPC method, e.g. `GetMetadata` from `/proto.MetadataService/GetMetadata`
+func methodName(fullMethod string) string {
+	return path.Base(fullMethod)
+}
+
+// clientIDHeader - request metadata key which clients can use to identify themselves
+const clientIDHeader = "x-client-id"
+
+// clientID - returns identifier of the client passed in request metadata. Peer address is used if client didn't pass it.
+func clientID(ctx context.Context) string {
+	if id := passedClientID(ctx); id != "" {
+		return id
+	}
+	if address := peerAddress(ctx); address != "" {
+		return address
+	}
+	return "unknown"
+}
+
+// passedClientID - returns identifier passed by client in request metadata. Empty string is returned if it isn't passed.
+func passedClientID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(clientIDHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// peerAddress - returns remote address of connection of the request. Empty string is returned if it's unknown.
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// clientIDSize - count of random bytes of generated client identifier
+const clientIDSize = 16
+
+// randReader - cryptographically secure source of client identifiers
+var randReader io.Reader = rand.Reader
+
+// newClientID - generates random client identifier from cryptographically secure source. Name is used as prefix to make identifier readable. Error is returned if source fails or returns less bytes than requested, identifier is never generated from partial or fallback randomness.
+func newClientID(name string) (string, error) {
+	buf := make([]byte, clientIDSize)
+	if _, err := io.ReadFull(randReader, buf); err != nil {
+		return "", errors.Wrap(err, "generating client id")
+	}
+	id := hex.EncodeToString(buf)
+	if name != "" {
+		id = fmt.Sprintf("%s-%s", name, id)
+	}
+	return id, nil
+}
+
+// methodUnaryInterceptor - puts called method name to request context to attribute storage calls
+func methodUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(storage.WithMethod(ctx, methodName(info.FullMethod)), req)
+}
+
+// methodStreamInterceptor - puts called method name to stream context to attribute storage calls
+func methodStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &wrappedStream{
+		ServerStream: ss,
+		ctx:          storage.WithMethod(ss.Context(), methodName(info.FullMethod)),
+	})
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+
+	ctx context.Context // nolint:containedctx
+}
+
+// Context -
+func (stream *wrappedStream) Context() context.Context {
+	return stream.ctx
+}
+
+type logInterceptor struct {
+	sampled map[string]zerolog.Logger
+	def     zerolog.Logger
+	// slow - calls which are longer than it are logged regardless of sampling. Zero disables it.
+	slow time.Duration
+}
+
+func newLogInterceptor(cfg *LogConfig) (*logInterceptor, error) {
+	interceptor := &logInterceptor{
+		sampled: make(map[string]zerolog.Logger),
+		def:     log.Logger,
+	}
+	if cfg == nil {
+		return interceptor, nil
+	}
+
+	supported := make(map[string]struct{})
+	for _, method := range supportedMethods() {
+		supported[method] = struct{}{}
+	}
+
+	if cfg.SampleRate > 1 {
+		interceptor.def = log.Sample(&zerolog.BasicSampler{N: cfg.SampleRate})
+	}
+	for method, rate := range cfg.SampleRates {
+		if _, ok := supported[method]; !ok {
+			return nil, errors.Errorf("unknown method in sample_rates: %s", method)
+		}
+		interceptor.sampled[method] = log.Sample(&zerolog.BasicSampler{N: rate})
+	}
+	interceptor.slow = time.Duration(cfg.SlowThreshold) * time.Millisecond
+	return interceptor, nil
+}
+
+func (interceptor *logInterceptor) log(fullMethod string, duration time.Duration, err error) {
+	method := methodName(fullMethod)
+
+	if err != nil {
+		log.Err(err).Str("method", method).Int64("duration_ms", duration.Milliseconds()).Msg("request failed")
+		return
+	}
+	if interceptor.slow > 0 && duration > interceptor.slow {
+		log.Warn().Str("method", method).Int64("duration_ms", duration.Milliseconds()).Msg("slow request")
+		return
+	}
+
+	logger, ok := interceptor.sampled[method]
+	if !ok {
+		logger = interceptor.def
+	}
+	logger.Info().Str("method", method).Int64("duration_ms", duration.Milliseconds()).Msg("request")
+}
+
+// Unary -
+func (interceptor *logInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	interceptor.log(info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+// Stream -
+func (interceptor *logInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	interceptor.log(info.FullMethod, time.Since(start), err)
+	return err
+}
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("entropy source is unavailable")
+}
+
+func withRandReader(t *testing.T, reader io.Reader) {
+	t.Helper()
+	previous := randReader
+	randReader = reader
+	t.Cleanup(func() {
+		randReader = previous
+	})
+}
+
+func TestNewClientID(t *testing.T) {
+	withRandReader(t, bytes.NewReader(bytes.Repeat([]byte{0xab}, clientIDSize)))
+
+	id, err := newClientID("indexer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "indexer-" + strings.Repeat("ab", clientIDSize); id != want {
+		t.Fatalf("id = %q, want %q", id, want)
+	}
+}
+
+func TestHelloRandomFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		reader func() io.Reader
+	}{
+		{
+			name:   "failing reader",
+			reader: func() io.Reader { return failingReader{} },
+		}, {
+			name:   "short reader",
+			reader: func() io.Reader { return bytes.NewReader(make([]byte, clientIDSize-1)) },
+		}, {
+			name:   "empty reader",
+			reader: func() io.Reader { return bytes.NewReader(nil) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withRandReader(t, tt.reader())
+			if id, err := newClientID("indexer"); err == nil || id != "" {
+				t.Fatalf("newClientID() = %q, %v, want error without id", id, err)
+			}
+
+			withRandReader(t, tt.reader())
+			server := &Server{}
+			response, err := server.Hello(context.Background(), &pb.HelloRequest{Name: "indexer"})
+			if code := status.Code(err); code != codes.Unavailable {
+				t.Fatalf("code = %s, want %s", code, codes.Unavailable)
+			}
+			if response != nil {
+				t.Fatalf("response with client id %q is returned", response.ClientId)
+			}
+		})
+	}
+}
+
+func TestNewLogInterceptorUnknownMethod(t *testing.T) {
+	if _, err := newLogInterceptor(&LogConfig{SampleRates: map[string]uint32{"GetMetadata": 10}}); err != nil {
+		t.Fatalf("known method: %v", err)
+	}
+	if _, err := newLogInterceptor(&LogConfig{SampleRates: map[string]uint32{"GetMetdata": 10}}); err == nil {
+		t.Fatal("unknown method in sample_rates is accepted")
+	}
+}
+
+func TestLogInterceptorSlowCalls(t *testing.T) {
+	var buf bytes.Buffer
+	previous := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() {
+		log.Logger = previous
+	})
+
+	interceptor, err := newLogInterceptor(&LogConfig{SampleRate: 1000, SlowThreshold: 100})
+	if err != nil {
+		t.Fatalf("newLogInterceptor: %v", err)
+	}
+	// the first call is kept by sampler, so the next fast one is dropped
+	interceptor.log("/proto.MetadataService/GetMetadata", time.Millisecond, nil)
+	buf.Reset()
+
+	interceptor.log("/proto.MetadataService/GetMetadata", time.Millisecond, nil)
+	if buf.Len() != 0 {
+		t.Fatalf("sampled out fast call is logged: %s", buf.String())
+	}
+	interceptor.log("/proto.MetadataService/GetMetadata", 200*time.Millisecond, nil)
+	if !strings.Contains(buf.String(), "slow request") {
+		t.Fatalf("slow call isn't logged: %q", buf.String())
+	}
+}
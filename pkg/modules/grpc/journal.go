@@ -0,0 +1,268 @@
+package grpc
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// journalSize - count of the latest events which can be replayed. It doesn't exceed subscription buffer, so replay always fits into queue of new subscription.
+const journalSize = subscriptionBufferSize
+
+// errors
+var (
+	ErrResumeExpired = errors.New("events after requested event id are not available")
+)
+
+// ReplayOverflowPolicy - behavior of resumed subscription which client doesn't read replayed events in time
+type ReplayOverflowPolicy string
+
+// replay overflow policies
+const (
+	// ReplayOverflowThrottle - replayed events are sent by serving loop of subscription at pace of client and don't take subscription buffer. Live events published during replay are buffered as usual.
+	ReplayOverflowThrottle ReplayOverflowPolicy = "throttle"
+	// ReplayOverflowFail - replayed events are queued to subscription buffer. If buffer overflows before they are delivered, subscription is finished with `ResourceExhausted`.
+	ReplayOverflowFail ReplayOverflowPolicy = "fail"
+)
+
+var metadataSubscriptionsCounter = new(atomic.Uint64)
+
+type journalEvent struct {
+	id       uint64
+	metadata *storage.Metadata
+}
+
+// eventJournal - ring of the latest metadata events which are replayed to resumed subscriptions. Event ids are monotonic: the first id is server start time in nanoseconds, so ids issued after restart are greater than ids issued before it.
+type eventJournal struct {
+	events []journalEvent
+	start  int
+	count  int
+	lastID uint64
+	mx     *sync.Mutex
+}
+
+func newEventJournal(size int) *eventJournal {
+	return &eventJournal{
+		events: make([]journalEvent, size),
+		lastID: uint64(time.Now().UnixNano()),
+		mx:     new(sync.Mutex),
+	}
+}
+
+// Publish - stamps metadata with the next event id, saves it and calls notify with the id. Subscriptions can't be resumed while notify is running, so live events are never sent twice.
+func (j *eventJournal) Publish(metadata *storage.Metadata, notify func(eventID uint64)) {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	j.lastID++
+	j.push(journalEvent{j.lastID, metadata})
+	notify(j.lastID)
+}
+
+// LastID - returns id of the latest published event
+func (j *eventJournal) LastID() uint64 {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	return j.lastID
+}
+
+func (j *eventJournal) push(event journalEvent) {
+	if j.count < len(j.events) {
+		j.events[(j.start+j.count)%len(j.events)] = event
+		j.count++
+		return
+	}
+	j.events[j.start] = event
+	j.start = (j.start + 1) % len(j.events)
+}
+
+// Resume - calls subscribe with events issued after passed id. Events published concurrently wait until subscribe returns. ErrResumeExpired is returned if some of events after the id were evicted or were issued before restart.
+func (j *eventJournal) Resume(afterID uint64, subscribe func(events []journalEvent)) error {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	oldest := j.lastID + 1
+	if j.count > 0 {
+		oldest = j.events[j.start].id
+	}
+	if afterID+1 < oldest || afterID > j.lastID {
+		return errors.Wrapf(ErrResumeExpired, "requested %d, available from %d to %d", afterID, oldest, j.lastID)
+	}
+
+	events := make([]journalEvent, 0, j.lastID-afterID)
+	for i := 0; i < j.count; i++ {
+		event := j.events[(j.start+i)%len(j.events)]
+		if event.id > afterID {
+			events = append(events, event)
+		}
+	}
+	subscribe(events)
+	return nil
+}
+
+// subscribeOn - serves metadata subscription. If afterEventID is set, events issued after it are replayed before live ones. Named subscription without afterEventID is resumed after offset committed by the same client if it exists. Subscription is registered and replayed events are collected under journal lock, so there is neither gap nor duplicate between replayed and live events. Overflow of subscription buffer during replay is handled by replay overflow policy of server.
+func (server *Server) subscribeOn(stream pb.MetadataService_SubscribeOnMetadataServer, subscription *MetadataSubscription, req *pb.SubscribeOnMetadataRequest) error {
+	subscriptionID := metadataSubscriptionsCounter.Add(1)
+	afterEventID := req.AfterEventId
+	if id := passedClientID(stream.Context()); afterEventID == 0 && req.Name != "" && id != "" {
+		if committed, ok := server.offsets.Get(id, req.Name); ok {
+			afterEventID = committed
+		}
+	}
+
+	batch, err := newSubscriptionBatch(subscriptionID, req)
+	if err != nil {
+		subscription.Close()
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// replay - events which are sent before live ones by throttle policy. It's bounded by journal size.
+	var replay []*pb.SubscriptionMetadata
+	register := func(events []journalEvent) {
+		server.metadataSubscriptions.Add(subscriptionID, subscription)
+		for i := range events {
+			if !subscription.Filter(events[i].metadata) {
+				continue
+			}
+			msg := eventMessage(subscriptionID, events[i].id, events[i].metadata)
+			if server.replayOverflow == ReplayOverflowFail {
+				subscription.Replay(msg)
+			} else {
+				replay = append(replay, msg)
+			}
+		}
+	}
+
+	if afterEventID > 0 {
+		if err := server.journal.Resume(afterEventID, register); err != nil {
+			subscription.Close()
+			return resumeError(err)
+		}
+	}
+
+	if err := stream.SendMsg(&generalPB.SubscribeResponse{
+		Id: subscriptionID,
+	}); err != nil {
+		server.metadataSubscriptions.Remove(subscriptionID)
+		subscription.Close()
+		return err
+	}
+	server.activeSubscriptions.Add(subscriptionID, subscription)
+	defer server.activeSubscriptions.Remove(subscriptionID)
+	server.observeSubscription(subscriptionEvent{
+		id:           subscriptionID,
+		subscription: subscription,
+		resumed:      afterEventID > 0,
+	})
+
+	if afterEventID == 0 {
+		register(nil)
+	}
+
+	var (
+		timer *time.Timer
+		flush <-chan time.Time
+	)
+	deliver := func(msg *pb.SubscriptionMetadata) bool {
+		if batch == nil {
+			return sendSubscriptionMessage(stream, msg)
+		}
+		if batch.Add(msg) {
+			if flush != nil {
+				timer.Stop()
+				flush = nil
+			}
+			return sendSubscriptionMessage(stream, batch.Flush())
+		}
+		if flush == nil {
+			timer = time.NewTimer(batch.interval)
+			flush = timer.C
+		}
+		return true
+	}
+
+	// stream send blocks by flow control, so replay goes at pace of client
+	finished := false
+	for i := range replay {
+		if finished = stream.Context().Err() != nil || subscription.Closed() || !deliver(replay[i]); finished {
+			break
+		}
+	}
+	replay = nil
+
+loop:
+	for !finished {
+		select {
+		case <-stream.Context().Done():
+			break loop
+		case <-flush:
+			flush = nil
+			if !sendSubscriptionMessage(stream, batch.Flush()) {
+				break loop
+			}
+		case msg, ok := <-subscription.Listen():
+			if !ok || !deliver(msg) {
+				break loop
+			}
+		}
+	}
+	if flush != nil {
+		timer.Stop()
+	}
+	// partial batch is sent, so messages accepted from subscription aren't lost when it's finished
+	if batch != nil && batch.Len() > 0 {
+		sendSubscriptionMessage(stream, batch.Flush())
+	}
+
+	server.observeSubscription(subscriptionEvent{
+		id:           subscriptionID,
+		subscription: subscription,
+		reason:       closeReason(stream.Context(), subscription),
+	})
+
+	err = server.metadataSubscriptions.Remove(subscriptionID)
+	if subscription.Evicted() {
+		return status.Error(codes.Aborted, "subscription was closed by admin")
+	}
+	if subscription.Overflowed() {
+		return status.Error(codes.ResourceExhausted, "subscription buffer overflowed during replay: resume after the last received event")
+	}
+	return err
+}
+
+// sendSubscriptionMessage - sends message to subscription stream. It returns false if stream is closed by client.
+func sendSubscriptionMessage(stream pb.MetadataService_SubscribeOnMetadataServer, msg *pb.SubscriptionMetadata) bool {
+	if err := stream.Send(msg); err != nil {
+		if err == io.EOF {
+			return false
+		}
+		log.Err(err).Msg("sending message error")
+	}
+	return true
+}
+
+// eventMessage - subscription message stamped with event id
+func eventMessage(subscriptionID, eventID uint64, metadata *storage.Metadata) *pb.SubscriptionMetadata {
+	msg := SubscriptionMetadata(subscriptionID, metadata)
+	msg.EventId = eventID
+	return msg
+}
+
+// publish - sends metadata to subscriptions stamped with the next event id
+func (server *Server) publish(metadata *storage.Metadata) {
+	server.journal.Publish(metadata, func(eventID uint64) {
+		server.metadataSubscriptions.NotifyAll(metadata, func(subscriptionID uint64, metadata *storage.Metadata) *pb.SubscriptionMetadata {
+			return eventMessage(subscriptionID, eventID, metadata)
+		})
+	})
+}
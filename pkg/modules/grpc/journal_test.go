@@ -0,0 +1,345 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules/grpc"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newJournalServer(policy ReplayOverflowPolicy) *Server {
+	return &Server{
+		metadataSubscriptions: grpc.NewSubscriptions[*storage.Metadata, *pb.SubscriptionMetadata](),
+		activeSubscriptions:   newActiveSubscriptions(),
+		journal:               newEventJournal(journalSize),
+		offsets:               newCommittedOffsets(),
+		replayOverflow:        policy,
+	}
+}
+
+func publishEvents(server *Server, count int) {
+	for i := 0; i < count; i++ {
+		server.publish(&storage.Metadata{Contract: "0x5fbdb2315678afecb367f032d93f642f64180aa3"})
+	}
+}
+
+// slowStream - subscription stream of consumer which spends delay on each message
+type slowStream struct {
+	gogrpc.ServerStream
+
+	ctx      context.Context // nolint:containedctx
+	delay    time.Duration
+	received chan uint64
+
+	mx       sync.Mutex
+	eventIDs []uint64
+}
+
+func newSlowStream(ctx context.Context, delay time.Duration) *slowStream {
+	return &slowStream{
+		ctx:      ctx,
+		delay:    delay,
+		received: make(chan uint64, 4*subscriptionBufferSize),
+	}
+}
+
+func (s *slowStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowStream) SendMsg(any) error {
+	return nil
+}
+
+func (s *slowStream) Send(msg *pb.SubscriptionMetadata) error {
+	time.Sleep(s.delay)
+	s.mx.Lock()
+	s.eventIDs = append(s.eventIDs, msg.EventId)
+	s.mx.Unlock()
+	s.received <- msg.EventId
+	return nil
+}
+
+func (s *slowStream) EventIDs() []uint64 {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return append([]uint64(nil), s.eventIDs...)
+}
+
+func subscribe(server *Server, stream *slowStream, afterEventID uint64) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- server.subscribeOn(stream, NewMetadataSubscription("client", "peer", ""), &pb.SubscribeOnMetadataRequest{
+			AfterEventId: afterEventID,
+		})
+	}()
+	return done
+}
+
+func TestReplayOverflowThrottle(t *testing.T) {
+	server := newJournalServer(ReplayOverflowThrottle)
+	first := server.journal.LastID() + 1
+	publishEvents(server, journalSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newSlowStream(ctx, 50*time.Microsecond)
+	done := subscribe(server, stream, first-1)
+
+	// live events flood server during replay and fill the whole buffer of subscription
+	<-stream.received
+	publishEvents(server, 2*subscriptionBufferSize)
+
+	for i := 1; i < journalSize; i++ {
+		select {
+		case <-stream.received:
+		case err := <-done:
+			t.Fatalf("subscription is finished during replay: %v", err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("only %d of %d replayed events are delivered", i, journalSize)
+		}
+	}
+	cancel()
+	if err := <-done; status.Code(err) == codes.ResourceExhausted {
+		t.Fatalf("throttled replay is finished with %v", err)
+	}
+
+	eventIDs := stream.EventIDs()
+	for i := 0; i < journalSize; i++ {
+		if want := first + uint64(i); eventIDs[i] != want {
+			t.Fatalf("event %d: id = %d, want %d", i, eventIDs[i], want)
+		}
+	}
+	for i := journalSize; i < len(eventIDs); i++ {
+		if eventIDs[i] <= eventIDs[i-1] {
+			t.Fatalf("live event id %d isn't greater than previous %d", eventIDs[i], eventIDs[i-1])
+		}
+	}
+}
+
+func TestReplayOverflowFail(t *testing.T) {
+	server := newJournalServer(ReplayOverflowFail)
+	first := server.journal.LastID() + 1
+	publishEvents(server, journalSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newSlowStream(ctx, 5*time.Millisecond)
+	done := subscribe(server, stream, first-1)
+
+	<-stream.received
+	publishEvents(server, 2*subscriptionBufferSize)
+
+	select {
+	case err := <-done:
+		if code := status.Code(err); code != codes.ResourceExhausted {
+			t.Fatalf("code = %s, want %s: %v", code, codes.ResourceExhausted, err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription isn't finished after overflow during replay")
+	}
+	if delivered := len(stream.EventIDs()); delivered >= journalSize {
+		t.Fatalf("%d events are delivered, replay should be interrupted", delivered)
+	}
+}
+
+func TestReplayOverflowFailLive(t *testing.T) {
+	server := newJournalServer(ReplayOverflowFail)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newSlowStream(ctx, time.Millisecond)
+	done := subscribe(server, stream, 0)
+
+	// live overflow isn't affected by replay policy: events are dropped and subscription is alive
+	for received := false; !received; {
+		publishEvents(server, 1)
+		select {
+		case <-stream.received:
+			received = true
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	publishEvents(server, 2*subscriptionBufferSize)
+	select {
+	case err := <-done:
+		t.Fatalf("subscription is finished by live overflow: %v", err)
+	case <-stream.received:
+	}
+	cancel()
+	if err := <-done; status.Code(err) == codes.ResourceExhausted {
+		t.Fatalf("live overflow is finished with %v", err)
+	}
+}
+
+func TestEventJournalMonotonicIDs(t *testing.T) {
+	journal := newEventJournal(4)
+	previous := journal.LastID()
+	for i := 0; i < 10; i++ {
+		var eventID uint64
+		journal.Publish(&storage.Metadata{}, func(id uint64) {
+			eventID = id
+		})
+		if eventID != previous+1 {
+			t.Fatalf("event id = %d, want %d", eventID, previous+1)
+		}
+		if journal.LastID() != eventID {
+			t.Fatalf("last id = %d, want %d", journal.LastID(), eventID)
+		}
+		previous = eventID
+	}
+
+	// journal of restarted server starts from current time, so it issues greater ids
+	time.Sleep(time.Millisecond)
+	if restarted := newEventJournal(4); restarted.LastID() <= previous {
+		t.Fatalf("id %d after restart isn't greater than %d", restarted.LastID(), previous)
+	}
+}
+
+func TestEventJournalResume(t *testing.T) {
+	journal := newEventJournal(4)
+	first := journal.LastID() + 1
+	for i := 0; i < 6; i++ {
+		journal.Publish(&storage.Metadata{}, func(uint64) {})
+	}
+	last := journal.LastID()
+
+	tests := []struct {
+		name    string
+		afterID uint64
+		want    []uint64
+		wantErr bool
+	}{
+		{"latest events", last - 2, []uint64{last - 1, last}, false},
+		{"oldest kept event", first + 1, []uint64{first + 2, first + 3, first + 4, first + 5}, false},
+		{"last event", last, []uint64{}, false},
+		{"evicted events", first, nil, true},
+		{"before restart", first - 100, nil, true},
+		{"future event", last + 1, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var replayed []uint64
+			err := journal.Resume(tt.afterID, func(events []journalEvent) {
+				replayed = make([]uint64, len(events))
+				for i := range events {
+					replayed[i] = events[i].id
+				}
+			})
+			if tt.wantErr {
+				if !errors.Is(err, ErrResumeExpired) {
+					t.Fatalf("error = %v, want %v", err, ErrResumeExpired)
+				}
+				if replayed != nil {
+					t.Fatalf("events %v are replayed", replayed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(replayed) != len(tt.want) {
+				t.Fatalf("replayed = %v, want %v", replayed, tt.want)
+			}
+			for i := range tt.want {
+				if replayed[i] != tt.want[i] {
+					t.Fatalf("replayed = %v, want %v", replayed, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSubscribeResumeAfterEventID(t *testing.T) {
+	server := newJournalServer(ReplayOverflowThrottle)
+	first := server.journal.LastID() + 1
+	publishEvents(server, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newSlowStream(ctx, 0)
+	done := subscribe(server, stream, first+4)
+
+	for i := uint64(5); i < 10; i++ {
+		if eventID := <-stream.received; eventID != first+i {
+			t.Fatalf("replayed id = %d, want %d", eventID, first+i)
+		}
+	}
+	// live events follow replayed ones without gap or duplicate
+	publishEvents(server, 1)
+	if eventID := <-stream.received; eventID != first+10 {
+		t.Fatalf("live id = %d, want %d", eventID, first+10)
+	}
+	cancel()
+	<-done
+}
+
+func TestSubscribeResumeExpired(t *testing.T) {
+	server := newJournalServer(ReplayOverflowThrottle)
+	publishEvents(server, 1)
+
+	stream := newSlowStream(context.Background(), 0)
+	err := <-subscribe(server, stream, server.journal.LastID()-100)
+	if code := status.Code(err); code != codes.OutOfRange {
+		t.Fatalf("code = %s, want %s", code, codes.OutOfRange)
+	}
+}
+
+func withClientID(ctx context.Context, id string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDHeader, id))
+}
+
+func TestCommitOffsetScopedByClient(t *testing.T) {
+	server := newJournalServer(ReplayOverflowThrottle)
+	first := server.journal.LastID() + 1
+	publishEvents(server, 10)
+	ctx := context.Background()
+
+	if _, err := server.CommitOffset(ctx, &pb.CommitOffsetRequest{Name: "consumer", EventId: first}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("commit without client id: code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+
+	response, err := server.CommitOffset(withClientID(ctx, "a"), &pb.CommitOffsetRequest{Name: "consumer", EventId: first + 2})
+	if err != nil {
+		t.Fatalf("CommitOffset: %v", err)
+	}
+	if response.EventId != first+2 {
+		t.Fatalf("committed id = %d, want %d", response.EventId, first+2)
+	}
+
+	// commit of another client with the same name doesn't move offset of the first one
+	if _, err := server.CommitOffset(withClientID(ctx, "b"), &pb.CommitOffsetRequest{Name: "consumer", EventId: first + 8}); err != nil {
+		t.Fatalf("CommitOffset: %v", err)
+	}
+	if committed, _ := server.offsets.Get("a", "consumer"); committed != first+2 {
+		t.Fatalf("offset of client a = %d, want %d", committed, first+2)
+	}
+	if committed, _ := server.offsets.Get("b", "consumer"); committed != first+8 {
+		t.Fatalf("offset of client b = %d, want %d", committed, first+8)
+	}
+	if _, ok := server.offsets.Get("", "consumer"); ok {
+		t.Fatal("offset without client id is found")
+	}
+
+	streamCtx, cancel := context.WithCancel(withClientID(ctx, "a"))
+	defer cancel()
+	stream := newSlowStream(streamCtx, 0)
+	done := make(chan error, 1)
+	go func() {
+		done <- server.subscribeOn(stream, NewMetadataSubscription("a", "peer", ""), &pb.SubscribeOnMetadataRequest{Name: "consumer"})
+	}()
+	if eventID := <-stream.received; eventID != first+3 {
+		t.Fatalf("resumed id = %d, want %d", eventID, first+3)
+	}
+	cancel()
+	<-done
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// reasons of subscription teardown
+const (
+	closeReasonUnsubscribe = "unsubscribe"
+	closeReasonConnEnd     = "conn_end"
+	closeReasonEvicted     = "evicted"
+	closeReasonOverflow    = "replay_overflow"
+)
+
+// subscriptionEvent - creation or teardown of metadata subscription. Reason is empty for creation.
+type subscriptionEvent struct {
+	id           uint64
+	subscription *MetadataSubscription
+	resumed      bool
+	reason       string
+}
+
+// closeReason - returns why serving loop of subscription was finished
+func closeReason(ctx context.Context, subscription *MetadataSubscription) string {
+	switch {
+	case subscription.Evicted():
+		return closeReasonEvicted
+	case subscription.Overflowed():
+		return closeReasonOverflow
+	case ctx.Err() != nil:
+		return closeReasonConnEnd
+	default:
+		return closeReasonUnsubscribe
+	}
+}
+
+// observeSubscription - logs subscription event and counts it
+func (server *Server) observeSubscription(event subscriptionEvent) {
+	sub := event.subscription
+	if event.reason == "" {
+		log.Info().
+			Uint64("id", event.id).
+			Str("client", sub.clientID).
+			Str("peer", sub.peer).
+			Str("interface", sub.iface).
+			Bool("resumed", event.resumed).
+			Msg("subscription created")
+		if server.metrics != nil {
+			server.metrics.IncrementCounter(MetricSubscriptionsCreated, map[string]string{"client": sub.clientID})
+		}
+		return
+	}
+
+	log.Info().
+		Uint64("id", event.id).
+		Str("client", sub.clientID).
+		Str("peer", sub.peer).
+		Str("interface", sub.iface).
+		Str("reason", event.reason).
+		Dur("lifetime", time.Since(sub.createdAt)).
+		Msg("subscription closed")
+	if server.metrics != nil {
+		server.metrics.IncrementCounter(MetricSubscriptionsClosed, map[string]string{"client": sub.clientID, "reason": event.reason})
+	}
+}
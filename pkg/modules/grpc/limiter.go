@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dipdup-net/go-lib/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// metric names
+const (
+	MetricInFlight = "abi_indexer_rpc_in_flight"
+)
+
+// inFlightLimiter - caps count of unary requests which are handled concurrently by all clients. Requests above the cap are rejected with `ResourceExhausted` immediately, so bursts don't queue on storage. Streams aren't limited because subscriptions are long-lived, admin methods aren't limited to keep the server manageable under overload.
+type inFlightLimiter struct {
+	slots   chan struct{}
+	metrics *prometheus.Service
+}
+
+func newInFlightLimiter(limit int, metrics *prometheus.Service) *inFlightLimiter {
+	if metrics != nil {
+		metrics.RegisterGauge(MetricInFlight, "count of unary requests which are handled now")
+	}
+
+	interceptor := &inFlightLimiter{
+		metrics: metrics,
+	}
+	if limit > 0 {
+		interceptor.slots = make(chan struct{}, limit)
+	}
+	return interceptor
+}
+
+func (interceptor *inFlightLimiter) acquire() bool {
+	if interceptor.slots != nil {
+		select {
+		case interceptor.slots <- struct{}{}:
+		default:
+			return false
+		}
+	}
+	if interceptor.metrics != nil {
+		interceptor.metrics.IncGaugeValue(MetricInFlight, map[string]string{})
+	}
+	return true
+}
+
+func (interceptor *inFlightLimiter) release() {
+	if interceptor.slots != nil {
+		<-interceptor.slots
+	}
+	if interceptor.metrics != nil {
+		interceptor.metrics.DecGaugeValue(MetricInFlight, map[string]string{})
+	}
+}
+
+// Unary -
+func (interceptor *inFlightLimiter) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := adminMethods[methodName(info.FullMethod)]; ok || isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	if !interceptor.acquire() {
+		return nil, status.Errorf(codes.ResourceExhausted, "server is overloaded: %d requests are in flight", cap(interceptor.slots))
+	}
+	defer interceptor.release()
+
+	return handler(ctx, req)
+}
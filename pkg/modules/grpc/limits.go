@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+const defaultMaxLimit = 1000
+
+// paginatedMethods - methods which limit of response can be capped by `max_limits` of config
+var paginatedMethods = []string{
+	"ListMetadata",
+	"GetMetadataByMethodSinature",
+	"GetMetadataByTopic",
+	"GetMetadataByErrorSelector",
+	"GetMetadataByBytecodeHash",
+	"GetMetadataBySelectors",
+	"GetMetadataByCreator",
+	"ListMetadataByBlockRange",
+	"ListIncompleteMetadata",
+	"GetMetadataByTag",
+	"ListByFactory",
+	"ListSelectorSignatures",
+}
+
+// pageLimits - maximum page size of paginated methods. Limit above maximum is truncated to it.
+type pageLimits struct {
+	// fallback - maximum of methods which aren't overridden, except `ListSelectorSignatures`
+	fallback uint64
+	methods  map[string]uint64
+}
+
+func newPageLimits(fallback uint64, methods map[string]uint64) (*pageLimits, error) {
+	supported := make(map[string]struct{}, len(paginatedMethods))
+	for _, method := range paginatedMethods {
+		supported[method] = struct{}{}
+	}
+
+	limits := &pageLimits{
+		fallback: defaultMaxLimit,
+		methods:  make(map[string]uint64, len(methods)),
+	}
+	if fallback > 0 {
+		limits.fallback = fallback
+	}
+	for method, limit := range methods {
+		if _, ok := supported[method]; !ok {
+			return nil, errors.Errorf("unknown paginated method in max_limits: %s", method)
+		}
+		limits.methods[method] = limit
+	}
+	return limits, nil
+}
+
+// Get - returns maximum page size of the method. `ListSelectorSignatures` returns small items, so it isn't capped by fallback: its default maximum is 1000.
+func (limits *pageLimits) Get(method string) uint64 {
+	if limit, ok := limits.methods[method]; ok {
+		return limit
+	}
+	if method == "ListSelectorSignatures" {
+		return maxSelectorSignaturesLimit
+	}
+	return limits.fallback
+}
+
+// FromContext - returns maximum page size of the method which is handled in context
+func (limits *pageLimits) FromContext(ctx context.Context) uint64 {
+	return limits.Get(storage.MethodFromContext(ctx))
+}
+
+// Overrides - returns maximum page sizes of methods which differ from fallback, so clients can learn them from `Hello`
+func (limits *pageLimits) Overrides() map[string]uint64 {
+	overrides := make(map[string]uint64)
+	for _, method := range paginatedMethods {
+		if limit := limits.Get(method); limit != limits.fallback {
+			overrides[method] = limit
+		}
+	}
+	return overrides
+}
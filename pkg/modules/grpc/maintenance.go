@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeMethods - methods which write to storage. They are rejected in maintenance mode if writes are shed.
+var writeMethods = map[string]struct{}{
+	"RefreshInterfaces": {},
+	"AddTags":           {},
+	"RemoveTags":        {},
+	"PutMetadata":       {},
+	"ImportMetadata":    {},
+	"ImportSignatures":  {},
+}
+
+// maintenanceInterceptor - rejects write methods with `Unavailable` while indexer sheds writes in maintenance mode. Reads are served as usual.
+type maintenanceInterceptor struct {
+	indexer Indexer
+}
+
+func newMaintenanceInterceptor(indexer Indexer) *maintenanceInterceptor {
+	return &maintenanceInterceptor{
+		indexer: indexer,
+	}
+}
+
+func (interceptor *maintenanceInterceptor) check(fullMethod string) error {
+	name := methodName(fullMethod)
+	if _, ok := writeMethods[name]; !ok {
+		return nil
+	}
+	if interceptor.indexer.Maintenance().ShedWrites {
+		return status.Errorf(codes.Unavailable, "method %s is unavailable in maintenance mode", name)
+	}
+	return nil
+}
+
+// Unary -
+func (interceptor *maintenanceInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := interceptor.check(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream -
+func (interceptor *maintenanceInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := interceptor.check(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
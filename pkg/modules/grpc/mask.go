@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fields which are returned only if they are requested by mask
+const (
+	selectorsField  = "selectors"
+	candidatesField = "candidates"
+)
+
+// fields which describe response itself and are never masked
+var unmaskedFields = map[protoreflect.Name]struct{}{
+	"not_modified":   {},
+	"inherited_from": {},
+}
+
+// fieldMask - projection of metadata fields requested by client. Nil mask means all fields except `selectors` and `candidates`.
+type fieldMask struct {
+	paths map[string]struct{}
+}
+
+func newFieldMask(mask *fieldmaskpb.FieldMask) (*fieldMask, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	if !mask.IsValid((*pb.Metadata)(nil)) {
+		return nil, errors.Errorf("invalid field mask: %v", mask.GetPaths())
+	}
+
+	paths := make(map[string]struct{}, len(mask.Paths))
+	for i := range mask.Paths {
+		paths[mask.Paths[i]] = struct{}{}
+	}
+	return &fieldMask{paths}, nil
+}
+
+// Has - checks field is requested
+func (mask *fieldMask) Has(field string) bool {
+	if mask == nil {
+		return field != selectorsField && field != candidatesField
+	}
+	_, ok := mask.paths[field]
+	return ok
+}
+
+// Columns - returns storage columns which should be selected. Large ABI and JSON schema columns are selected only if they are requested. Nil means all columns.
+func (mask *fieldMask) Columns() []string {
+	if mask == nil {
+		return nil
+	}
+	columns := make([]string, len(storage.MetadataLightColumns), len(storage.MetadataLightColumns)+2)
+	copy(columns, storage.MetadataLightColumns)
+	if mask.Has("metadata") {
+		columns = append(columns, storage.ColumnMetadata)
+	}
+	if mask.Has("json_schema") {
+		columns = append(columns, storage.ColumnJSONSchema)
+	}
+	return columns
+}
+
+// Apply - clears fields of response which are not requested
+func (mask *fieldMask) Apply(metadata *pb.Metadata) {
+	if mask == nil {
+		return
+	}
+	msg := metadata.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if _, ok := unmaskedFields[field.Name()]; ok {
+			continue
+		}
+		if !mask.Has(string(field.Name())) {
+			msg.Clear(field)
+		}
+	}
+}
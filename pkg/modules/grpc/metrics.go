@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/metrics"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// metric names
+const (
+	MetricSubscriptionSent     = "abi_indexer_subscription_sent"
+	MetricSubscriptionDropped  = "abi_indexer_subscription_dropped"
+	MetricSubscriptionQueued   = "abi_indexer_subscription_queued"
+	MetricSubscriptionLag      = "abi_indexer_subscription_lag_seconds"
+	MetricRequestDuration      = "abi_indexer_rpc_duration_seconds"
+	MetricSubscriptionsCreated = "abi_indexer_subscriptions_created_total"
+	MetricSubscriptionsClosed  = "abi_indexer_subscriptions_closed_total"
+)
+
+func (server *Server) registerMetrics() {
+	server.metrics.RegisterGauge(MetricSubscriptionSent, "count of messages delivered to active subscriptions of client", "client")
+	server.metrics.RegisterGauge(MetricSubscriptionDropped, "count of messages dropped because subscription buffer of client was full", "client")
+	server.metrics.RegisterGauge(MetricSubscriptionQueued, "count of undelivered messages in subscriptions of client", "client")
+	server.metrics.RegisterGauge(MetricSubscriptionLag, "age of the oldest undelivered message in subscriptions of client", "client")
+	server.metrics.RegisterCounter(MetricSubscriptionsCreated, "count of created metadata subscriptions of client", "client")
+	server.metrics.RegisterCounter(MetricSubscriptionsClosed, "count of closed metadata subscriptions of client by reason: unsubscribe, conn_end, evicted or replay_overflow", "client", "reason")
+}
+
+type durationInterceptor struct {
+	histogram *prom.HistogramVec
+}
+
+func newDurationInterceptor() *durationInterceptor {
+	return &durationInterceptor{
+		histogram: metrics.NewLatencyHistogram(MetricRequestDuration, "duration of gRPC requests by method and status code", "method", "code"),
+	}
+}
+
+func (interceptor *durationInterceptor) observe(fullMethod string, duration time.Duration, err error) {
+	interceptor.histogram.WithLabelValues(methodName(fullMethod), status.Code(err).String()).Observe(duration.Seconds())
+}
+
+// Unary -
+func (interceptor *durationInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	interceptor.observe(info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+// Stream -
+func (interceptor *durationInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	interceptor.observe(info.FullMethod, time.Since(start), err)
+	return err
+}
+
+// reportSubscriptions - periodically exports stats of active subscriptions aggregated by client
+func (server *Server) reportSubscriptions(ctx context.Context) {
+	defer server.wg.Done()
+
+	ticker := time.NewTicker(time.Second * 5)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			clients := make(map[string]SubscriptionStats)
+			for _, stats := range server.activeSubscriptions.Stats() {
+				client := clients[stats.ClientID]
+				client.Sent += stats.Sent
+				client.Dropped += stats.Dropped
+				client.Queued += stats.Queued
+				if stats.Lag > client.Lag {
+					client.Lag = stats.Lag
+				}
+				clients[stats.ClientID] = client
+			}
+
+			for _, name := range []string{MetricSubscriptionSent, MetricSubscriptionDropped, MetricSubscriptionQueued, MetricSubscriptionLag} {
+				server.metrics.Gauge(name).Reset()
+			}
+			for clientID, stats := range clients {
+				labels := map[string]string{"client": clientID}
+				server.metrics.SetGaugeValue(MetricSubscriptionSent, labels, float64(stats.Sent))
+				server.metrics.SetGaugeValue(MetricSubscriptionDropped, labels, float64(stats.Dropped))
+				server.metrics.SetGaugeValue(MetricSubscriptionQueued, labels, float64(stats.Queued))
+				server.metrics.SetGaugeValue(MetricSubscriptionLag, labels, stats.Lag.Seconds())
+			}
+		}
+	}
+}
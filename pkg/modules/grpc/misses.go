@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	defaultDecodeMissesMaxEntries = 10000
+	defaultDecodeMissesLimit      = 100
+	maxDecodeMissesLimit          = 1000
+)
+
+// DecodeMissesConfig - settings of decode misses recording. Zero value means default.
+type DecodeMissesConfig struct {
+	// MaxEntries - maximum count of recorded (address, selector) pairs. The least frequent pair is evicted when new one doesn't fit. Default: 10000
+	MaxEntries int `yaml:"max_entries" validate:"omitempty,min=1"`
+	// SampleRate - only 1-in-N misses are recorded. Default: 1
+	SampleRate uint32 `yaml:"sample_rate" validate:"omitempty,min=1"`
+}
+
+type decodeMissKey struct {
+	address  string
+	selector string
+	kind     pb.SignatureKind
+}
+
+// decodeMiss - how often selector wasn't found in stored ABI of the contract
+type decodeMiss struct {
+	count     uint64
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// decodeMisses - bounded in-memory record of decode requests which failed because selector isn't declared by stored ABI. Counts are sampled, so they are proportional to real traffic instead of equal to it.
+type decodeMisses struct {
+	maxEntries int
+	sampleRate uint64
+
+	seen *atomic.Uint64
+
+	mx      sync.Mutex
+	misses  map[decodeMissKey]*decodeMiss
+	evicted uint64
+}
+
+func newDecodeMisses(cfg *DecodeMissesConfig) *decodeMisses {
+	m := &decodeMisses{
+		maxEntries: defaultDecodeMissesMaxEntries,
+		sampleRate: 1,
+		seen:       new(atomic.Uint64),
+		misses:     make(map[decodeMissKey]*decodeMiss),
+	}
+	if cfg != nil {
+		if cfg.MaxEntries > 0 {
+			m.maxEntries = cfg.MaxEntries
+		}
+		if cfg.SampleRate > 1 {
+			m.sampleRate = uint64(cfg.SampleRate)
+		}
+	}
+	return m
+}
+
+// Record - counts miss of selector in ABI of the contract if it's sampled
+func (m *decodeMisses) Record(address string, selector []byte, kind pb.SignatureKind) {
+	if m.seen.Add(1)%m.sampleRate != 0 {
+		return
+	}
+
+	key := decodeMissKey{
+		address:  strings.ToLower(address),
+		selector: hexutil.Encode(selector),
+		kind:     kind,
+	}
+	now := time.Now().UTC()
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if miss, ok := m.misses[key]; ok {
+		miss.count++
+		miss.lastSeen = now
+		return
+	}
+	if len(m.misses) >= m.maxEntries {
+		m.evict()
+	}
+	m.misses[key] = &decodeMiss{
+		count:     1,
+		firstSeen: now,
+		lastSeen:  now,
+	}
+}
+
+// evict - removes the least frequent miss, the least recent one of equally frequent misses. It should be called under lock.
+func (m *decodeMisses) evict() {
+	var (
+		victim decodeMissKey
+		least  *decodeMiss
+	)
+	for key, miss := range m.misses {
+		if least == nil || miss.count < least.count || (miss.count == least.count && miss.lastSeen.Before(least.lastSeen)) {
+			victim = key
+			least = miss
+		}
+	}
+	if least != nil {
+		delete(m.misses, victim)
+		m.evicted++
+	}
+}
+
+// Forget - removes misses of the contract, e.g. after its ABI is replaced
+func (m *decodeMisses) Forget(address string) {
+	address = strings.ToLower(address)
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	for key := range m.misses {
+		if key.address == address {
+			delete(m.misses, key)
+		}
+	}
+}
+
+// List - returns the most frequent misses. If address isn't empty only misses of the contract are returned.
+func (m *decodeMisses) List(address string, limit int) *pb.ListDecodeMissesResponse {
+	address = strings.ToLower(address)
+
+	m.mx.Lock()
+	response := &pb.ListDecodeMissesResponse{
+		Misses:  make([]*pb.DecodeMiss, 0),
+		Tracked: uint64(len(m.misses)),
+		Evicted: m.evicted,
+	}
+	for key, miss := range m.misses {
+		if address != "" && key.address != address {
+			continue
+		}
+		response.Misses = append(response.Misses, &pb.DecodeMiss{
+			Address:   checksum(key.address),
+			Selector:  key.selector,
+			Kind:      key.kind,
+			Count:     miss.count,
+			FirstSeen: miss.firstSeen.Unix(),
+			LastSeen:  miss.lastSeen.Unix(),
+		})
+	}
+	m.mx.Unlock()
+
+	sort.Slice(response.Misses, func(i, j int) bool {
+		a, b := response.Misses[i], response.Misses[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.LastSeen != b.LastSeen {
+			return a.LastSeen > b.LastSeen
+		}
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Selector < b.Selector
+	})
+	if len(response.Misses) > limit {
+		response.Misses = response.Misses[:limit]
+	}
+	return response
+}
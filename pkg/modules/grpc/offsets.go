@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	maxCommittedOffsets = 10000
+	maxSubscriptionName = 128
+)
+
+type committedOffset struct {
+	eventID   uint64
+	updatedAt time.Time
+}
+
+// committedOffsets - ids of the latest events which consumers of named subscriptions have processed. Offsets are keyed by client id and subscription name, so clients can't move offsets of each other. Subscription with name resumes after committed id instead of the last delivered one, so events delivered but not processed before crash of consumer are delivered again. Offsets are kept in memory of server instance like event journal.
+type committedOffsets struct {
+	mx      sync.Mutex
+	offsets map[string]*committedOffset
+}
+
+// offsetKey - returns key of subscription offset. Separator can't be passed in gRPC metadata, so keys of different clients never collide.
+func offsetKey(clientID, name string) string {
+	return clientID + "\x00" + name
+}
+
+func newCommittedOffsets() *committedOffsets {
+	return &committedOffsets{
+		offsets: make(map[string]*committedOffset),
+	}
+}
+
+// Commit - saves event id of subscription and returns committed id. Offsets only move forward: id which is less than committed one is ignored, so late commit of concurrent consumer doesn't rewind subscription.
+func (offsets *committedOffsets) Commit(clientID, name string, eventID uint64) uint64 {
+	offsets.mx.Lock()
+	defer offsets.mx.Unlock()
+
+	name = offsetKey(clientID, name)
+	now := time.Now()
+	if offset, ok := offsets.offsets[name]; ok {
+		if eventID > offset.eventID {
+			offset.eventID = eventID
+		}
+		offset.updatedAt = now
+		return offset.eventID
+	}
+
+	if len(offsets.offsets) >= maxCommittedOffsets {
+		offsets.evict()
+	}
+	offsets.offsets[name] = &committedOffset{
+		eventID:   eventID,
+		updatedAt: now,
+	}
+	return eventID
+}
+
+// Get - returns committed event id of client subscription
+func (offsets *committedOffsets) Get(clientID, name string) (uint64, bool) {
+	offsets.mx.Lock()
+	defer offsets.mx.Unlock()
+
+	offset, ok := offsets.offsets[offsetKey(clientID, name)]
+	if !ok {
+		return 0, false
+	}
+	return offset.eventID, true
+}
+
+// evict - removes offset of subscription which was committed the longest time ago. It should be called under lock.
+func (offsets *committedOffsets) evict() {
+	var (
+		victim string
+		oldest *committedOffset
+	)
+	for name, offset := range offsets.offsets {
+		if oldest == nil || offset.updatedAt.Before(oldest.updatedAt) {
+			victim = name
+			oldest = offset
+		}
+	}
+	if oldest != nil {
+		delete(offsets.offsets, victim)
+	}
+}
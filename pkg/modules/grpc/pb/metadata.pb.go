@@ -10,6 +10,7 @@ import (
 	pb "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,12 +22,305 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AbiFormat int32
+
+const (
+	AbiFormat_STORED AbiFormat = 0
+	AbiFormat_ABIGEN AbiFormat = 1
+)
+
+// Enum value maps for AbiFormat.
+var (
+	AbiFormat_name = map[int32]string{
+		0: "STORED",
+		1: "ABIGEN",
+	}
+	AbiFormat_value = map[string]int32{
+		"STORED": 0,
+		"ABIGEN": 1,
+	}
+)
+
+func (x AbiFormat) Enum() *AbiFormat {
+	p := new(AbiFormat)
+	*p = x
+	return p
+}
+
+func (x AbiFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AbiFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[0].Descriptor()
+}
+
+func (AbiFormat) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[0]
+}
+
+func (x AbiFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AbiFormat.Descriptor instead.
+func (AbiFormat) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+type CodeStatus int32
+
+const (
+	CodeStatus_UNCHECKED CodeStatus = 0
+	CodeStatus_DEPLOYED  CodeStatus = 1
+	CodeStatus_DESTROYED CodeStatus = 2
+)
+
+// Enum value maps for CodeStatus.
+var (
+	CodeStatus_name = map[int32]string{
+		0: "UNCHECKED",
+		1: "DEPLOYED",
+		2: "DESTROYED",
+	}
+	CodeStatus_value = map[string]int32{
+		"UNCHECKED": 0,
+		"DEPLOYED":  1,
+		"DESTROYED": 2,
+	}
+)
+
+func (x CodeStatus) Enum() *CodeStatus {
+	p := new(CodeStatus)
+	*p = x
+	return p
+}
+
+func (x CodeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[1].Descriptor()
+}
+
+func (CodeStatus) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[1]
+}
+
+func (x CodeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeStatus.Descriptor instead.
+func (CodeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+type MatchType int32
+
+const (
+	MatchType_ANY MatchType = 0
+	MatchType_ALL MatchType = 1
+)
+
+// Enum value maps for MatchType.
+var (
+	MatchType_name = map[int32]string{
+		0: "ANY",
+		1: "ALL",
+	}
+	MatchType_value = map[string]int32{
+		"ANY": 0,
+		"ALL": 1,
+	}
+)
+
+func (x MatchType) Enum() *MatchType {
+	p := new(MatchType)
+	*p = x
+	return p
+}
+
+func (x MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[2].Descriptor()
+}
+
+func (MatchType) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[2]
+}
+
+func (x MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchType.Descriptor instead.
+func (MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+type ProxyFilter int32
+
+const (
+	ProxyFilter_WITH_PROXIES    ProxyFilter = 0
+	ProxyFilter_ONLY_PROXIES    ProxyFilter = 1
+	ProxyFilter_WITHOUT_PROXIES ProxyFilter = 2
+)
+
+// Enum value maps for ProxyFilter.
+var (
+	ProxyFilter_name = map[int32]string{
+		0: "WITH_PROXIES",
+		1: "ONLY_PROXIES",
+		2: "WITHOUT_PROXIES",
+	}
+	ProxyFilter_value = map[string]int32{
+		"WITH_PROXIES":    0,
+		"ONLY_PROXIES":    1,
+		"WITHOUT_PROXIES": 2,
+	}
+)
+
+func (x ProxyFilter) Enum() *ProxyFilter {
+	p := new(ProxyFilter)
+	*p = x
+	return p
+}
+
+func (x ProxyFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[3].Descriptor()
+}
+
+func (ProxyFilter) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[3]
+}
+
+func (x ProxyFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyFilter.Descriptor instead.
+func (ProxyFilter) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+type SignatureKind int32
+
+const (
+	SignatureKind_FUNCTION SignatureKind = 0
+	SignatureKind_ERROR    SignatureKind = 1
+)
+
+// Enum value maps for SignatureKind.
+var (
+	SignatureKind_name = map[int32]string{
+		0: "FUNCTION",
+		1: "ERROR",
+	}
+	SignatureKind_value = map[string]int32{
+		"FUNCTION": 0,
+		"ERROR":    1,
+	}
+)
+
+func (x SignatureKind) Enum() *SignatureKind {
+	p := new(SignatureKind)
+	*p = x
+	return p
+}
+
+func (x SignatureKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignatureKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[4].Descriptor()
+}
+
+func (SignatureKind) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[4]
+}
+
+func (x SignatureKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignatureKind.Descriptor instead.
+func (SignatureKind) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportStatus int32
+
+const (
+	ImportStatus_INSERTED  ImportStatus = 0
+	ImportStatus_UPDATED   ImportStatus = 1
+	ImportStatus_FAILED    ImportStatus = 2
+	ImportStatus_UNCHANGED ImportStatus = 3
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "INSERTED",
+		1: "UPDATED",
+		2: "FAILED",
+		3: "UNCHANGED",
+	}
+	ImportStatus_value = map[string]int32{
+		"INSERTED":  0,
+		"UPDATED":   1,
+		"FAILED":    2,
+		"UNCHANGED": 3,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes[5]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{5}
+}
+
 type GetMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address        string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IfUpdatedAfter int64                  `protobuf:"varint,2,opt,name=if_updated_after,json=ifUpdatedAfter,proto3" json:"if_updated_after,omitempty"`
+	IfNoneMatch    string                 `protobuf:"bytes,3,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+	FollowFactory  bool                   `protobuf:"varint,4,opt,name=follow_factory,json=followFactory,proto3" json:"follow_factory,omitempty"`
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	Format         AbiFormat              `protobuf:"varint,6,opt,name=format,proto3,enum=proto.AbiFormat" json:"format,omitempty"`
 }
 
 func (x *GetMetadataRequest) Reset() {
@@ -68,12 +362,56 @@ func (x *GetMetadataRequest) GetAddress() string {
 	return ""
 }
 
+func (x *GetMetadataRequest) GetIfUpdatedAfter() int64 {
+	if x != nil {
+		return x.IfUpdatedAfter
+	}
+	return 0
+}
+
+func (x *GetMetadataRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+func (x *GetMetadataRequest) GetFollowFactory() bool {
+	if x != nil {
+		return x.FollowFactory
+	}
+	return false
+}
+
+func (x *GetMetadataRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *GetMetadataRequest) GetFormat() AbiFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AbiFormat_STORED
+}
+
 type ListMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page *pb.Page `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Page             *pb.Page               `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	OnlyComplete     bool                   `protobuf:"varint,2,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	UpdatedSince     int64                  `protobuf:"varint,3,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	FieldMask        *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	Consistent       bool                   `protobuf:"varint,5,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	Cursor           string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	CompilerVersion  string                 `protobuf:"bytes,7,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	Proxy            ProxyFilter            `protobuf:"varint,8,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool                   `protobuf:"varint,9,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool                   `protobuf:"varint,10,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
 }
 
 func (x *ListMetadataRequest) Reset() {
@@ -115,12 +453,78 @@ func (x *ListMetadataRequest) GetPage() *pb.Page {
 	return nil
 }
 
+func (x *ListMetadataRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *ListMetadataRequest) GetUpdatedSince() int64 {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return 0
+}
+
+func (x *ListMetadataRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *ListMetadataRequest) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *ListMetadataRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMetadataRequest) GetCompilerVersion() string {
+	if x != nil {
+		return x.CompilerVersion
+	}
+	return ""
+}
+
+func (x *ListMetadataRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *ListMetadataRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *ListMetadataRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
 type ListMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata []*Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Metadata   []*Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Page       *pb.Page    `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	NextCursor string      `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	MaxLimit   uint64      `protobuf:"varint,4,opt,name=max_limit,json=maxLimit,proto3" json:"max_limit,omitempty"`
 }
 
 func (x *ListMetadataResponse) Reset() {
@@ -162,17 +566,41 @@ func (x *ListMetadataResponse) GetMetadata() []*Metadata {
 	return nil
 }
 
-type SubscriptionMetadata struct {
+func (x *ListMetadataResponse) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListMetadataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListMetadataResponse) GetMaxLimit() uint64 {
+	if x != nil {
+		return x.MaxLimit
+	}
+	return 0
+}
+
+type SubscribeOnMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subscription *pb.SubscribeResponse `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
-	Metadata     *Metadata             `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Interface     string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	AfterEventId  uint64 `protobuf:"varint,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	BatchSize     uint32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	BatchInterval uint32 `protobuf:"varint,4,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+	Name          string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SubscriptionMetadata) Reset() {
-	*x = SubscriptionMetadata{}
+func (x *SubscribeOnMetadataRequest) Reset() {
+	*x = SubscribeOnMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,13 +608,13 @@ func (x *SubscriptionMetadata) Reset() {
 	}
 }
 
-func (x *SubscriptionMetadata) String() string {
+func (x *SubscribeOnMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionMetadata) ProtoMessage() {}
+func (*SubscribeOnMetadataRequest) ProtoMessage() {}
 
-func (x *SubscriptionMetadata) ProtoReflect() protoreflect.Message {
+func (x *SubscribeOnMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,37 +626,59 @@ func (x *SubscriptionMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionMetadata.ProtoReflect.Descriptor instead.
-func (*SubscriptionMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeOnMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeOnMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SubscriptionMetadata) GetSubscription() *pb.SubscribeResponse {
+func (x *SubscribeOnMetadataRequest) GetInterface() string {
 	if x != nil {
-		return x.Subscription
+		return x.Interface
 	}
-	return nil
+	return ""
 }
 
-func (x *SubscriptionMetadata) GetMetadata() *Metadata {
+func (x *SubscribeOnMetadataRequest) GetAfterEventId() uint64 {
 	if x != nil {
-		return x.Metadata
+		return x.AfterEventId
 	}
-	return nil
+	return 0
 }
 
-type Metadata struct {
+func (x *SubscribeOnMetadataRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *SubscribeOnMetadataRequest) GetBatchInterval() uint32 {
+	if x != nil {
+		return x.BatchInterval
+	}
+	return 0
+}
+
+func (x *SubscribeOnMetadataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SubscriptionMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Metadata   []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	JsonSchema []byte `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Subscription *pb.SubscribeResponse   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Metadata     *Metadata               `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EventId      uint64                  `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Batch        []*SubscriptionMetadata `protobuf:"bytes,4,rep,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
+func (x *SubscriptionMetadata) Reset() {
+	*x = SubscriptionMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,13 +686,13 @@ func (x *Metadata) Reset() {
 	}
 }
 
-func (x *Metadata) String() string {
+func (x *SubscriptionMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata) ProtoMessage() {}
+func (*SubscriptionMetadata) ProtoMessage() {}
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
+func (x *SubscriptionMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,43 +704,50 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
-func (*Metadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscriptionMetadata.ProtoReflect.Descriptor instead.
+func (*SubscriptionMetadata) Descriptor() ([]byte, []int) {
 	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Metadata) GetAddress() string {
+func (x *SubscriptionMetadata) GetSubscription() *pb.SubscribeResponse {
 	if x != nil {
-		return x.Address
+		return x.Subscription
 	}
-	return ""
+	return nil
 }
 
-func (x *Metadata) GetMetadata() []byte {
+func (x *SubscriptionMetadata) GetMetadata() *Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *Metadata) GetJsonSchema() []byte {
+func (x *SubscriptionMetadata) GetEventId() uint64 {
 	if x != nil {
-		return x.JsonSchema
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *SubscriptionMetadata) GetBatch() []*SubscriptionMetadata {
+	if x != nil {
+		return x.Batch
 	}
 	return nil
 }
 
-type GetMetadataByMethodSinatureRequest struct {
+type CommitOffsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page      *pb.Page `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	Signature string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EventId uint64 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 }
 
-func (x *GetMetadataByMethodSinatureRequest) Reset() {
-	*x = GetMetadataByMethodSinatureRequest{}
+func (x *CommitOffsetRequest) Reset() {
+	*x = CommitOffsetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,13 +755,13 @@ func (x *GetMetadataByMethodSinatureRequest) Reset() {
 	}
 }
 
-func (x *GetMetadataByMethodSinatureRequest) String() string {
+func (x *CommitOffsetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataByMethodSinatureRequest) ProtoMessage() {}
+func (*CommitOffsetRequest) ProtoMessage() {}
 
-func (x *GetMetadataByMethodSinatureRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitOffsetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,36 +773,35 @@ func (x *GetMetadataByMethodSinatureRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataByMethodSinatureRequest.ProtoReflect.Descriptor instead.
-func (*GetMetadataByMethodSinatureRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitOffsetRequest.ProtoReflect.Descriptor instead.
+func (*CommitOffsetRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMetadataByMethodSinatureRequest) GetPage() *pb.Page {
+func (x *CommitOffsetRequest) GetName() string {
 	if x != nil {
-		return x.Page
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMetadataByMethodSinatureRequest) GetSignature() string {
+func (x *CommitOffsetRequest) GetEventId() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.EventId
 	}
-	return ""
+	return 0
 }
 
-type GetMetadataByTopicRequest struct {
+type CommitOffsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  *pb.Page `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	Topic string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	EventId uint64 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 }
 
-func (x *GetMetadataByTopicRequest) Reset() {
-	*x = GetMetadataByTopicRequest{}
+func (x *CommitOffsetResponse) Reset() {
+	*x = CommitOffsetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,13 +809,13 @@ func (x *GetMetadataByTopicRequest) Reset() {
 	}
 }
 
-func (x *GetMetadataByTopicRequest) String() string {
+func (x *CommitOffsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataByTopicRequest) ProtoMessage() {}
+func (*CommitOffsetResponse) ProtoMessage() {}
 
-func (x *GetMetadataByTopicRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitOffsetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,197 +827,8146 @@ func (x *GetMetadataByTopicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataByTopicRequest.ProtoReflect.Descriptor instead.
-func (*GetMetadataByTopicRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitOffsetResponse.ProtoReflect.Descriptor instead.
+func (*CommitOffsetResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMetadataByTopicRequest) GetPage() *pb.Page {
+func (x *CommitOffsetResponse) GetEventId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.EventId
+	}
+	return 0
+}
+
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Metadata         []byte          `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	JsonSchema       []byte          `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	IsComplete       bool            `protobuf:"varint,4,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`
+	Interfaces       []string        `protobuf:"bytes,5,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	UpdatedAt        int64           `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Hash             string          `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	NotModified      bool            `protobuf:"varint,8,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	Creator          string          `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreationTx       string          `protobuf:"bytes,10,opt,name=creation_tx,json=creationTx,proto3" json:"creation_tx,omitempty"`
+	CreatedAt        int64           `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Factory          string          `protobuf:"bytes,12,opt,name=factory,proto3" json:"factory,omitempty"`
+	InheritedFrom    string          `protobuf:"bytes,13,opt,name=inherited_from,json=inheritedFrom,proto3" json:"inherited_from,omitempty"`
+	Selectors        []string        `protobuf:"bytes,14,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	CodeHash         string          `protobuf:"bytes,15,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	CreationBlock    uint64          `protobuf:"varint,16,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+	Tags             []string        `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	Source           string          `protobuf:"bytes,18,opt,name=source,proto3" json:"source,omitempty"`
+	Candidates       []*AbiCandidate `protobuf:"bytes,19,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Compilation      *Compilation    `protobuf:"bytes,20,opt,name=compilation,proto3" json:"compilation,omitempty"`
+	LastFetchAttempt int64           `protobuf:"varint,21,opt,name=last_fetch_attempt,json=lastFetchAttempt,proto3" json:"last_fetch_attempt,omitempty"`
+	IsProxy          bool            `protobuf:"varint,22,opt,name=is_proxy,json=isProxy,proto3" json:"is_proxy,omitempty"`
+	CodeStatus       CodeStatus      `protobuf:"varint,23,opt,name=code_status,json=codeStatus,proto3,enum=proto.CodeStatus" json:"code_status,omitempty"`
+	CodeCheckedAt    int64           `protobuf:"varint,24,opt,name=code_checked_at,json=codeCheckedAt,proto3" json:"code_checked_at,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Metadata) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Metadata) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *GetMetadataByTopicRequest) GetTopic() string {
+func (x *Metadata) GetJsonSchema() []byte {
 	if x != nil {
-		return x.Topic
+		return x.JsonSchema
+	}
+	return nil
+}
+
+func (x *Metadata) GetIsComplete() bool {
+	if x != nil {
+		return x.IsComplete
+	}
+	return false
+}
+
+func (x *Metadata) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Metadata) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Metadata) GetHash() string {
+	if x != nil {
+		return x.Hash
 	}
 	return ""
 }
 
-var File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto protoreflect.FileDescriptor
+func (x *Metadata) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
 
-var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDesc = []byte{
-	0x0a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70,
-	0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70,
-	0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c,
-	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x61, 0x0a, 0x08, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6a,
-	0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x63, 0x0a, 0x22,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x52, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x32, 0xf0, 0x03, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70, 0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65,
-	0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Metadata) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
 }
 
-var (
-	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescOnce sync.Once
-	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData = file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDesc
-)
+func (x *Metadata) GetCreationTx() string {
+	if x != nil {
+		return x.CreationTx
+	}
+	return ""
+}
 
-func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP() []byte {
-	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescOnce.Do(func() {
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData)
-	})
-	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData
+func (x *Metadata) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_goTypes = []interface{}{
-	(*GetMetadataRequest)(nil),                 // 0: proto.GetMetadataRequest
-	(*ListMetadataRequest)(nil),                // 1: proto.ListMetadataRequest
-	(*ListMetadataResponse)(nil),               // 2: proto.ListMetadataResponse
-	(*SubscriptionMetadata)(nil),               // 3: proto.SubscriptionMetadata
-	(*Metadata)(nil),                           // 4: proto.Metadata
-	(*GetMetadataByMethodSinatureRequest)(nil), // 5: proto.GetMetadataByMethodSinatureRequest
-	(*GetMetadataByTopicRequest)(nil),          // 6: proto.GetMetadataByTopicRequest
-	(*pb.Page)(nil),                            // 7: proto.Page
-	(*pb.SubscribeResponse)(nil),               // 8: proto.SubscribeResponse
-	(*pb.DefaultRequest)(nil),                  // 9: proto.DefaultRequest
-	(*pb.UnsubscribeRequest)(nil),              // 10: proto.UnsubscribeRequest
-	(*pb.UnsubscribeResponse)(nil),             // 11: proto.UnsubscribeResponse
+func (x *Metadata) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
 }
-var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_depIdxs = []int32{
-	7,  // 0: proto.ListMetadataRequest.page:type_name -> proto.Page
-	4,  // 1: proto.ListMetadataResponse.metadata:type_name -> proto.Metadata
-	8,  // 2: proto.SubscriptionMetadata.subscription:type_name -> proto.SubscribeResponse
-	4,  // 3: proto.SubscriptionMetadata.metadata:type_name -> proto.Metadata
-	7,  // 4: proto.GetMetadataByMethodSinatureRequest.page:type_name -> proto.Page
-	7,  // 5: proto.GetMetadataByTopicRequest.page:type_name -> proto.Page
-	9,  // 6: proto.MetadataService.SubscribeOnMetadata:input_type -> proto.DefaultRequest
-	10, // 7: proto.MetadataService.UnsubscribeFromMetadata:input_type -> proto.UnsubscribeRequest
-	0,  // 8: proto.MetadataService.GetMetadata:input_type -> proto.GetMetadataRequest
-	1,  // 9: proto.MetadataService.ListMetadata:input_type -> proto.ListMetadataRequest
-	5,  // 10: proto.MetadataService.GetMetadataByMethodSinature:input_type -> proto.GetMetadataByMethodSinatureRequest
-	6,  // 11: proto.MetadataService.GetMetadataByTopic:input_type -> proto.GetMetadataByTopicRequest
-	3,  // 12: proto.MetadataService.SubscribeOnMetadata:output_type -> proto.SubscriptionMetadata
-	11, // 13: proto.MetadataService.UnsubscribeFromMetadata:output_type -> proto.UnsubscribeResponse
-	4,  // 14: proto.MetadataService.GetMetadata:output_type -> proto.Metadata
-	2,  // 15: proto.MetadataService.ListMetadata:output_type -> proto.ListMetadataResponse
-	2,  // 16: proto.MetadataService.GetMetadataByMethodSinature:output_type -> proto.ListMetadataResponse
-	2,  // 17: proto.MetadataService.GetMetadataByTopic:output_type -> proto.ListMetadataResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *Metadata) GetInheritedFrom() string {
+	if x != nil {
+		return x.InheritedFrom
+	}
+	return ""
 }
 
-func init() { file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_init() }
-func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_init() {
-	if File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto != nil {
-		return
+func (x *Metadata) GetSelectors() []string {
+	if x != nil {
+		return x.Selectors
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetadataRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMetadataRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *Metadata) GetCodeHash() string {
+	if x != nil {
+		return x.CodeHash
+	}
+	return ""
+}
+
+func (x *Metadata) GetCreationBlock() uint64 {
+	if x != nil {
+		return x.CreationBlock
+	}
+	return 0
+}
+
+func (x *Metadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Metadata) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Metadata) GetCandidates() []*AbiCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Metadata) GetCompilation() *Compilation {
+	if x != nil {
+		return x.Compilation
+	}
+	return nil
+}
+
+func (x *Metadata) GetLastFetchAttempt() int64 {
+	if x != nil {
+		return x.LastFetchAttempt
+	}
+	return 0
+}
+
+func (x *Metadata) GetIsProxy() bool {
+	if x != nil {
+		return x.IsProxy
+	}
+	return false
+}
+
+func (x *Metadata) GetCodeStatus() CodeStatus {
+	if x != nil {
+		return x.CodeStatus
+	}
+	return CodeStatus_UNCHECKED
+}
+
+func (x *Metadata) GetCodeCheckedAt() int64 {
+	if x != nil {
+		return x.CodeCheckedAt
+	}
+	return 0
+}
+
+type Compilation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompilerVersion  string `protobuf:"bytes,1,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	OptimizerEnabled bool   `protobuf:"varint,2,opt,name=optimizer_enabled,json=optimizerEnabled,proto3" json:"optimizer_enabled,omitempty"`
+	OptimizerRuns    uint64 `protobuf:"varint,3,opt,name=optimizer_runs,json=optimizerRuns,proto3" json:"optimizer_runs,omitempty"`
+	EvmVersion       string `protobuf:"bytes,4,opt,name=evm_version,json=evmVersion,proto3" json:"evm_version,omitempty"`
+	License          string `protobuf:"bytes,5,opt,name=license,proto3" json:"license,omitempty"`
+}
+
+func (x *Compilation) Reset() {
+	*x = Compilation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compilation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compilation) ProtoMessage() {}
+
+func (x *Compilation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMetadataResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compilation.ProtoReflect.Descriptor instead.
+func (*Compilation) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Compilation) GetCompilerVersion() string {
+	if x != nil {
+		return x.CompilerVersion
+	}
+	return ""
+}
+
+func (x *Compilation) GetOptimizerEnabled() bool {
+	if x != nil {
+		return x.OptimizerEnabled
+	}
+	return false
+}
+
+func (x *Compilation) GetOptimizerRuns() uint64 {
+	if x != nil {
+		return x.OptimizerRuns
+	}
+	return 0
+}
+
+func (x *Compilation) GetEvmVersion() string {
+	if x != nil {
+		return x.EvmVersion
+	}
+	return ""
+}
+
+func (x *Compilation) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+type AbiCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source    string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Metadata  []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Hash      string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AbiCandidate) Reset() {
+	*x = AbiCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbiCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiCandidate) ProtoMessage() {}
+
+func (x *AbiCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiCandidate.ProtoReflect.Descriptor instead.
+func (*AbiCandidate) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AbiCandidate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AbiCandidate) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AbiCandidate) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AbiCandidate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetMetadataByMethodSinatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Signature        string      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,4,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,5,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,6,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByMethodSinatureRequest) Reset() {
+	*x = GetMetadataByMethodSinatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByMethodSinatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByMethodSinatureRequest) ProtoMessage() {}
+
+func (x *GetMetadataByMethodSinatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByMethodSinatureRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByMethodSinatureRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByMethodSinatureRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type GetMetadataByTopicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Topic            string      `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Indexed          []string    `protobuf:"bytes,4,rep,name=indexed,proto3" json:"indexed,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,5,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,6,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,7,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByTopicRequest) Reset() {
+	*x = GetMetadataByTopicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByTopicRequest) ProtoMessage() {}
+
+func (x *GetMetadataByTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByTopicRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByTopicRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMetadataByTopicRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByTopicRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *GetMetadataByTopicRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByTopicRequest) GetIndexed() []string {
+	if x != nil {
+		return x.Indexed
+	}
+	return nil
+}
+
+func (x *GetMetadataByTopicRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByTopicRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByTopicRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type GetMetadataBySelectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Selectors        []string    `protobuf:"bytes,2,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	Match            MatchType   `protobuf:"varint,3,opt,name=match,proto3,enum=proto.MatchType" json:"match,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,4,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,5,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,6,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,7,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataBySelectorsRequest) Reset() {
+	*x = GetMetadataBySelectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataBySelectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataBySelectorsRequest) ProtoMessage() {}
+
+func (x *GetMetadataBySelectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataBySelectorsRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataBySelectorsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMetadataBySelectorsRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataBySelectorsRequest) GetSelectors() []string {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *GetMetadataBySelectorsRequest) GetMatch() MatchType {
+	if x != nil {
+		return x.Match
+	}
+	return MatchType_ANY
+}
+
+func (x *GetMetadataBySelectorsRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataBySelectorsRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataBySelectorsRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataBySelectorsRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type RefreshInterfacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RefreshInterfacesRequest) Reset() {
+	*x = RefreshInterfacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshInterfacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshInterfacesRequest) ProtoMessage() {}
+
+func (x *RefreshInterfacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshInterfacesRequest.ProtoReflect.Descriptor instead.
+func (*RefreshInterfacesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefreshInterfacesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RefreshInterfacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interfaces []string `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *RefreshInterfacesResponse) Reset() {
+	*x = RefreshInterfacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshInterfacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshInterfacesResponse) ProtoMessage() {}
+
+func (x *RefreshInterfacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshInterfacesResponse.ProtoReflect.Descriptor instead.
+func (*RefreshInterfacesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefreshInterfacesResponse) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type ListSelectorSignaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit         uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	ExcludeSystem bool   `protobuf:"varint,3,opt,name=exclude_system,json=excludeSystem,proto3" json:"exclude_system,omitempty"`
+}
+
+func (x *ListSelectorSignaturesRequest) Reset() {
+	*x = ListSelectorSignaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSelectorSignaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelectorSignaturesRequest) ProtoMessage() {}
+
+func (x *ListSelectorSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelectorSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*ListSelectorSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSelectorSignaturesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSelectorSignaturesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSelectorSignaturesRequest) GetExcludeSystem() bool {
+	if x != nil {
+		return x.ExcludeSystem
+	}
+	return false
+}
+
+type SelectorSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selector  string        `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Signature string        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Kind      SignatureKind `protobuf:"varint,3,opt,name=kind,proto3,enum=proto.SignatureKind" json:"kind,omitempty"`
+}
+
+func (x *SelectorSignature) Reset() {
+	*x = SelectorSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectorSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorSignature) ProtoMessage() {}
+
+func (x *SelectorSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorSignature.ProtoReflect.Descriptor instead.
+func (*SelectorSignature) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SelectorSignature) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *SelectorSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SelectorSignature) GetKind() SignatureKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SignatureKind_FUNCTION
+}
+
+type ListSelectorSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items             []*SelectorSignature `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor        string               `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	ExcludedSelectors []string             `protobuf:"bytes,3,rep,name=excluded_selectors,json=excludedSelectors,proto3" json:"excluded_selectors,omitempty"`
+	MaxLimit          uint64               `protobuf:"varint,4,opt,name=max_limit,json=maxLimit,proto3" json:"max_limit,omitempty"`
+}
+
+func (x *ListSelectorSignaturesResponse) Reset() {
+	*x = ListSelectorSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSelectorSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelectorSignaturesResponse) ProtoMessage() {}
+
+func (x *ListSelectorSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelectorSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*ListSelectorSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSelectorSignaturesResponse) GetItems() []*SelectorSignature {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSelectorSignaturesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListSelectorSignaturesResponse) GetExcludedSelectors() []string {
+	if x != nil {
+		return x.ExcludedSelectors
+	}
+	return nil
+}
+
+func (x *ListSelectorSignaturesResponse) GetMaxLimit() uint64 {
+	if x != nil {
+		return x.MaxLimit
+	}
+	return 0
+}
+
+type GetCoverageStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCoverageStatsRequest) Reset() {
+	*x = GetCoverageStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoverageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoverageStatsRequest) ProtoMessage() {}
+
+func (x *GetCoverageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoverageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoverageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{18}
+}
+
+type GetCoverageStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selectors          uint64  `protobuf:"varint,1,opt,name=selectors,proto3" json:"selectors,omitempty"`
+	Topics             uint64  `protobuf:"varint,2,opt,name=topics,proto3" json:"topics,omitempty"`
+	Signatures         uint64  `protobuf:"varint,3,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	CollidingSelectors uint64  `protobuf:"varint,4,opt,name=colliding_selectors,json=collidingSelectors,proto3" json:"colliding_selectors,omitempty"`
+	CollisionRate      float64 `protobuf:"fixed64,5,opt,name=collision_rate,json=collisionRate,proto3" json:"collision_rate,omitempty"`
+	ComputedAt         int64   `protobuf:"varint,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *GetCoverageStatsResponse) Reset() {
+	*x = GetCoverageStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoverageStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoverageStatsResponse) ProtoMessage() {}
+
+func (x *GetCoverageStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoverageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoverageStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCoverageStatsResponse) GetSelectors() uint64 {
+	if x != nil {
+		return x.Selectors
+	}
+	return 0
+}
+
+func (x *GetCoverageStatsResponse) GetTopics() uint64 {
+	if x != nil {
+		return x.Topics
+	}
+	return 0
+}
+
+func (x *GetCoverageStatsResponse) GetSignatures() uint64 {
+	if x != nil {
+		return x.Signatures
+	}
+	return 0
+}
+
+func (x *GetCoverageStatsResponse) GetCollidingSelectors() uint64 {
+	if x != nil {
+		return x.CollidingSelectors
+	}
+	return 0
+}
+
+func (x *GetCoverageStatsResponse) GetCollisionRate() float64 {
+	if x != nil {
+		return x.CollisionRate
+	}
+	return 0
+}
+
+func (x *GetCoverageStatsResponse) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+type AnalyzeTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AnalyzeTokenRequest) Reset() {
+	*x = AnalyzeTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeTokenRequest) ProtoMessage() {}
+
+func (x *AnalyzeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeTokenRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AnalyzeTokenRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type TokenIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TokenIssue) Reset() {
+	*x = TokenIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenIssue) ProtoMessage() {}
+
+func (x *TokenIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenIssue.ProtoReflect.Descriptor instead.
+func (*TokenIssue) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TokenIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TokenIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AnalyzeTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsToken bool          `protobuf:"varint,1,opt,name=is_token,json=isToken,proto3" json:"is_token,omitempty"`
+	Issues  []*TokenIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *AnalyzeTokenResponse) Reset() {
+	*x = AnalyzeTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeTokenResponse) ProtoMessage() {}
+
+func (x *AnalyzeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeTokenResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnalyzeTokenResponse) GetIsToken() bool {
+	if x != nil {
+		return x.IsToken
+	}
+	return false
+}
+
+func (x *AnalyzeTokenResponse) GetIssues() []*TokenIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type SampleMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SampleMetadataRequest) Reset() {
+	*x = SampleMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleMetadataRequest) ProtoMessage() {}
+
+func (x *SampleMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SampleMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SampleMetadataRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SampleMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata []*Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *SampleMetadataResponse) Reset() {
+	*x = SampleMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleMetadataResponse) ProtoMessage() {}
+
+func (x *SampleMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleMetadataResponse.ProtoReflect.Descriptor instead.
+func (*SampleMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SampleMetadataResponse) GetMetadata() []*Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type FindSimilarContractsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MinSimilarity float64 `protobuf:"fixed64,2,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"`
+	Limit         uint64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *FindSimilarContractsRequest) Reset() {
+	*x = FindSimilarContractsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSimilarContractsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarContractsRequest) ProtoMessage() {}
+
+func (x *FindSimilarContractsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarContractsRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarContractsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindSimilarContractsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FindSimilarContractsRequest) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *FindSimilarContractsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SimilarContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata   *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Similarity float64   `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+}
+
+func (x *SimilarContract) Reset() {
+	*x = SimilarContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarContract) ProtoMessage() {}
+
+func (x *SimilarContract) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarContract.ProtoReflect.Descriptor instead.
+func (*SimilarContract) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimilarContract) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SimilarContract) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FindSimilarContractsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fingerprint string             `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Contracts   []*SimilarContract `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (x *FindSimilarContractsResponse) Reset() {
+	*x = FindSimilarContractsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSimilarContractsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarContractsResponse) ProtoMessage() {}
+
+func (x *FindSimilarContractsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarContractsResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarContractsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindSimilarContractsResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *FindSimilarContractsResponse) GetContracts() []*SimilarContract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{28}
+}
+
+type SubscriptionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId   string  `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CreatedAt  int64   `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Sent       uint64  `protobuf:"varint,4,opt,name=sent,proto3" json:"sent,omitempty"`
+	Dropped    uint64  `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Queued     uint64  `protobuf:"varint,6,opt,name=queued,proto3" json:"queued,omitempty"`
+	LagSeconds float64 `protobuf:"fixed64,7,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+}
+
+func (x *SubscriptionStats) Reset() {
+	*x = SubscriptionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionStats) ProtoMessage() {}
+
+func (x *SubscriptionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionStats.ProtoReflect.Descriptor instead.
+func (*SubscriptionStats) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubscriptionStats) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscriptionStats) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SubscriptionStats) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SubscriptionStats) GetSent() uint64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *SubscriptionStats) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *SubscriptionStats) GetQueued() uint64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *SubscriptionStats) GetLagSeconds() float64 {
+	if x != nil {
+		return x.LagSeconds
+	}
+	return 0
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*SubscriptionStats `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*SubscriptionStats {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type ListClientsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClientsRequest) Reset() {
+	*x = ListClientsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsRequest) ProtoMessage() {}
+
+func (x *ListClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{31}
+}
+
+type ClientSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ClientSubscription) Reset() {
+	*x = ClientSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSubscription) ProtoMessage() {}
+
+func (x *ClientSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSubscription.ProtoReflect.Descriptor instead.
+func (*ClientSubscription) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ClientSubscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClientSubscription) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *ClientSubscription) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ConnectedClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId      string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ConnectedAt   int64                 `protobuf:"varint,2,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	Addresses     []string              `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Subscriptions []*ClientSubscription `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ConnectedClient) Reset() {
+	*x = ConnectedClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectedClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectedClient) ProtoMessage() {}
+
+func (x *ConnectedClient) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectedClient.ProtoReflect.Descriptor instead.
+func (*ConnectedClient) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConnectedClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ConnectedClient) GetConnectedAt() int64 {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return 0
+}
+
+func (x *ConnectedClient) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *ConnectedClient) GetSubscriptions() []*ClientSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type ListClientsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*ConnectedClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListClientsResponse) Reset() {
+	*x = ListClientsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsResponse) ProtoMessage() {}
+
+func (x *ListClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListClientsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListClientsResponse) GetClients() []*ConnectedClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type DisconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DisconnectRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions uint64 `protobuf:"varint,1,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Connections   uint64 `protobuf:"varint,2,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DisconnectResponse) GetSubscriptions() uint64 {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return 0
+}
+
+func (x *DisconnectResponse) GetConnections() uint64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+type ListDecodeMissesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListDecodeMissesRequest) Reset() {
+	*x = ListDecodeMissesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDecodeMissesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecodeMissesRequest) ProtoMessage() {}
+
+func (x *ListDecodeMissesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecodeMissesRequest.ProtoReflect.Descriptor instead.
+func (*ListDecodeMissesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDecodeMissesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListDecodeMissesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DecodeMiss struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Selector  string        `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	Kind      SignatureKind `protobuf:"varint,3,opt,name=kind,proto3,enum=proto.SignatureKind" json:"kind,omitempty"`
+	Count     uint64        `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen int64         `protobuf:"varint,5,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen  int64         `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *DecodeMiss) Reset() {
+	*x = DecodeMiss{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeMiss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeMiss) ProtoMessage() {}
+
+func (x *DecodeMiss) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeMiss.ProtoReflect.Descriptor instead.
+func (*DecodeMiss) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DecodeMiss) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DecodeMiss) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *DecodeMiss) GetKind() SignatureKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SignatureKind_FUNCTION
+}
+
+func (x *DecodeMiss) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DecodeMiss) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *DecodeMiss) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type ListDecodeMissesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Misses  []*DecodeMiss `protobuf:"bytes,1,rep,name=misses,proto3" json:"misses,omitempty"`
+	Tracked uint64        `protobuf:"varint,2,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	Evicted uint64        `protobuf:"varint,3,opt,name=evicted,proto3" json:"evicted,omitempty"`
+}
+
+func (x *ListDecodeMissesResponse) Reset() {
+	*x = ListDecodeMissesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDecodeMissesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecodeMissesResponse) ProtoMessage() {}
+
+func (x *ListDecodeMissesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecodeMissesResponse.ProtoReflect.Descriptor instead.
+func (*ListDecodeMissesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListDecodeMissesResponse) GetMisses() []*DecodeMiss {
+	if x != nil {
+		return x.Misses
+	}
+	return nil
+}
+
+func (x *ListDecodeMissesResponse) GetTracked() uint64 {
+	if x != nil {
+		return x.Tracked
+	}
+	return 0
+}
+
+func (x *ListDecodeMissesResponse) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+type GetMetadataByTopicsBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	Limit  uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetMetadataByTopicsBatchRequest) Reset() {
+	*x = GetMetadataByTopicsBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByTopicsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByTopicsBatchRequest) ProtoMessage() {}
+
+func (x *GetMetadataByTopicsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByTopicsBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByTopicsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMetadataByTopicsBatchRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *GetMetadataByTopicsBatchRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TopicEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Signature    string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Anonymous    bool     `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Indexed      []string `protobuf:"bytes,5,rep,name=indexed,proto3" json:"indexed,omitempty"`
+	IndexedKnown bool     `protobuf:"varint,6,opt,name=indexed_known,json=indexedKnown,proto3" json:"indexed_known,omitempty"`
+}
+
+func (x *TopicEvent) Reset() {
+	*x = TopicEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicEvent) ProtoMessage() {}
+
+func (x *TopicEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicEvent.ProtoReflect.Descriptor instead.
+func (*TopicEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TopicEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TopicEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopicEvent) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TopicEvent) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *TopicEvent) GetIndexed() []string {
+	if x != nil {
+		return x.Indexed
+	}
+	return nil
+}
+
+func (x *TopicEvent) GetIndexedKnown() bool {
+	if x != nil {
+		return x.IndexedKnown
+	}
+	return false
+}
+
+type TopicMatches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic  string        `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Events []*TopicEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TopicMatches) Reset() {
+	*x = TopicMatches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicMatches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicMatches) ProtoMessage() {}
+
+func (x *TopicMatches) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicMatches.ProtoReflect.Descriptor instead.
+func (*TopicMatches) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TopicMatches) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *TopicMatches) GetEvents() []*TopicEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetMetadataByTopicsBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []*TopicMatches `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *GetMetadataByTopicsBatchResponse) Reset() {
+	*x = GetMetadataByTopicsBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByTopicsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByTopicsBatchResponse) ProtoMessage() {}
+
+func (x *GetMetadataByTopicsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByTopicsBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataByTopicsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMetadataByTopicsBatchResponse) GetTopics() []*TopicMatches {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type HelloRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *HelloRequest) Reset() {
+	*x = HelloRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelloRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloRequest) ProtoMessage() {}
+
+func (x *HelloRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloRequest.ProtoReflect.Descriptor instead.
+func (*HelloRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HelloRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HelloRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type HelloResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId      string           `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ServerTime    int64            `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	ServerVersion string           `protobuf:"bytes,3,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	Methods       []string         `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	Features      []string         `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	Limits        *Limits          `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
+	Principal     string           `protobuf:"bytes,7,opt,name=principal,proto3" json:"principal,omitempty"`
+	SnapshotAge   int64            `protobuf:"varint,8,opt,name=snapshot_age,json=snapshotAge,proto3" json:"snapshot_age,omitempty"`
+	LatestUpdate  int64            `protobuf:"varint,9,opt,name=latest_update,json=latestUpdate,proto3" json:"latest_update,omitempty"`
+	IngestionLag  int64            `protobuf:"varint,10,opt,name=ingestion_lag,json=ingestionLag,proto3" json:"ingestion_lag,omitempty"`
+	Maintenance   *MaintenanceMode `protobuf:"bytes,11,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+}
+
+func (x *HelloResponse) Reset() {
+	*x = HelloResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResponse) ProtoMessage() {}
+
+func (x *HelloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HelloResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *HelloResponse) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *HelloResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *HelloResponse) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *HelloResponse) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetSnapshotAge() int64 {
+	if x != nil {
+		return x.SnapshotAge
+	}
+	return 0
+}
+
+func (x *HelloResponse) GetLatestUpdate() int64 {
+	if x != nil {
+		return x.LatestUpdate
+	}
+	return 0
+}
+
+func (x *HelloResponse) GetIngestionLag() int64 {
+	if x != nil {
+		return x.IngestionLag
+	}
+	return 0
+}
+
+func (x *HelloResponse) GetMaintenance() *MaintenanceMode {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+type Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxSelectors          uint64            `protobuf:"varint,1,opt,name=max_selectors,json=maxSelectors,proto3" json:"max_selectors,omitempty"`
+	MaxTopics             uint64            `protobuf:"varint,2,opt,name=max_topics,json=maxTopics,proto3" json:"max_topics,omitempty"`
+	MaxTopicEvents        uint64            `protobuf:"varint,3,opt,name=max_topic_events,json=maxTopicEvents,proto3" json:"max_topic_events,omitempty"`
+	MaxSelectorSignatures uint64            `protobuf:"varint,4,opt,name=max_selector_signatures,json=maxSelectorSignatures,proto3" json:"max_selector_signatures,omitempty"`
+	MaxSampleSize         uint64            `protobuf:"varint,5,opt,name=max_sample_size,json=maxSampleSize,proto3" json:"max_sample_size,omitempty"`
+	GetMetadataTimeout    int64             `protobuf:"varint,6,opt,name=get_metadata_timeout,json=getMetadataTimeout,proto3" json:"get_metadata_timeout,omitempty"`
+	MaxImportSignatures   uint64            `protobuf:"varint,7,opt,name=max_import_signatures,json=maxImportSignatures,proto3" json:"max_import_signatures,omitempty"`
+	MaxOffset             uint64            `protobuf:"varint,8,opt,name=max_offset,json=maxOffset,proto3" json:"max_offset,omitempty"`
+	DefaultOrder          pb.SortOrder      `protobuf:"varint,9,opt,name=default_order,json=defaultOrder,proto3,enum=proto.SortOrder" json:"default_order,omitempty"`
+	MaxLimit              uint64            `protobuf:"varint,10,opt,name=max_limit,json=maxLimit,proto3" json:"max_limit,omitempty"`
+	MaxLimits             map[string]uint64 `protobuf:"bytes,11,rep,name=max_limits,json=maxLimits,proto3" json:"max_limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Limits) GetMaxSelectors() uint64 {
+	if x != nil {
+		return x.MaxSelectors
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxTopics() uint64 {
+	if x != nil {
+		return x.MaxTopics
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxTopicEvents() uint64 {
+	if x != nil {
+		return x.MaxTopicEvents
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxSelectorSignatures() uint64 {
+	if x != nil {
+		return x.MaxSelectorSignatures
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxSampleSize() uint64 {
+	if x != nil {
+		return x.MaxSampleSize
+	}
+	return 0
+}
+
+func (x *Limits) GetGetMetadataTimeout() int64 {
+	if x != nil {
+		return x.GetMetadataTimeout
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxImportSignatures() uint64 {
+	if x != nil {
+		return x.MaxImportSignatures
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxOffset() uint64 {
+	if x != nil {
+		return x.MaxOffset
+	}
+	return 0
+}
+
+func (x *Limits) GetDefaultOrder() pb.SortOrder {
+	if x != nil {
+		return x.DefaultOrder
+	}
+	return pb.SortOrder(0)
+}
+
+func (x *Limits) GetMaxLimit() uint64 {
+	if x != nil {
+		return x.MaxLimit
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxLimits() map[string]uint64 {
+	if x != nil {
+		return x.MaxLimits
+	}
+	return nil
+}
+
+type GetMetadataByCreatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Creator          string      `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,4,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,5,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,6,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByCreatorRequest) Reset() {
+	*x = GetMetadataByCreatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByCreatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByCreatorRequest) ProtoMessage() {}
+
+func (x *GetMetadataByCreatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByCreatorRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByCreatorRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetMetadataByCreatorRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByCreatorRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *GetMetadataByCreatorRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByCreatorRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByCreatorRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByCreatorRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type ListMetadataByBlockRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	FromBlock        uint64      `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock          uint64      `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,4,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,5,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,6,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,7,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *ListMetadataByBlockRangeRequest) Reset() {
+	*x = ListMetadataByBlockRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetadataByBlockRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataByBlockRangeRequest) ProtoMessage() {}
+
+func (x *ListMetadataByBlockRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataByBlockRangeRequest.ProtoReflect.Descriptor instead.
+func (*ListMetadataByBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *ListMetadataByBlockRangeRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type ListIncompleteMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     *pb.Page `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	OrderSet bool     `protobuf:"varint,2,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *ListIncompleteMetadataRequest) Reset() {
+	*x = ListIncompleteMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncompleteMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncompleteMetadataRequest) ProtoMessage() {}
+
+func (x *ListIncompleteMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncompleteMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ListIncompleteMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListIncompleteMetadataRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListIncompleteMetadataRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type GetMetadataByTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Tags             []string    `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Match            MatchType   `protobuf:"varint,3,opt,name=match,proto3,enum=proto.MatchType" json:"match,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,4,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,5,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,6,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,7,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByTagRequest) Reset() {
+	*x = GetMetadataByTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByTagRequest) ProtoMessage() {}
+
+func (x *GetMetadataByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByTagRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetMetadataByTagRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByTagRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetMetadataByTagRequest) GetMatch() MatchType {
+	if x != nil {
+		return x.Match
+	}
+	return MatchType_ANY
+}
+
+func (x *GetMetadataByTagRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByTagRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByTagRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByTagRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{51}
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag   string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListByFactoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Factory          string      `protobuf:"bytes,2,opt,name=factory,proto3" json:"factory,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,4,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,5,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,6,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *ListByFactoryRequest) Reset() {
+	*x = ListByFactoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListByFactoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByFactoryRequest) ProtoMessage() {}
+
+func (x *ListByFactoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByFactoryRequest.ProtoReflect.Descriptor instead.
+func (*ListByFactoryRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListByFactoryRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListByFactoryRequest) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *ListByFactoryRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *ListByFactoryRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *ListByFactoryRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *ListByFactoryRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type RefreshStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshStatusRequest) Reset() {
+	*x = RefreshStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatusRequest) ProtoMessage() {}
+
+func (x *RefreshStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatusRequest.ProtoReflect.Descriptor instead.
+func (*RefreshStatusRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{55}
+}
+
+type RefreshStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Paused  bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	LastRun int64  `protobuf:"varint,3,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	Checked uint64 `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	Updated uint64 `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *RefreshStatus) Reset() {
+	*x = RefreshStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatus) ProtoMessage() {}
+
+func (x *RefreshStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatus.ProtoReflect.Descriptor instead.
+func (*RefreshStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RefreshStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RefreshStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *RefreshStatus) GetLastRun() int64 {
+	if x != nil {
+		return x.LastRun
+	}
+	return 0
+}
+
+func (x *RefreshStatus) GetChecked() uint64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *RefreshStatus) GetUpdated() uint64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type SetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled    bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ShedWrites bool `protobuf:"varint,2,opt,name=shed_writes,json=shedWrites,proto3" json:"shed_writes,omitempty"`
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetShedWrites() bool {
+	if x != nil {
+		return x.ShedWrites
+	}
+	return false
+}
+
+type MaintenanceMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled    bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ShedWrites bool  `protobuf:"varint,2,opt,name=shed_writes,json=shedWrites,proto3" json:"shed_writes,omitempty"`
+	Since      int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *MaintenanceMode) Reset() {
+	*x = MaintenanceMode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceMode) ProtoMessage() {}
+
+func (x *MaintenanceMode) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceMode.ProtoReflect.Descriptor instead.
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MaintenanceMode) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetShedWrites() bool {
+	if x != nil {
+		return x.ShedWrites
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type DecodeConstructorArgsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DecodeConstructorArgsRequest) Reset() {
+	*x = DecodeConstructorArgsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeConstructorArgsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeConstructorArgsRequest) ProtoMessage() {}
+
+func (x *DecodeConstructorArgsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeConstructorArgsRequest.ProtoReflect.Descriptor instead.
+func (*DecodeConstructorArgsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DecodeConstructorArgsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DecodeConstructorArgsRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type DecodedArgument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string    `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value      string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	TypedValue *AbiValue `protobuf:"bytes,4,opt,name=typed_value,json=typedValue,proto3" json:"typed_value,omitempty"`
+}
+
+func (x *DecodedArgument) Reset() {
+	*x = DecodedArgument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedArgument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedArgument) ProtoMessage() {}
+
+func (x *DecodedArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedArgument.ProtoReflect.Descriptor instead.
+func (*DecodedArgument) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DecodedArgument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecodedArgument) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DecodedArgument) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DecodedArgument) GetTypedValue() *AbiValue {
+	if x != nil {
+		return x.TypedValue
+	}
+	return nil
+}
+
+type AbiValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Types that are assignable to Value:
+	//	*AbiValue_UintValue
+	//	*AbiValue_IntValue
+	//	*AbiValue_BoolValue
+	//	*AbiValue_AddressValue
+	//	*AbiValue_BytesValue
+	//	*AbiValue_StringValue
+	//	*AbiValue_ArrayValue
+	//	*AbiValue_TupleValue
+	Value isAbiValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *AbiValue) Reset() {
+	*x = AbiValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbiValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiValue) ProtoMessage() {}
+
+func (x *AbiValue) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiValue.ProtoReflect.Descriptor instead.
+func (*AbiValue) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AbiValue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *AbiValue) GetValue() isAbiValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *AbiValue) GetUintValue() string {
+	if x, ok := x.GetValue().(*AbiValue_UintValue); ok {
+		return x.UintValue
+	}
+	return ""
+}
+
+func (x *AbiValue) GetIntValue() string {
+	if x, ok := x.GetValue().(*AbiValue_IntValue); ok {
+		return x.IntValue
+	}
+	return ""
+}
+
+func (x *AbiValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*AbiValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *AbiValue) GetAddressValue() string {
+	if x, ok := x.GetValue().(*AbiValue_AddressValue); ok {
+		return x.AddressValue
+	}
+	return ""
+}
+
+func (x *AbiValue) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*AbiValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *AbiValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*AbiValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *AbiValue) GetArrayValue() *AbiArray {
+	if x, ok := x.GetValue().(*AbiValue_ArrayValue); ok {
+		return x.ArrayValue
+	}
+	return nil
+}
+
+func (x *AbiValue) GetTupleValue() *AbiTuple {
+	if x, ok := x.GetValue().(*AbiValue_TupleValue); ok {
+		return x.TupleValue
+	}
+	return nil
+}
+
+type isAbiValue_Value interface {
+	isAbiValue_Value()
+}
+
+type AbiValue_UintValue struct {
+	UintValue string `protobuf:"bytes,2,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type AbiValue_IntValue struct {
+	IntValue string `protobuf:"bytes,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type AbiValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type AbiValue_AddressValue struct {
+	AddressValue string `protobuf:"bytes,5,opt,name=address_value,json=addressValue,proto3,oneof"`
+}
+
+type AbiValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,6,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type AbiValue_StringValue struct {
+	StringValue string `protobuf:"bytes,7,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type AbiValue_ArrayValue struct {
+	ArrayValue *AbiArray `protobuf:"bytes,8,opt,name=array_value,json=arrayValue,proto3,oneof"`
+}
+
+type AbiValue_TupleValue struct {
+	TupleValue *AbiTuple `protobuf:"bytes,9,opt,name=tuple_value,json=tupleValue,proto3,oneof"`
+}
+
+func (*AbiValue_UintValue) isAbiValue_Value() {}
+
+func (*AbiValue_IntValue) isAbiValue_Value() {}
+
+func (*AbiValue_BoolValue) isAbiValue_Value() {}
+
+func (*AbiValue_AddressValue) isAbiValue_Value() {}
+
+func (*AbiValue_BytesValue) isAbiValue_Value() {}
+
+func (*AbiValue_StringValue) isAbiValue_Value() {}
+
+func (*AbiValue_ArrayValue) isAbiValue_Value() {}
+
+func (*AbiValue_TupleValue) isAbiValue_Value() {}
+
+type AbiArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AbiValue `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AbiArray) Reset() {
+	*x = AbiArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbiArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiArray) ProtoMessage() {}
+
+func (x *AbiArray) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiArray.ProtoReflect.Descriptor instead.
+func (*AbiArray) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AbiArray) GetItems() []*AbiValue {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AbiTupleComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value *AbiValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AbiTupleComponent) Reset() {
+	*x = AbiTupleComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbiTupleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiTupleComponent) ProtoMessage() {}
+
+func (x *AbiTupleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiTupleComponent.ProtoReflect.Descriptor instead.
+func (*AbiTupleComponent) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AbiTupleComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbiTupleComponent) GetValue() *AbiValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AbiTuple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Components []*AbiTupleComponent `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (x *AbiTuple) Reset() {
+	*x = AbiTuple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbiTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiTuple) ProtoMessage() {}
+
+func (x *AbiTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiTuple.ProtoReflect.Descriptor instead.
+func (*AbiTuple) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AbiTuple) GetComponents() []*AbiTupleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type DecodeConstructorArgsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arguments  []*DecodedArgument `protobuf:"bytes,1,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	CodeStatus CodeStatus         `protobuf:"varint,2,opt,name=code_status,json=codeStatus,proto3,enum=proto.CodeStatus" json:"code_status,omitempty"`
+}
+
+func (x *DecodeConstructorArgsResponse) Reset() {
+	*x = DecodeConstructorArgsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeConstructorArgsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeConstructorArgsResponse) ProtoMessage() {}
+
+func (x *DecodeConstructorArgsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeConstructorArgsResponse.ProtoReflect.Descriptor instead.
+func (*DecodeConstructorArgsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DecodeConstructorArgsResponse) GetArguments() []*DecodedArgument {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *DecodeConstructorArgsResponse) GetCodeStatus() CodeStatus {
+	if x != nil {
+		return x.CodeStatus
+	}
+	return CodeStatus_UNCHECKED
+}
+
+type DecodeErrorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DecodeErrorRequest) Reset() {
+	*x = DecodeErrorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeErrorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeErrorRequest) ProtoMessage() {}
+
+func (x *DecodeErrorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeErrorRequest.ProtoReflect.Descriptor instead.
+func (*DecodeErrorRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DecodeErrorRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DecodeErrorRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type DecodeErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signature   string             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Arguments   []*DecodedArgument `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Description string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CodeStatus  CodeStatus         `protobuf:"varint,5,opt,name=code_status,json=codeStatus,proto3,enum=proto.CodeStatus" json:"code_status,omitempty"`
+}
+
+func (x *DecodeErrorResponse) Reset() {
+	*x = DecodeErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeErrorResponse) ProtoMessage() {}
+
+func (x *DecodeErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeErrorResponse.ProtoReflect.Descriptor instead.
+func (*DecodeErrorResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DecodeErrorResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecodeErrorResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DecodeErrorResponse) GetArguments() []*DecodedArgument {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *DecodeErrorResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DecodeErrorResponse) GetCodeStatus() CodeStatus {
+	if x != nil {
+		return x.CodeStatus
+	}
+	return CodeStatus_UNCHECKED
+}
+
+type GetInputSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *GetInputSchemaRequest) Reset() {
+	*x = GetInputSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInputSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInputSchemaRequest) ProtoMessage() {}
+
+func (x *GetInputSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInputSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetInputSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetInputSchemaRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetInputSchemaRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type GetInputSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signature  string     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Schema     []byte     `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	CodeStatus CodeStatus `protobuf:"varint,4,opt,name=code_status,json=codeStatus,proto3,enum=proto.CodeStatus" json:"code_status,omitempty"`
+}
+
+func (x *GetInputSchemaResponse) Reset() {
+	*x = GetInputSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInputSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInputSchemaResponse) ProtoMessage() {}
+
+func (x *GetInputSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInputSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetInputSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetInputSchemaResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetInputSchemaResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GetInputSchemaResponse) GetSchema() []byte {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *GetInputSchemaResponse) GetCodeStatus() CodeStatus {
+	if x != nil {
+		return x.CodeStatus
+	}
+	return CodeStatus_UNCHECKED
+}
+
+type GetMetadataDecodeTableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetMetadataDecodeTableRequest) Reset() {
+	*x = GetMetadataDecodeTableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataDecodeTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataDecodeTableRequest) ProtoMessage() {}
+
+func (x *GetMetadataDecodeTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataDecodeTableRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataDecodeTableRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetMetadataDecodeTableRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DecodeTableEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Fragment  []byte `protobuf:"bytes,3,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Anonymous bool   `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+}
+
+func (x *DecodeTableEntry) Reset() {
+	*x = DecodeTableEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeTableEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTableEntry) ProtoMessage() {}
+
+func (x *DecodeTableEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTableEntry.ProtoReflect.Descriptor instead.
+func (*DecodeTableEntry) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DecodeTableEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecodeTableEntry) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DecodeTableEntry) GetFragment() []byte {
+	if x != nil {
+		return x.Fragment
+	}
+	return nil
+}
+
+func (x *DecodeTableEntry) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+type GetMetadataDecodeTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string                       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Metadata  []byte                       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Hash      string                       `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Functions map[string]*DecodeTableEntry `protobuf:"bytes,4,rep,name=functions,proto3" json:"functions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Events    map[string]*DecodeTableEntry `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Errors    map[string]*DecodeTableEntry `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetMetadataDecodeTableResponse) Reset() {
+	*x = GetMetadataDecodeTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataDecodeTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataDecodeTableResponse) ProtoMessage() {}
+
+func (x *GetMetadataDecodeTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataDecodeTableResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataDecodeTableResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetMetadataDecodeTableResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetMetadataDecodeTableResponse) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetMetadataDecodeTableResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetMetadataDecodeTableResponse) GetFunctions() map[string]*DecodeTableEntry {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *GetMetadataDecodeTableResponse) GetEvents() map[string]*DecodeTableEntry {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetMetadataDecodeTableResponse) GetErrors() map[string]*DecodeTableEntry {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetTypedDataSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetTypedDataSchemaRequest) Reset() {
+	*x = GetTypedDataSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTypedDataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypedDataSchemaRequest) ProtoMessage() {}
+
+func (x *GetTypedDataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypedDataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetTypedDataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetTypedDataSchemaRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type TypedDataField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *TypedDataField) Reset() {
+	*x = TypedDataField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypedDataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedDataField) ProtoMessage() {}
+
+func (x *TypedDataField) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedDataField.ProtoReflect.Descriptor instead.
+func (*TypedDataField) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TypedDataField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypedDataField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type TypedDataStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*TypedDataField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *TypedDataStruct) Reset() {
+	*x = TypedDataStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypedDataStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedDataStruct) ProtoMessage() {}
+
+func (x *TypedDataStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedDataStruct.ProtoReflect.Descriptor instead.
+func (*TypedDataStruct) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TypedDataStruct) GetFields() []*TypedDataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetTypedDataSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Types        map[string]*TypedDataStruct `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TypesJson    []byte                      `protobuf:"bytes,3,opt,name=types_json,json=typesJson,proto3" json:"types_json,omitempty"`
+	DomainGetter bool                        `protobuf:"varint,4,opt,name=domain_getter,json=domainGetter,proto3" json:"domain_getter,omitempty"`
+	Warnings     []string                    `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *GetTypedDataSchemaResponse) Reset() {
+	*x = GetTypedDataSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTypedDataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypedDataSchemaResponse) ProtoMessage() {}
+
+func (x *GetTypedDataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypedDataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetTypedDataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetTypedDataSchemaResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetTypedDataSchemaResponse) GetTypes() map[string]*TypedDataStruct {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetTypedDataSchemaResponse) GetTypesJson() []byte {
+	if x != nil {
+		return x.TypesJson
+	}
+	return nil
+}
+
+func (x *GetTypedDataSchemaResponse) GetDomainGetter() bool {
+	if x != nil {
+		return x.DomainGetter
+	}
+	return false
+}
+
+func (x *GetTypedDataSchemaResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type Keccak256Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Keccak256Request) Reset() {
+	*x = Keccak256Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Keccak256Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keccak256Request) ProtoMessage() {}
+
+func (x *Keccak256Request) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keccak256Request.ProtoReflect.Descriptor instead.
+func (*Keccak256Request) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Keccak256Request) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Keccak256Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Keccak256Response) Reset() {
+	*x = Keccak256Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Keccak256Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keccak256Response) ProtoMessage() {}
+
+func (x *Keccak256Response) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keccak256Response.ProtoReflect.Descriptor instead.
+func (*Keccak256Response) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Keccak256Response) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type ParseSignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ParseSignatureRequest) Reset() {
+	*x = ParseSignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSignatureRequest) ProtoMessage() {}
+
+func (x *ParseSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSignatureRequest.ProtoReflect.Descriptor instead.
+func (*ParseSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ParseSignatureRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ParseSignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Selector  string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	Topic     string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Fragment  []byte `protobuf:"bytes,6,opt,name=fragment,proto3" json:"fragment,omitempty"`
+}
+
+func (x *ParseSignatureResponse) Reset() {
+	*x = ParseSignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSignatureResponse) ProtoMessage() {}
+
+func (x *ParseSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSignatureResponse.ProtoReflect.Descriptor instead.
+func (*ParseSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ParseSignatureResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParseSignatureResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParseSignatureResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ParseSignatureResponse) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *ParseSignatureResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ParseSignatureResponse) GetFragment() []byte {
+	if x != nil {
+		return x.Fragment
+	}
+	return nil
+}
+
+type MatchTopicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic     string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *MatchTopicRequest) Reset() {
+	*x = MatchTopicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTopicRequest) ProtoMessage() {}
+
+func (x *MatchTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTopicRequest.ProtoReflect.Descriptor instead.
+func (*MatchTopicRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MatchTopicRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *MatchTopicRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type MatchTopicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matched         bool     `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	ComputedTopic   string   `protobuf:"bytes,2,opt,name=computed_topic,json=computedTopic,proto3" json:"computed_topic,omitempty"`
+	Signature       string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	KnownSignatures []string `protobuf:"bytes,4,rep,name=known_signatures,json=knownSignatures,proto3" json:"known_signatures,omitempty"`
+}
+
+func (x *MatchTopicResponse) Reset() {
+	*x = MatchTopicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTopicResponse) ProtoMessage() {}
+
+func (x *MatchTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTopicResponse.ProtoReflect.Descriptor instead.
+func (*MatchTopicResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MatchTopicResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *MatchTopicResponse) GetComputedTopic() string {
+	if x != nil {
+		return x.ComputedTopic
+	}
+	return ""
+}
+
+func (x *MatchTopicResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *MatchTopicResponse) GetKnownSignatures() []string {
+	if x != nil {
+		return x.KnownSignatures
+	}
+	return nil
+}
+
+type GetMetadataByErrorSelectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Selector         string      `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,4,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,5,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,6,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByErrorSelectorRequest) Reset() {
+	*x = GetMetadataByErrorSelectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByErrorSelectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByErrorSelectorRequest) ProtoMessage() {}
+
+func (x *GetMetadataByErrorSelectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByErrorSelectorRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByErrorSelectorRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByErrorSelectorRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type GetMetadataByBytecodeHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page             *pb.Page    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Hash             string      `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	OnlyComplete     bool        `protobuf:"varint,3,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	Proxy            ProxyFilter `protobuf:"varint,4,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+	ExcludeDestroyed bool        `protobuf:"varint,5,opt,name=exclude_destroyed,json=excludeDestroyed,proto3" json:"exclude_destroyed,omitempty"`
+	OrderSet         bool        `protobuf:"varint,6,opt,name=order_set,json=orderSet,proto3" json:"order_set,omitempty"`
+}
+
+func (x *GetMetadataByBytecodeHashRequest) Reset() {
+	*x = GetMetadataByBytecodeHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataByBytecodeHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataByBytecodeHashRequest) ProtoMessage() {}
+
+func (x *GetMetadataByBytecodeHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataByBytecodeHashRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataByBytecodeHashRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetPage() *pb.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetExcludeDestroyed() bool {
+	if x != nil {
+		return x.ExcludeDestroyed
+	}
+	return false
+}
+
+func (x *GetMetadataByBytecodeHashRequest) GetOrderSet() bool {
+	if x != nil {
+		return x.OrderSet
+	}
+	return false
+}
+
+type InvalidateCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *InvalidateCacheRequest) Reset() {
+	*x = InvalidateCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *InvalidateCacheRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type InvalidateCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evicted uint64 `protobuf:"varint,1,opt,name=evicted,proto3" json:"evicted,omitempty"`
+}
+
+func (x *InvalidateCacheResponse) Reset() {
+	*x = InvalidateCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *InvalidateCacheResponse) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+type TagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tags    []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *TagsRequest) Reset() {
+	*x = TagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsRequest) ProtoMessage() {}
+
+func (x *TagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsRequest.ProtoReflect.Descriptor instead.
+func (*TagsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TagsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *TagsResponse) Reset() {
+	*x = TagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResponse) ProtoMessage() {}
+
+func (x *TagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResponse.ProtoReflect.Descriptor instead.
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PutMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Merge    bool   `protobuf:"varint,3,opt,name=merge,proto3" json:"merge,omitempty"`
+	IfMatch  string `protobuf:"bytes,4,opt,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
+}
+
+func (x *PutMetadataRequest) Reset() {
+	*x = PutMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutMetadataRequest) ProtoMessage() {}
+
+func (x *PutMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutMetadataRequest.ProtoReflect.Descriptor instead.
+func (*PutMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PutMetadataRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PutMetadataRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PutMetadataRequest) GetMerge() bool {
+	if x != nil {
+		return x.Merge
+	}
+	return false
+}
+
+func (x *PutMetadataRequest) GetIfMatch() string {
+	if x != nil {
+		return x.IfMatch
+	}
+	return ""
+}
+
+type PutMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata  *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Conflicts []string  `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Unchanged bool      `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+}
+
+func (x *PutMetadataResponse) Reset() {
+	*x = PutMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutMetadataResponse) ProtoMessage() {}
+
+func (x *PutMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutMetadataResponse.ProtoReflect.Descriptor instead.
+func (*PutMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PutMetadataResponse) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PutMetadataResponse) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *PutMetadataResponse) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
+type ImportMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Merge    bool   `protobuf:"varint,4,opt,name=merge,proto3" json:"merge,omitempty"`
+}
+
+func (x *ImportMetadataRequest) Reset() {
+	*x = ImportMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMetadataRequest) ProtoMessage() {}
+
+func (x *ImportMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ImportMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ImportMetadataRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ImportMetadataRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ImportMetadataRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ImportMetadataRequest) GetMerge() bool {
+	if x != nil {
+		return x.Merge
+	}
+	return false
+}
+
+type ImportMetadataAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  uint64       `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Address   string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status    ImportStatus `protobuf:"varint,3,opt,name=status,proto3,enum=proto.ImportStatus" json:"status,omitempty"`
+	Error     string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Conflicts []string     `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *ImportMetadataAck) Reset() {
+	*x = ImportMetadataAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportMetadataAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMetadataAck) ProtoMessage() {}
+
+func (x *ImportMetadataAck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMetadataAck.ProtoReflect.Descriptor instead.
+func (*ImportMetadataAck) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ImportMetadataAck) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ImportMetadataAck) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ImportMetadataAck) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_INSERTED
+}
+
+func (x *ImportMetadataAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportMetadataAck) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ImportSignaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signatures []string      `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Kind       SignatureKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.SignatureKind" json:"kind,omitempty"`
+}
+
+func (x *ImportSignaturesRequest) Reset() {
+	*x = ImportSignaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSignaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSignaturesRequest) ProtoMessage() {}
+
+func (x *ImportSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*ImportSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ImportSignaturesRequest) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *ImportSignaturesRequest) GetKind() SignatureKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SignatureKind_FUNCTION
+}
+
+type ImportSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    uint64   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Inserted uint64   `protobuf:"varint,2,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Existing uint64   `protobuf:"varint,3,opt,name=existing,proto3" json:"existing,omitempty"`
+	Invalid  uint64   `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Errors   []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportSignaturesResponse) Reset() {
+	*x = ImportSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSignaturesResponse) ProtoMessage() {}
+
+func (x *ImportSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*ImportSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportSignaturesResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSignaturesResponse) GetInserted() uint64 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ImportSignaturesResponse) GetExisting() uint64 {
+	if x != nil {
+		return x.Existing
+	}
+	return 0
+}
+
+func (x *ImportSignaturesResponse) GetInvalid() uint64 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportSignaturesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto protoreflect.FileDescriptor
+
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDesc = []byte{
+	0x0a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70,
+	0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70,
+	0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x02, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69,
+	0x66, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65,
+	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x66,
+	0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xba, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xcf, 0x01, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x44,
+	0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x06, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72,
+	0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76,
+	0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x76, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x0c, 0x41, 0x62, 0x69, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfc, 0x01, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x85, 0x02, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12,
+	0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3b, 0x0a, 0x19, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x77,
+	0x0a, 0x11, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2f,
+	0x0a, 0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x3a, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x74, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5e, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x76, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x22, 0x1a, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x5b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xbe, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x22, 0x79, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x52,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb5, 0x01, 0x0a,
+	0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x4f, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x3f, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x0d, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x61, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0xaa, 0x04, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x65, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x35,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf1, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x22, 0x95, 0x02, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a,
+	0x08, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x37, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x79, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x90, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x56, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x73, 0x68, 0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x0f, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68,
+	0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x4c,
+	0x0a, 0x1c, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x41, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a,
+	0x0f, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30,
+	0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xdf, 0x02, 0x0a, 0x08, 0x41, 0x62, 0x69, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x62, 0x69, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x75, 0x70, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x41, 0x62, 0x69, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4e, 0x0a, 0x11, 0x41, 0x62, 0x69, 0x54, 0x75, 0x70, 0x6c,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62, 0x69, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x08, 0x41, 0x62, 0x69, 0x54, 0x75, 0x70, 0x6c,
+	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x62,
+	0x69, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1d,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x41, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd3, 0x01, 0x0a, 0x13,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x96, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x6f, 0x75, 0x73, 0x22, 0xd3, 0x04, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x52, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x49, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x55, 0x0a, 0x0e, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x38, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x40, 0x0a, 0x0f, 0x54,
+	0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2d,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xac, 0x02,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x10,
+	0x4b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x4b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x32, 0x35,
+	0x36, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x35, 0x0a,
+	0x15, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9e, 0x01, 0x0a,
+	0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xf9, 0x01,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x20, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x42, 0x79, 0x74, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x79,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x16,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x33, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x0b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x7b, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x66, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x66, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x7e, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x22, 0x63, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x2a, 0x23, 0x0a, 0x09, 0x41, 0x62, 0x69, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x41, 0x42, 0x49, 0x47, 0x45, 0x4e, 0x10, 0x01, 0x2a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x1d, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10,
+	0x01, 0x2a, 0x46, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x0c, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x49, 0x45, 0x53,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x49,
+	0x45, 0x53, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f,
+	0x50, 0x52, 0x4f, 0x58, 0x49, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x28, 0x0a, 0x0d, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x55,
+	0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x01, 0x2a, 0x44, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0x8f, 0x1d, 0x0a, 0x0f, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x17, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x69, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x42, 0x79, 0x74,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x42,
+	0x79, 0x74, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x46, 0x69,
+	0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x41, 0x72, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x41,
+	0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4b, 0x65, 0x63, 0x63, 0x61,
+	0x6b, 0x32, 0x35, 0x36, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x63,
+	0x63, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x32, 0x35, 0x36, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x42, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x41, 0x64, 0x64,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x41, 0x63, 0x6b, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70, 0x64, 0x75, 0x70,
+	0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescOnce sync.Once
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData = file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDesc
+)
+
+func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescGZIP() []byte {
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescOnce.Do(func() {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData)
+	})
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDescData
+}
+
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_goTypes = []interface{}{
+	(AbiFormat)(0),                             // 0: proto.AbiFormat
+	(CodeStatus)(0),                            // 1: proto.CodeStatus
+	(MatchType)(0),                             // 2: proto.MatchType
+	(ProxyFilter)(0),                           // 3: proto.ProxyFilter
+	(SignatureKind)(0),                         // 4: proto.SignatureKind
+	(ImportStatus)(0),                          // 5: proto.ImportStatus
+	(*GetMetadataRequest)(nil),                 // 6: proto.GetMetadataRequest
+	(*ListMetadataRequest)(nil),                // 7: proto.ListMetadataRequest
+	(*ListMetadataResponse)(nil),               // 8: proto.ListMetadataResponse
+	(*SubscribeOnMetadataRequest)(nil),         // 9: proto.SubscribeOnMetadataRequest
+	(*SubscriptionMetadata)(nil),               // 10: proto.SubscriptionMetadata
+	(*CommitOffsetRequest)(nil),                // 11: proto.CommitOffsetRequest
+	(*CommitOffsetResponse)(nil),               // 12: proto.CommitOffsetResponse
+	(*Metadata)(nil),                           // 13: proto.Metadata
+	(*Compilation)(nil),                        // 14: proto.Compilation
+	(*AbiCandidate)(nil),                       // 15: proto.AbiCandidate
+	(*GetMetadataByMethodSinatureRequest)(nil), // 16: proto.GetMetadataByMethodSinatureRequest
+	(*GetMetadataByTopicRequest)(nil),          // 17: proto.GetMetadataByTopicRequest
+	(*GetMetadataBySelectorsRequest)(nil),      // 18: proto.GetMetadataBySelectorsRequest
+	(*RefreshInterfacesRequest)(nil),           // 19: proto.RefreshInterfacesRequest
+	(*RefreshInterfacesResponse)(nil),          // 20: proto.RefreshInterfacesResponse
+	(*ListSelectorSignaturesRequest)(nil),      // 21: proto.ListSelectorSignaturesRequest
+	(*SelectorSignature)(nil),                  // 22: proto.SelectorSignature
+	(*ListSelectorSignaturesResponse)(nil),     // 23: proto.ListSelectorSignaturesResponse
+	(*GetCoverageStatsRequest)(nil),            // 24: proto.GetCoverageStatsRequest
+	(*GetCoverageStatsResponse)(nil),           // 25: proto.GetCoverageStatsResponse
+	(*AnalyzeTokenRequest)(nil),                // 26: proto.AnalyzeTokenRequest
+	(*TokenIssue)(nil),                         // 27: proto.TokenIssue
+	(*AnalyzeTokenResponse)(nil),               // 28: proto.AnalyzeTokenResponse
+	(*SampleMetadataRequest)(nil),              // 29: proto.SampleMetadataRequest
+	(*SampleMetadataResponse)(nil),             // 30: proto.SampleMetadataResponse
+	(*FindSimilarContractsRequest)(nil),        // 31: proto.FindSimilarContractsRequest
+	(*SimilarContract)(nil),                    // 32: proto.SimilarContract
+	(*FindSimilarContractsResponse)(nil),       // 33: proto.FindSimilarContractsResponse
+	(*ListSubscriptionsRequest)(nil),           // 34: proto.ListSubscriptionsRequest
+	(*SubscriptionStats)(nil),                  // 35: proto.SubscriptionStats
+	(*ListSubscriptionsResponse)(nil),          // 36: proto.ListSubscriptionsResponse
+	(*ListClientsRequest)(nil),                 // 37: proto.ListClientsRequest
+	(*ClientSubscription)(nil),                 // 38: proto.ClientSubscription
+	(*ConnectedClient)(nil),                    // 39: proto.ConnectedClient
+	(*ListClientsResponse)(nil),                // 40: proto.ListClientsResponse
+	(*DisconnectRequest)(nil),                  // 41: proto.DisconnectRequest
+	(*DisconnectResponse)(nil),                 // 42: proto.DisconnectResponse
+	(*ListDecodeMissesRequest)(nil),            // 43: proto.ListDecodeMissesRequest
+	(*DecodeMiss)(nil),                         // 44: proto.DecodeMiss
+	(*ListDecodeMissesResponse)(nil),           // 45: proto.ListDecodeMissesResponse
+	(*GetMetadataByTopicsBatchRequest)(nil),    // 46: proto.GetMetadataByTopicsBatchRequest
+	(*TopicEvent)(nil),                         // 47: proto.TopicEvent
+	(*TopicMatches)(nil),                       // 48: proto.TopicMatches
+	(*GetMetadataByTopicsBatchResponse)(nil),   // 49: proto.GetMetadataByTopicsBatchResponse
+	(*HelloRequest)(nil),                       // 50: proto.HelloRequest
+	(*HelloResponse)(nil),                      // 51: proto.HelloResponse
+	(*Limits)(nil),                             // 52: proto.Limits
+	(*GetMetadataByCreatorRequest)(nil),        // 53: proto.GetMetadataByCreatorRequest
+	(*ListMetadataByBlockRangeRequest)(nil),    // 54: proto.ListMetadataByBlockRangeRequest
+	(*ListIncompleteMetadataRequest)(nil),      // 55: proto.ListIncompleteMetadataRequest
+	(*GetMetadataByTagRequest)(nil),            // 56: proto.GetMetadataByTagRequest
+	(*ListTagsRequest)(nil),                    // 57: proto.ListTagsRequest
+	(*TagCount)(nil),                           // 58: proto.TagCount
+	(*ListTagsResponse)(nil),                   // 59: proto.ListTagsResponse
+	(*ListByFactoryRequest)(nil),               // 60: proto.ListByFactoryRequest
+	(*RefreshStatusRequest)(nil),               // 61: proto.RefreshStatusRequest
+	(*RefreshStatus)(nil),                      // 62: proto.RefreshStatus
+	(*SetMaintenanceModeRequest)(nil),          // 63: proto.SetMaintenanceModeRequest
+	(*MaintenanceMode)(nil),                    // 64: proto.MaintenanceMode
+	(*DecodeConstructorArgsRequest)(nil),       // 65: proto.DecodeConstructorArgsRequest
+	(*DecodedArgument)(nil),                    // 66: proto.DecodedArgument
+	(*AbiValue)(nil),                           // 67: proto.AbiValue
+	(*AbiArray)(nil),                           // 68: proto.AbiArray
+	(*AbiTupleComponent)(nil),                  // 69: proto.AbiTupleComponent
+	(*AbiTuple)(nil),                           // 70: proto.AbiTuple
+	(*DecodeConstructorArgsResponse)(nil),      // 71: proto.DecodeConstructorArgsResponse
+	(*DecodeErrorRequest)(nil),                 // 72: proto.DecodeErrorRequest
+	(*DecodeErrorResponse)(nil),                // 73: proto.DecodeErrorResponse
+	(*GetInputSchemaRequest)(nil),              // 74: proto.GetInputSchemaRequest
+	(*GetInputSchemaResponse)(nil),             // 75: proto.GetInputSchemaResponse
+	(*GetMetadataDecodeTableRequest)(nil),      // 76: proto.GetMetadataDecodeTableRequest
+	(*DecodeTableEntry)(nil),                   // 77: proto.DecodeTableEntry
+	(*GetMetadataDecodeTableResponse)(nil),     // 78: proto.GetMetadataDecodeTableResponse
+	(*GetTypedDataSchemaRequest)(nil),          // 79: proto.GetTypedDataSchemaRequest
+	(*TypedDataField)(nil),                     // 80: proto.TypedDataField
+	(*TypedDataStruct)(nil),                    // 81: proto.TypedDataStruct
+	(*GetTypedDataSchemaResponse)(nil),         // 82: proto.GetTypedDataSchemaResponse
+	(*Keccak256Request)(nil),                   // 83: proto.Keccak256Request
+	(*Keccak256Response)(nil),                  // 84: proto.Keccak256Response
+	(*ParseSignatureRequest)(nil),              // 85: proto.ParseSignatureRequest
+	(*ParseSignatureResponse)(nil),             // 86: proto.ParseSignatureResponse
+	(*MatchTopicRequest)(nil),                  // 87: proto.MatchTopicRequest
+	(*MatchTopicResponse)(nil),                 // 88: proto.MatchTopicResponse
+	(*GetMetadataByErrorSelectorRequest)(nil),  // 89: proto.GetMetadataByErrorSelectorRequest
+	(*GetMetadataByBytecodeHashRequest)(nil),   // 90: proto.GetMetadataByBytecodeHashRequest
+	(*InvalidateCacheRequest)(nil),             // 91: proto.InvalidateCacheRequest
+	(*InvalidateCacheResponse)(nil),            // 92: proto.InvalidateCacheResponse
+	(*TagsRequest)(nil),                        // 93: proto.TagsRequest
+	(*TagsResponse)(nil),                       // 94: proto.TagsResponse
+	(*PutMetadataRequest)(nil),                 // 95: proto.PutMetadataRequest
+	(*PutMetadataResponse)(nil),                // 96: proto.PutMetadataResponse
+	(*ImportMetadataRequest)(nil),              // 97: proto.ImportMetadataRequest
+	(*ImportMetadataAck)(nil),                  // 98: proto.ImportMetadataAck
+	(*ImportSignaturesRequest)(nil),            // 99: proto.ImportSignaturesRequest
+	(*ImportSignaturesResponse)(nil),           // 100: proto.ImportSignaturesResponse
+	nil,                                        // 101: proto.Limits.MaxLimitsEntry
+	nil,                                        // 102: proto.GetMetadataDecodeTableResponse.FunctionsEntry
+	nil,                                        // 103: proto.GetMetadataDecodeTableResponse.EventsEntry
+	nil,                                        // 104: proto.GetMetadataDecodeTableResponse.ErrorsEntry
+	nil,                                        // 105: proto.GetTypedDataSchemaResponse.TypesEntry
+	(*fieldmaskpb.FieldMask)(nil),              // 106: google.protobuf.FieldMask
+	(*pb.Page)(nil),                            // 107: proto.Page
+	(*pb.SubscribeResponse)(nil),               // 108: proto.SubscribeResponse
+	(pb.SortOrder)(0),                          // 109: proto.SortOrder
+	(*pb.UnsubscribeRequest)(nil),              // 110: proto.UnsubscribeRequest
+	(*pb.UnsubscribeResponse)(nil),             // 111: proto.UnsubscribeResponse
+}
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_depIdxs = []int32{
+	106, // 0: proto.GetMetadataRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,   // 1: proto.GetMetadataRequest.format:type_name -> proto.AbiFormat
+	107, // 2: proto.ListMetadataRequest.page:type_name -> proto.Page
+	106, // 3: proto.ListMetadataRequest.field_mask:type_name -> google.protobuf.FieldMask
+	3,   // 4: proto.ListMetadataRequest.proxy:type_name -> proto.ProxyFilter
+	13,  // 5: proto.ListMetadataResponse.metadata:type_name -> proto.Metadata
+	107, // 6: proto.ListMetadataResponse.page:type_name -> proto.Page
+	108, // 7: proto.SubscriptionMetadata.subscription:type_name -> proto.SubscribeResponse
+	13,  // 8: proto.SubscriptionMetadata.metadata:type_name -> proto.Metadata
+	10,  // 9: proto.SubscriptionMetadata.batch:type_name -> proto.SubscriptionMetadata
+	15,  // 10: proto.Metadata.candidates:type_name -> proto.AbiCandidate
+	14,  // 11: proto.Metadata.compilation:type_name -> proto.Compilation
+	1,   // 12: proto.Metadata.code_status:type_name -> proto.CodeStatus
+	107, // 13: proto.GetMetadataByMethodSinatureRequest.page:type_name -> proto.Page
+	3,   // 14: proto.GetMetadataByMethodSinatureRequest.proxy:type_name -> proto.ProxyFilter
+	107, // 15: proto.GetMetadataByTopicRequest.page:type_name -> proto.Page
+	3,   // 16: proto.GetMetadataByTopicRequest.proxy:type_name -> proto.ProxyFilter
+	107, // 17: proto.GetMetadataBySelectorsRequest.page:type_name -> proto.Page
+	2,   // 18: proto.GetMetadataBySelectorsRequest.match:type_name -> proto.MatchType
+	3,   // 19: proto.GetMetadataBySelectorsRequest.proxy:type_name -> proto.ProxyFilter
+	4,   // 20: proto.SelectorSignature.kind:type_name -> proto.SignatureKind
+	22,  // 21: proto.ListSelectorSignaturesResponse.items:type_name -> proto.SelectorSignature
+	27,  // 22: proto.AnalyzeTokenResponse.issues:type_name -> proto.TokenIssue
+	13,  // 23: proto.SampleMetadataResponse.metadata:type_name -> proto.Metadata
+	13,  // 24: proto.SimilarContract.metadata:type_name -> proto.Metadata
+	32,  // 25: proto.FindSimilarContractsResponse.contracts:type_name -> proto.SimilarContract
+	35,  // 26: proto.ListSubscriptionsResponse.subscriptions:type_name -> proto.SubscriptionStats
+	38,  // 27: proto.ConnectedClient.subscriptions:type_name -> proto.ClientSubscription
+	39,  // 28: proto.ListClientsResponse.clients:type_name -> proto.ConnectedClient
+	4,   // 29: proto.DecodeMiss.kind:type_name -> proto.SignatureKind
+	44,  // 30: proto.ListDecodeMissesResponse.misses:type_name -> proto.DecodeMiss
+	47,  // 31: proto.TopicMatches.events:type_name -> proto.TopicEvent
+	48,  // 32: proto.GetMetadataByTopicsBatchResponse.topics:type_name -> proto.TopicMatches
+	52,  // 33: proto.HelloResponse.limits:type_name -> proto.Limits
+	64,  // 34: proto.HelloResponse.maintenance:type_name -> proto.MaintenanceMode
+	109, // 35: proto.Limits.default_order:type_name -> proto.SortOrder
+	101, // 36: proto.Limits.max_limits:type_name -> proto.Limits.MaxLimitsEntry
+	107, // 37: proto.GetMetadataByCreatorRequest.page:type_name -> proto.Page
+	3,   // 38: proto.GetMetadataByCreatorRequest.proxy:type_name -> proto.ProxyFilter
+	107, // 39: proto.ListMetadataByBlockRangeRequest.page:type_name -> proto.Page
+	3,   // 40: proto.ListMetadataByBlockRangeRequest.proxy:type_name -> proto.ProxyFilter
+	107, // 41: proto.ListIncompleteMetadataRequest.page:type_name -> proto.Page
+	107, // 42: proto.GetMetadataByTagRequest.page:type_name -> proto.Page
+	2,   // 43: proto.GetMetadataByTagRequest.match:type_name -> proto.MatchType
+	3,   // 44: proto.GetMetadataByTagRequest.proxy:type_name -> proto.ProxyFilter
+	58,  // 45: proto.ListTagsResponse.tags:type_name -> proto.TagCount
+	107, // 46: proto.ListByFactoryRequest.page:type_name -> proto.Page
+	3,   // 47: proto.ListByFactoryRequest.proxy:type_name -> proto.ProxyFilter
+	67,  // 48: proto.DecodedArgument.typed_value:type_name -> proto.AbiValue
+	68,  // 49: proto.AbiValue.array_value:type_name -> proto.AbiArray
+	70,  // 50: proto.AbiValue.tuple_value:type_name -> proto.AbiTuple
+	67,  // 51: proto.AbiArray.items:type_name -> proto.AbiValue
+	67,  // 52: proto.AbiTupleComponent.value:type_name -> proto.AbiValue
+	69,  // 53: proto.AbiTuple.components:type_name -> proto.AbiTupleComponent
+	66,  // 54: proto.DecodeConstructorArgsResponse.arguments:type_name -> proto.DecodedArgument
+	1,   // 55: proto.DecodeConstructorArgsResponse.code_status:type_name -> proto.CodeStatus
+	66,  // 56: proto.DecodeErrorResponse.arguments:type_name -> proto.DecodedArgument
+	1,   // 57: proto.DecodeErrorResponse.code_status:type_name -> proto.CodeStatus
+	1,   // 58: proto.GetInputSchemaResponse.code_status:type_name -> proto.CodeStatus
+	102, // 59: proto.GetMetadataDecodeTableResponse.functions:type_name -> proto.GetMetadataDecodeTableResponse.FunctionsEntry
+	103, // 60: proto.GetMetadataDecodeTableResponse.events:type_name -> proto.GetMetadataDecodeTableResponse.EventsEntry
+	104, // 61: proto.GetMetadataDecodeTableResponse.errors:type_name -> proto.GetMetadataDecodeTableResponse.ErrorsEntry
+	80,  // 62: proto.TypedDataStruct.fields:type_name -> proto.TypedDataField
+	105, // 63: proto.GetTypedDataSchemaResponse.types:type_name -> proto.GetTypedDataSchemaResponse.TypesEntry
+	107, // 64: proto.GetMetadataByErrorSelectorRequest.page:type_name -> proto.Page
+	3,   // 65: proto.GetMetadataByErrorSelectorRequest.proxy:type_name -> proto.ProxyFilter
+	107, // 66: proto.GetMetadataByBytecodeHashRequest.page:type_name -> proto.Page
+	3,   // 67: proto.GetMetadataByBytecodeHashRequest.proxy:type_name -> proto.ProxyFilter
+	13,  // 68: proto.PutMetadataResponse.metadata:type_name -> proto.Metadata
+	5,   // 69: proto.ImportMetadataAck.status:type_name -> proto.ImportStatus
+	4,   // 70: proto.ImportSignaturesRequest.kind:type_name -> proto.SignatureKind
+	77,  // 71: proto.GetMetadataDecodeTableResponse.FunctionsEntry.value:type_name -> proto.DecodeTableEntry
+	77,  // 72: proto.GetMetadataDecodeTableResponse.EventsEntry.value:type_name -> proto.DecodeTableEntry
+	77,  // 73: proto.GetMetadataDecodeTableResponse.ErrorsEntry.value:type_name -> proto.DecodeTableEntry
+	81,  // 74: proto.GetTypedDataSchemaResponse.TypesEntry.value:type_name -> proto.TypedDataStruct
+	50,  // 75: proto.MetadataService.Hello:input_type -> proto.HelloRequest
+	9,   // 76: proto.MetadataService.SubscribeOnMetadata:input_type -> proto.SubscribeOnMetadataRequest
+	110, // 77: proto.MetadataService.UnsubscribeFromMetadata:input_type -> proto.UnsubscribeRequest
+	11,  // 78: proto.MetadataService.CommitOffset:input_type -> proto.CommitOffsetRequest
+	34,  // 79: proto.MetadataService.ListSubscriptions:input_type -> proto.ListSubscriptionsRequest
+	6,   // 80: proto.MetadataService.GetMetadata:input_type -> proto.GetMetadataRequest
+	7,   // 81: proto.MetadataService.ListMetadata:input_type -> proto.ListMetadataRequest
+	16,  // 82: proto.MetadataService.GetMetadataByMethodSinature:input_type -> proto.GetMetadataByMethodSinatureRequest
+	17,  // 83: proto.MetadataService.GetMetadataByTopic:input_type -> proto.GetMetadataByTopicRequest
+	46,  // 84: proto.MetadataService.GetMetadataByTopicsBatch:input_type -> proto.GetMetadataByTopicsBatchRequest
+	18,  // 85: proto.MetadataService.GetMetadataBySelectors:input_type -> proto.GetMetadataBySelectorsRequest
+	89,  // 86: proto.MetadataService.GetMetadataByErrorSelector:input_type -> proto.GetMetadataByErrorSelectorRequest
+	90,  // 87: proto.MetadataService.GetMetadataByBytecodeHash:input_type -> proto.GetMetadataByBytecodeHashRequest
+	53,  // 88: proto.MetadataService.GetMetadataByCreator:input_type -> proto.GetMetadataByCreatorRequest
+	54,  // 89: proto.MetadataService.ListMetadataByBlockRange:input_type -> proto.ListMetadataByBlockRangeRequest
+	55,  // 90: proto.MetadataService.ListIncompleteMetadata:input_type -> proto.ListIncompleteMetadataRequest
+	56,  // 91: proto.MetadataService.GetMetadataByTag:input_type -> proto.GetMetadataByTagRequest
+	57,  // 92: proto.MetadataService.ListTags:input_type -> proto.ListTagsRequest
+	60,  // 93: proto.MetadataService.ListByFactory:input_type -> proto.ListByFactoryRequest
+	21,  // 94: proto.MetadataService.ListSelectorSignatures:input_type -> proto.ListSelectorSignaturesRequest
+	24,  // 95: proto.MetadataService.GetCoverageStats:input_type -> proto.GetCoverageStatsRequest
+	29,  // 96: proto.MetadataService.SampleMetadata:input_type -> proto.SampleMetadataRequest
+	31,  // 97: proto.MetadataService.FindSimilarContracts:input_type -> proto.FindSimilarContractsRequest
+	19,  // 98: proto.MetadataService.RefreshInterfaces:input_type -> proto.RefreshInterfacesRequest
+	26,  // 99: proto.MetadataService.AnalyzeToken:input_type -> proto.AnalyzeTokenRequest
+	65,  // 100: proto.MetadataService.DecodeConstructorArgs:input_type -> proto.DecodeConstructorArgsRequest
+	72,  // 101: proto.MetadataService.DecodeError:input_type -> proto.DecodeErrorRequest
+	74,  // 102: proto.MetadataService.GetInputSchema:input_type -> proto.GetInputSchemaRequest
+	76,  // 103: proto.MetadataService.GetMetadataDecodeTable:input_type -> proto.GetMetadataDecodeTableRequest
+	79,  // 104: proto.MetadataService.GetTypedDataSchema:input_type -> proto.GetTypedDataSchemaRequest
+	83,  // 105: proto.MetadataService.Keccak256:input_type -> proto.Keccak256Request
+	85,  // 106: proto.MetadataService.ParseSignature:input_type -> proto.ParseSignatureRequest
+	87,  // 107: proto.MetadataService.MatchTopic:input_type -> proto.MatchTopicRequest
+	61,  // 108: proto.MetadataService.PauseRefresh:input_type -> proto.RefreshStatusRequest
+	61,  // 109: proto.MetadataService.ResumeRefresh:input_type -> proto.RefreshStatusRequest
+	61,  // 110: proto.MetadataService.GetRefreshStatus:input_type -> proto.RefreshStatusRequest
+	63,  // 111: proto.MetadataService.SetMaintenanceMode:input_type -> proto.SetMaintenanceModeRequest
+	91,  // 112: proto.MetadataService.InvalidateCache:input_type -> proto.InvalidateCacheRequest
+	93,  // 113: proto.MetadataService.AddTags:input_type -> proto.TagsRequest
+	93,  // 114: proto.MetadataService.RemoveTags:input_type -> proto.TagsRequest
+	95,  // 115: proto.MetadataService.PutMetadata:input_type -> proto.PutMetadataRequest
+	97,  // 116: proto.MetadataService.ImportMetadata:input_type -> proto.ImportMetadataRequest
+	99,  // 117: proto.MetadataService.ImportSignatures:input_type -> proto.ImportSignaturesRequest
+	37,  // 118: proto.MetadataService.ListClients:input_type -> proto.ListClientsRequest
+	41,  // 119: proto.MetadataService.Disconnect:input_type -> proto.DisconnectRequest
+	43,  // 120: proto.MetadataService.ListDecodeMisses:input_type -> proto.ListDecodeMissesRequest
+	51,  // 121: proto.MetadataService.Hello:output_type -> proto.HelloResponse
+	10,  // 122: proto.MetadataService.SubscribeOnMetadata:output_type -> proto.SubscriptionMetadata
+	111, // 123: proto.MetadataService.UnsubscribeFromMetadata:output_type -> proto.UnsubscribeResponse
+	12,  // 124: proto.MetadataService.CommitOffset:output_type -> proto.CommitOffsetResponse
+	36,  // 125: proto.MetadataService.ListSubscriptions:output_type -> proto.ListSubscriptionsResponse
+	13,  // 126: proto.MetadataService.GetMetadata:output_type -> proto.Metadata
+	8,   // 127: proto.MetadataService.ListMetadata:output_type -> proto.ListMetadataResponse
+	8,   // 128: proto.MetadataService.GetMetadataByMethodSinature:output_type -> proto.ListMetadataResponse
+	8,   // 129: proto.MetadataService.GetMetadataByTopic:output_type -> proto.ListMetadataResponse
+	49,  // 130: proto.MetadataService.GetMetadataByTopicsBatch:output_type -> proto.GetMetadataByTopicsBatchResponse
+	8,   // 131: proto.MetadataService.GetMetadataBySelectors:output_type -> proto.ListMetadataResponse
+	8,   // 132: proto.MetadataService.GetMetadataByErrorSelector:output_type -> proto.ListMetadataResponse
+	8,   // 133: proto.MetadataService.GetMetadataByBytecodeHash:output_type -> proto.ListMetadataResponse
+	8,   // 134: proto.MetadataService.GetMetadataByCreator:output_type -> proto.ListMetadataResponse
+	8,   // 135: proto.MetadataService.ListMetadataByBlockRange:output_type -> proto.ListMetadataResponse
+	8,   // 136: proto.MetadataService.ListIncompleteMetadata:output_type -> proto.ListMetadataResponse
+	8,   // 137: proto.MetadataService.GetMetadataByTag:output_type -> proto.ListMetadataResponse
+	59,  // 138: proto.MetadataService.ListTags:output_type -> proto.ListTagsResponse
+	8,   // 139: proto.MetadataService.ListByFactory:output_type -> proto.ListMetadataResponse
+	23,  // 140: proto.MetadataService.ListSelectorSignatures:output_type -> proto.ListSelectorSignaturesResponse
+	25,  // 141: proto.MetadataService.GetCoverageStats:output_type -> proto.GetCoverageStatsResponse
+	30,  // 142: proto.MetadataService.SampleMetadata:output_type -> proto.SampleMetadataResponse
+	33,  // 143: proto.MetadataService.FindSimilarContracts:output_type -> proto.FindSimilarContractsResponse
+	20,  // 144: proto.MetadataService.RefreshInterfaces:output_type -> proto.RefreshInterfacesResponse
+	28,  // 145: proto.MetadataService.AnalyzeToken:output_type -> proto.AnalyzeTokenResponse
+	71,  // 146: proto.MetadataService.DecodeConstructorArgs:output_type -> proto.DecodeConstructorArgsResponse
+	73,  // 147: proto.MetadataService.DecodeError:output_type -> proto.DecodeErrorResponse
+	75,  // 148: proto.MetadataService.GetInputSchema:output_type -> proto.GetInputSchemaResponse
+	78,  // 149: proto.MetadataService.GetMetadataDecodeTable:output_type -> proto.GetMetadataDecodeTableResponse
+	82,  // 150: proto.MetadataService.GetTypedDataSchema:output_type -> proto.GetTypedDataSchemaResponse
+	84,  // 151: proto.MetadataService.Keccak256:output_type -> proto.Keccak256Response
+	86,  // 152: proto.MetadataService.ParseSignature:output_type -> proto.ParseSignatureResponse
+	88,  // 153: proto.MetadataService.MatchTopic:output_type -> proto.MatchTopicResponse
+	62,  // 154: proto.MetadataService.PauseRefresh:output_type -> proto.RefreshStatus
+	62,  // 155: proto.MetadataService.ResumeRefresh:output_type -> proto.RefreshStatus
+	62,  // 156: proto.MetadataService.GetRefreshStatus:output_type -> proto.RefreshStatus
+	64,  // 157: proto.MetadataService.SetMaintenanceMode:output_type -> proto.MaintenanceMode
+	92,  // 158: proto.MetadataService.InvalidateCache:output_type -> proto.InvalidateCacheResponse
+	94,  // 159: proto.MetadataService.AddTags:output_type -> proto.TagsResponse
+	94,  // 160: proto.MetadataService.RemoveTags:output_type -> proto.TagsResponse
+	96,  // 161: proto.MetadataService.PutMetadata:output_type -> proto.PutMetadataResponse
+	98,  // 162: proto.MetadataService.ImportMetadata:output_type -> proto.ImportMetadataAck
+	100, // 163: proto.MetadataService.ImportSignatures:output_type -> proto.ImportSignaturesResponse
+	40,  // 164: proto.MetadataService.ListClients:output_type -> proto.ListClientsResponse
+	42,  // 165: proto.MetadataService.Disconnect:output_type -> proto.DisconnectResponse
+	45,  // 166: proto.MetadataService.ListDecodeMisses:output_type -> proto.ListDecodeMissesResponse
+	121, // [121:167] is the sub-list for method output_type
+	75,  // [75:121] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_init() }
+func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_init() {
+	if File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeOnMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitOffsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitOffsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compilation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbiCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByMethodSinatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByTopicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataBySelectorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshInterfacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshInterfacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSelectorSignaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectorSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSelectorSignaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoverageStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoverageStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampleMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampleMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSimilarContractsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSimilarContractsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectedClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDecodeMissesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeMiss); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDecodeMissesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByTopicsBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicMatches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByTopicsBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByCreatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataByBlockRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncompleteMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListByFactoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeConstructorArgsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedArgument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbiValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbiArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbiTupleComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbiTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeConstructorArgsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeErrorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -572,8 +8977,8 @@ func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_prot
 				return nil
 			}
 		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriptionMetadata); i {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeErrorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -584,8 +8989,8 @@ func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_prot
 				return nil
 			}
 		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metadata); i {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInputSchemaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -596,8 +9001,8 @@ func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_prot
 				return nil
 			}
 		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetadataByMethodSinatureRequest); i {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInputSchemaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -608,8 +9013,296 @@ func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_prot
 				return nil
 			}
 		}
-		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetadataByTopicRequest); i {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataDecodeTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeTableEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataDecodeTableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypedDataSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypedDataField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypedDataStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTypedDataSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keccak256Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keccak256Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchTopicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchTopicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByErrorSelectorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataByBytecodeHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMetadataAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSignaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSignaturesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -621,18 +9314,29 @@ func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_prot
 			}
 		}
 	}
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes[61].OneofWrappers = []interface{}{
+		(*AbiValue_UintValue)(nil),
+		(*AbiValue_IntValue)(nil),
+		(*AbiValue_BoolValue)(nil),
+		(*AbiValue_AddressValue)(nil),
+		(*AbiValue_BytesValue)(nil),
+		(*AbiValue_StringValue)(nil),
+		(*AbiValue_ArrayValue)(nil),
+		(*AbiValue_TupleValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      6,
+			NumMessages:   100,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_goTypes,
 		DependencyIndexes: file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_depIdxs,
+		EnumInfos:         file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_enumTypes,
 		MessageInfos:      file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_msgTypes,
 	}.Build()
 	File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto = out.File
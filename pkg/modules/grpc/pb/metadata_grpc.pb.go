@@ -23,12 +23,52 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MetadataServiceClient interface {
-	SubscribeOnMetadata(ctx context.Context, in *pb.DefaultRequest, opts ...grpc.CallOption) (MetadataService_SubscribeOnMetadataClient, error)
+	Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
+	SubscribeOnMetadata(ctx context.Context, in *SubscribeOnMetadataRequest, opts ...grpc.CallOption) (MetadataService_SubscribeOnMetadataClient, error)
 	UnsubscribeFromMetadata(ctx context.Context, in *pb.UnsubscribeRequest, opts ...grpc.CallOption) (*pb.UnsubscribeResponse, error)
+	CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
 	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*Metadata, error)
 	ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
 	GetMetadataByMethodSinature(ctx context.Context, in *GetMetadataByMethodSinatureRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
 	GetMetadataByTopic(ctx context.Context, in *GetMetadataByTopicRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	GetMetadataByTopicsBatch(ctx context.Context, in *GetMetadataByTopicsBatchRequest, opts ...grpc.CallOption) (*GetMetadataByTopicsBatchResponse, error)
+	GetMetadataBySelectors(ctx context.Context, in *GetMetadataBySelectorsRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	GetMetadataByErrorSelector(ctx context.Context, in *GetMetadataByErrorSelectorRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	GetMetadataByBytecodeHash(ctx context.Context, in *GetMetadataByBytecodeHashRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	GetMetadataByCreator(ctx context.Context, in *GetMetadataByCreatorRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	ListMetadataByBlockRange(ctx context.Context, in *ListMetadataByBlockRangeRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	ListIncompleteMetadata(ctx context.Context, in *ListIncompleteMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	GetMetadataByTag(ctx context.Context, in *GetMetadataByTagRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	ListByFactory(ctx context.Context, in *ListByFactoryRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
+	ListSelectorSignatures(ctx context.Context, in *ListSelectorSignaturesRequest, opts ...grpc.CallOption) (*ListSelectorSignaturesResponse, error)
+	GetCoverageStats(ctx context.Context, in *GetCoverageStatsRequest, opts ...grpc.CallOption) (*GetCoverageStatsResponse, error)
+	SampleMetadata(ctx context.Context, in *SampleMetadataRequest, opts ...grpc.CallOption) (*SampleMetadataResponse, error)
+	FindSimilarContracts(ctx context.Context, in *FindSimilarContractsRequest, opts ...grpc.CallOption) (*FindSimilarContractsResponse, error)
+	RefreshInterfaces(ctx context.Context, in *RefreshInterfacesRequest, opts ...grpc.CallOption) (*RefreshInterfacesResponse, error)
+	AnalyzeToken(ctx context.Context, in *AnalyzeTokenRequest, opts ...grpc.CallOption) (*AnalyzeTokenResponse, error)
+	DecodeConstructorArgs(ctx context.Context, in *DecodeConstructorArgsRequest, opts ...grpc.CallOption) (*DecodeConstructorArgsResponse, error)
+	DecodeError(ctx context.Context, in *DecodeErrorRequest, opts ...grpc.CallOption) (*DecodeErrorResponse, error)
+	GetInputSchema(ctx context.Context, in *GetInputSchemaRequest, opts ...grpc.CallOption) (*GetInputSchemaResponse, error)
+	GetMetadataDecodeTable(ctx context.Context, in *GetMetadataDecodeTableRequest, opts ...grpc.CallOption) (*GetMetadataDecodeTableResponse, error)
+	GetTypedDataSchema(ctx context.Context, in *GetTypedDataSchemaRequest, opts ...grpc.CallOption) (*GetTypedDataSchemaResponse, error)
+	Keccak256(ctx context.Context, in *Keccak256Request, opts ...grpc.CallOption) (*Keccak256Response, error)
+	ParseSignature(ctx context.Context, in *ParseSignatureRequest, opts ...grpc.CallOption) (*ParseSignatureResponse, error)
+	MatchTopic(ctx context.Context, in *MatchTopicRequest, opts ...grpc.CallOption) (*MatchTopicResponse, error)
+	PauseRefresh(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error)
+	ResumeRefresh(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error)
+	GetRefreshStatus(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+	AddTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	RemoveTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	PutMetadata(ctx context.Context, in *PutMetadataRequest, opts ...grpc.CallOption) (*PutMetadataResponse, error)
+	ImportMetadata(ctx context.Context, opts ...grpc.CallOption) (MetadataService_ImportMetadataClient, error)
+	ImportSignatures(ctx context.Context, in *ImportSignaturesRequest, opts ...grpc.CallOption) (*ImportSignaturesResponse, error)
+	ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
+	ListDecodeMisses(ctx context.Context, in *ListDecodeMissesRequest, opts ...grpc.CallOption) (*ListDecodeMissesResponse, error)
 }
 
 type metadataServiceClient struct {
@@ -39,7 +79,16 @@ func NewMetadataServiceClient(cc grpc.ClientConnInterface) MetadataServiceClient
 	return &metadataServiceClient{cc}
 }
 
-func (c *metadataServiceClient) SubscribeOnMetadata(ctx context.Context, in *pb.DefaultRequest, opts ...grpc.CallOption) (MetadataService_SubscribeOnMetadataClient, error) {
+func (c *metadataServiceClient) Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	out := new(HelloResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/Hello", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SubscribeOnMetadata(ctx context.Context, in *SubscribeOnMetadataRequest, opts ...grpc.CallOption) (MetadataService_SubscribeOnMetadataClient, error) {
 	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[0], "/proto.MetadataService/SubscribeOnMetadata", opts...)
 	if err != nil {
 		return nil, err
@@ -80,6 +129,24 @@ func (c *metadataServiceClient) UnsubscribeFromMetadata(ctx context.Context, in
 	return out, nil
 }
 
+func (c *metadataServiceClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+	out := new(CommitOffsetResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/CommitOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*Metadata, error) {
 	out := new(Metadata)
 	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadata", in, out, opts...)
@@ -116,163 +183,1406 @@ func (c *metadataServiceClient) GetMetadataByTopic(ctx context.Context, in *GetM
 	return out, nil
 }
 
-// MetadataServiceServer is the server API for MetadataService service.
-// All implementations must embed UnimplementedMetadataServiceServer
-// for forward compatibility
-type MetadataServiceServer interface {
-	SubscribeOnMetadata(*pb.DefaultRequest, MetadataService_SubscribeOnMetadataServer) error
-	UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error)
-	GetMetadata(context.Context, *GetMetadataRequest) (*Metadata, error)
-	ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error)
-	GetMetadataByMethodSinature(context.Context, *GetMetadataByMethodSinatureRequest) (*ListMetadataResponse, error)
-	GetMetadataByTopic(context.Context, *GetMetadataByTopicRequest) (*ListMetadataResponse, error)
-	mustEmbedUnimplementedMetadataServiceServer()
+func (c *metadataServiceClient) GetMetadataByTopicsBatch(ctx context.Context, in *GetMetadataByTopicsBatchRequest, opts ...grpc.CallOption) (*GetMetadataByTopicsBatchResponse, error) {
+	out := new(GetMetadataByTopicsBatchResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataByTopicsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMetadataServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedMetadataServiceServer struct {
+func (c *metadataServiceClient) GetMetadataBySelectors(ctx context.Context, in *GetMetadataBySelectorsRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataBySelectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedMetadataServiceServer) SubscribeOnMetadata(*pb.DefaultRequest, MetadataService_SubscribeOnMetadataServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeOnMetadata not implemented")
-}
-func (UnimplementedMetadataServiceServer) UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFromMetadata not implemented")
-}
-func (UnimplementedMetadataServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*Metadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
-}
-func (UnimplementedMetadataServiceServer) ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+func (c *metadataServiceClient) GetMetadataByErrorSelector(ctx context.Context, in *GetMetadataByErrorSelectorRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataByErrorSelector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMetadataServiceServer) GetMetadataByMethodSinature(context.Context, *GetMetadataByMethodSinatureRequest) (*ListMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByMethodSinature not implemented")
+
+func (c *metadataServiceClient) GetMetadataByBytecodeHash(ctx context.Context, in *GetMetadataByBytecodeHashRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataByBytecodeHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMetadataServiceServer) GetMetadataByTopic(context.Context, *GetMetadataByTopicRequest) (*ListMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByTopic not implemented")
+
+func (c *metadataServiceClient) GetMetadataByCreator(ctx context.Context, in *GetMetadataByCreatorRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
 
-// UnsafeMetadataServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MetadataServiceServer will
-// result in compilation errors.
-type UnsafeMetadataServiceServer interface {
-	mustEmbedUnimplementedMetadataServiceServer()
+func (c *metadataServiceClient) ListMetadataByBlockRange(ctx context.Context, in *ListMetadataByBlockRangeRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListMetadataByBlockRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterMetadataServiceServer(s grpc.ServiceRegistrar, srv MetadataServiceServer) {
-	s.RegisterService(&MetadataService_ServiceDesc, srv)
+func (c *metadataServiceClient) ListIncompleteMetadata(ctx context.Context, in *ListIncompleteMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListIncompleteMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _MetadataService_SubscribeOnMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(pb.DefaultRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *metadataServiceClient) GetMetadataByTag(ctx context.Context, in *GetMetadataByTagRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(MetadataServiceServer).SubscribeOnMetadata(m, &metadataServiceSubscribeOnMetadataServer{stream})
+	return out, nil
 }
 
-type MetadataService_SubscribeOnMetadataServer interface {
-	Send(*SubscriptionMetadata) error
-	grpc.ServerStream
+func (c *metadataServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type metadataServiceSubscribeOnMetadataServer struct {
-	grpc.ServerStream
+func (c *metadataServiceClient) ListByFactory(ctx context.Context, in *ListByFactoryRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListByFactory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *metadataServiceSubscribeOnMetadataServer) Send(m *SubscriptionMetadata) error {
-	return x.ServerStream.SendMsg(m)
+func (c *metadataServiceClient) ListSelectorSignatures(ctx context.Context, in *ListSelectorSignaturesRequest, opts ...grpc.CallOption) (*ListSelectorSignaturesResponse, error) {
+	out := new(ListSelectorSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListSelectorSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _MetadataService_UnsubscribeFromMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.UnsubscribeRequest)
-	if err := dec(in); err != nil {
+func (c *metadataServiceClient) GetCoverageStats(ctx context.Context, in *GetCoverageStatsRequest, opts ...grpc.CallOption) (*GetCoverageStatsResponse, error) {
+	out := new(GetCoverageStatsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetCoverageStats", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).UnsubscribeFromMetadata(ctx, in)
+	return out, nil
+}
+
+func (c *metadataServiceClient) SampleMetadata(ctx context.Context, in *SampleMetadataRequest, opts ...grpc.CallOption) (*SampleMetadataResponse, error) {
+	out := new(SampleMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/SampleMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.MetadataService/UnsubscribeFromMetadata",
+	return out, nil
+}
+
+func (c *metadataServiceClient) FindSimilarContracts(ctx context.Context, in *FindSimilarContractsRequest, opts ...grpc.CallOption) (*FindSimilarContractsResponse, error) {
+	out := new(FindSimilarContractsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/FindSimilarContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).UnsubscribeFromMetadata(ctx, req.(*pb.UnsubscribeRequest))
+	return out, nil
+}
+
+func (c *metadataServiceClient) RefreshInterfaces(ctx context.Context, in *RefreshInterfacesRequest, opts ...grpc.CallOption) (*RefreshInterfacesResponse, error) {
+	out := new(RefreshInterfacesResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/RefreshInterfaces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRequest)
-	if err := dec(in); err != nil {
+func (c *metadataServiceClient) AnalyzeToken(ctx context.Context, in *AnalyzeTokenRequest, opts ...grpc.CallOption) (*AnalyzeTokenResponse, error) {
+	out := new(AnalyzeTokenResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/AnalyzeToken", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).GetMetadata(ctx, in)
+	return out, nil
+}
+
+func (c *metadataServiceClient) DecodeConstructorArgs(ctx context.Context, in *DecodeConstructorArgsRequest, opts ...grpc.CallOption) (*DecodeConstructorArgsResponse, error) {
+	out := new(DecodeConstructorArgsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/DecodeConstructorArgs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.MetadataService/GetMetadata",
+	return out, nil
+}
+
+func (c *metadataServiceClient) DecodeError(ctx context.Context, in *DecodeErrorRequest, opts ...grpc.CallOption) (*DecodeErrorResponse, error) {
+	out := new(DecodeErrorResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/DecodeError", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetInputSchema(ctx context.Context, in *GetInputSchemaRequest, opts ...grpc.CallOption) (*GetInputSchemaResponse, error) {
+	out := new(GetInputSchemaResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetInputSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MetadataService_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetadataRequest)
-	if err := dec(in); err != nil {
+func (c *metadataServiceClient) GetMetadataDecodeTable(ctx context.Context, in *GetMetadataDecodeTableRequest, opts ...grpc.CallOption) (*GetMetadataDecodeTableResponse, error) {
+	out := new(GetMetadataDecodeTableResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetMetadataDecodeTable", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).ListMetadata(ctx, in)
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetTypedDataSchema(ctx context.Context, in *GetTypedDataSchemaRequest, opts ...grpc.CallOption) (*GetTypedDataSchemaResponse, error) {
+	out := new(GetTypedDataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetTypedDataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.MetadataService/ListMetadata",
+	return out, nil
+}
+
+func (c *metadataServiceClient) Keccak256(ctx context.Context, in *Keccak256Request, opts ...grpc.CallOption) (*Keccak256Response, error) {
+	out := new(Keccak256Response)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/Keccak256", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).ListMetadata(ctx, req.(*ListMetadataRequest))
+	return out, nil
+}
+
+func (c *metadataServiceClient) ParseSignature(ctx context.Context, in *ParseSignatureRequest, opts ...grpc.CallOption) (*ParseSignatureResponse, error) {
+	out := new(ParseSignatureResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ParseSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MetadataService_GetMetadataByMethodSinature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataByMethodSinatureRequest)
-	if err := dec(in); err != nil {
+func (c *metadataServiceClient) MatchTopic(ctx context.Context, in *MatchTopicRequest, opts ...grpc.CallOption) (*MatchTopicResponse, error) {
+	out := new(MatchTopicResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/MatchTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).GetMetadataByMethodSinature(ctx, in)
+	return out, nil
+}
+
+func (c *metadataServiceClient) PauseRefresh(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error) {
+	out := new(RefreshStatus)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/PauseRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.MetadataService/GetMetadataByMethodSinature",
+	return out, nil
+}
+
+func (c *metadataServiceClient) ResumeRefresh(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error) {
+	out := new(RefreshStatus)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ResumeRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetMetadataByMethodSinature(ctx, req.(*GetMetadataByMethodSinatureRequest))
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetRefreshStatus(ctx context.Context, in *RefreshStatusRequest, opts ...grpc.CallOption) (*RefreshStatus, error) {
+	out := new(RefreshStatus)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/GetRefreshStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _MetadataService_GetMetadataByTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataByTopicRequest)
-	if err := dec(in); err != nil {
+func (c *metadataServiceClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).GetMetadataByTopic(ctx, in)
+	return out, nil
+}
+
+func (c *metadataServiceClient) InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/InvalidateCache", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.MetadataService/GetMetadataByTopic",
+	return out, nil
+}
+
+func (c *metadataServiceClient) AddTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/AddTags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetMetadataByTopic(ctx, req.(*GetMetadataByTopicRequest))
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemoveTags(ctx context.Context, in *TagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/RemoveTags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
+}
+
+func (c *metadataServiceClient) PutMetadata(ctx context.Context, in *PutMetadataRequest, opts ...grpc.CallOption) (*PutMetadataResponse, error) {
+	out := new(PutMetadataResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/PutMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ImportMetadata(ctx context.Context, opts ...grpc.CallOption) (MetadataService_ImportMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MetadataService_ServiceDesc.Streams[1], "/proto.MetadataService/ImportMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metadataServiceImportMetadataClient{stream}
+	return x, nil
+}
+
+type MetadataService_ImportMetadataClient interface {
+	Send(*ImportMetadataRequest) error
+	Recv() (*ImportMetadataAck, error)
+	grpc.ClientStream
+}
+
+type metadataServiceImportMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *metadataServiceImportMetadataClient) Send(m *ImportMetadataRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metadataServiceImportMetadataClient) Recv() (*ImportMetadataAck, error) {
+	m := new(ImportMetadataAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metadataServiceClient) ImportSignatures(ctx context.Context, in *ImportSignaturesRequest, opts ...grpc.CallOption) (*ImportSignaturesResponse, error) {
+	out := new(ImportSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ImportSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error) {
+	out := new(DisconnectResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/Disconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListDecodeMisses(ctx context.Context, in *ListDecodeMissesRequest, opts ...grpc.CallOption) (*ListDecodeMissesResponse, error) {
+	out := new(ListDecodeMissesResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataService/ListDecodeMisses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetadataServiceServer is the server API for MetadataService service.
+// All implementations must embed UnimplementedMetadataServiceServer
+// for forward compatibility
+type MetadataServiceServer interface {
+	Hello(context.Context, *HelloRequest) (*HelloResponse, error)
+	SubscribeOnMetadata(*SubscribeOnMetadataRequest, MetadataService_SubscribeOnMetadataServer) error
+	UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error)
+	CommitOffset(context.Context, *CommitOffsetRequest) (*CommitOffsetResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*Metadata, error)
+	ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error)
+	GetMetadataByMethodSinature(context.Context, *GetMetadataByMethodSinatureRequest) (*ListMetadataResponse, error)
+	GetMetadataByTopic(context.Context, *GetMetadataByTopicRequest) (*ListMetadataResponse, error)
+	GetMetadataByTopicsBatch(context.Context, *GetMetadataByTopicsBatchRequest) (*GetMetadataByTopicsBatchResponse, error)
+	GetMetadataBySelectors(context.Context, *GetMetadataBySelectorsRequest) (*ListMetadataResponse, error)
+	GetMetadataByErrorSelector(context.Context, *GetMetadataByErrorSelectorRequest) (*ListMetadataResponse, error)
+	GetMetadataByBytecodeHash(context.Context, *GetMetadataByBytecodeHashRequest) (*ListMetadataResponse, error)
+	GetMetadataByCreator(context.Context, *GetMetadataByCreatorRequest) (*ListMetadataResponse, error)
+	ListMetadataByBlockRange(context.Context, *ListMetadataByBlockRangeRequest) (*ListMetadataResponse, error)
+	ListIncompleteMetadata(context.Context, *ListIncompleteMetadataRequest) (*ListMetadataResponse, error)
+	GetMetadataByTag(context.Context, *GetMetadataByTagRequest) (*ListMetadataResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	ListByFactory(context.Context, *ListByFactoryRequest) (*ListMetadataResponse, error)
+	ListSelectorSignatures(context.Context, *ListSelectorSignaturesRequest) (*ListSelectorSignaturesResponse, error)
+	GetCoverageStats(context.Context, *GetCoverageStatsRequest) (*GetCoverageStatsResponse, error)
+	SampleMetadata(context.Context, *SampleMetadataRequest) (*SampleMetadataResponse, error)
+	FindSimilarContracts(context.Context, *FindSimilarContractsRequest) (*FindSimilarContractsResponse, error)
+	RefreshInterfaces(context.Context, *RefreshInterfacesRequest) (*RefreshInterfacesResponse, error)
+	AnalyzeToken(context.Context, *AnalyzeTokenRequest) (*AnalyzeTokenResponse, error)
+	DecodeConstructorArgs(context.Context, *DecodeConstructorArgsRequest) (*DecodeConstructorArgsResponse, error)
+	DecodeError(context.Context, *DecodeErrorRequest) (*DecodeErrorResponse, error)
+	GetInputSchema(context.Context, *GetInputSchemaRequest) (*GetInputSchemaResponse, error)
+	GetMetadataDecodeTable(context.Context, *GetMetadataDecodeTableRequest) (*GetMetadataDecodeTableResponse, error)
+	GetTypedDataSchema(context.Context, *GetTypedDataSchemaRequest) (*GetTypedDataSchemaResponse, error)
+	Keccak256(context.Context, *Keccak256Request) (*Keccak256Response, error)
+	ParseSignature(context.Context, *ParseSignatureRequest) (*ParseSignatureResponse, error)
+	MatchTopic(context.Context, *MatchTopicRequest) (*MatchTopicResponse, error)
+	PauseRefresh(context.Context, *RefreshStatusRequest) (*RefreshStatus, error)
+	ResumeRefresh(context.Context, *RefreshStatusRequest) (*RefreshStatus, error)
+	GetRefreshStatus(context.Context, *RefreshStatusRequest) (*RefreshStatus, error)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error)
+	InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error)
+	AddTags(context.Context, *TagsRequest) (*TagsResponse, error)
+	RemoveTags(context.Context, *TagsRequest) (*TagsResponse, error)
+	PutMetadata(context.Context, *PutMetadataRequest) (*PutMetadataResponse, error)
+	ImportMetadata(MetadataService_ImportMetadataServer) error
+	ImportSignatures(context.Context, *ImportSignaturesRequest) (*ImportSignaturesResponse, error)
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+	Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error)
+	ListDecodeMisses(context.Context, *ListDecodeMissesRequest) (*ListDecodeMissesResponse, error)
+	mustEmbedUnimplementedMetadataServiceServer()
+}
+
+// UnimplementedMetadataServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMetadataServiceServer struct {
+}
+
+func (UnimplementedMetadataServiceServer) Hello(context.Context, *HelloRequest) (*HelloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hello not implemented")
+}
+func (UnimplementedMetadataServiceServer) SubscribeOnMetadata(*SubscribeOnMetadataRequest, MetadataService_SubscribeOnMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOnMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFromMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) CommitOffset(context.Context, *CommitOffsetRequest) (*CommitOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOffset not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*Metadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByMethodSinature(context.Context, *GetMetadataByMethodSinatureRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByMethodSinature not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByTopic(context.Context, *GetMetadataByTopicRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByTopic not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByTopicsBatch(context.Context, *GetMetadataByTopicsBatchRequest) (*GetMetadataByTopicsBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByTopicsBatch not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataBySelectors(context.Context, *GetMetadataBySelectorsRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataBySelectors not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByErrorSelector(context.Context, *GetMetadataByErrorSelectorRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByErrorSelector not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByBytecodeHash(context.Context, *GetMetadataByBytecodeHashRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByBytecodeHash not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByCreator(context.Context, *GetMetadataByCreatorRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByCreator not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListMetadataByBlockRange(context.Context, *ListMetadataByBlockRangeRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataByBlockRange not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListIncompleteMetadata(context.Context, *ListIncompleteMetadataRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncompleteMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataByTag(context.Context, *GetMetadataByTagRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByTag not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListByFactory(context.Context, *ListByFactoryRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByFactory not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListSelectorSignatures(context.Context, *ListSelectorSignaturesRequest) (*ListSelectorSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSelectorSignatures not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetCoverageStats(context.Context, *GetCoverageStatsRequest) (*GetCoverageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoverageStats not implemented")
+}
+func (UnimplementedMetadataServiceServer) SampleMetadata(context.Context, *SampleMetadataRequest) (*SampleMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) FindSimilarContracts(context.Context, *FindSimilarContractsRequest) (*FindSimilarContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarContracts not implemented")
+}
+func (UnimplementedMetadataServiceServer) RefreshInterfaces(context.Context, *RefreshInterfacesRequest) (*RefreshInterfacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshInterfaces not implemented")
+}
+func (UnimplementedMetadataServiceServer) AnalyzeToken(context.Context, *AnalyzeTokenRequest) (*AnalyzeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeToken not implemented")
+}
+func (UnimplementedMetadataServiceServer) DecodeConstructorArgs(context.Context, *DecodeConstructorArgsRequest) (*DecodeConstructorArgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeConstructorArgs not implemented")
+}
+func (UnimplementedMetadataServiceServer) DecodeError(context.Context, *DecodeErrorRequest) (*DecodeErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeError not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetInputSchema(context.Context, *GetInputSchemaRequest) (*GetInputSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInputSchema not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetMetadataDecodeTable(context.Context, *GetMetadataDecodeTableRequest) (*GetMetadataDecodeTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataDecodeTable not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetTypedDataSchema(context.Context, *GetTypedDataSchemaRequest) (*GetTypedDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypedDataSchema not implemented")
+}
+func (UnimplementedMetadataServiceServer) Keccak256(context.Context, *Keccak256Request) (*Keccak256Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keccak256 not implemented")
+}
+func (UnimplementedMetadataServiceServer) ParseSignature(context.Context, *ParseSignatureRequest) (*ParseSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseSignature not implemented")
+}
+func (UnimplementedMetadataServiceServer) MatchTopic(context.Context, *MatchTopicRequest) (*MatchTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchTopic not implemented")
+}
+func (UnimplementedMetadataServiceServer) PauseRefresh(context.Context, *RefreshStatusRequest) (*RefreshStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRefresh not implemented")
+}
+func (UnimplementedMetadataServiceServer) ResumeRefresh(context.Context, *RefreshStatusRequest) (*RefreshStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRefresh not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetRefreshStatus(context.Context, *RefreshStatusRequest) (*RefreshStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefreshStatus not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedMetadataServiceServer) InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
+}
+func (UnimplementedMetadataServiceServer) AddTags(context.Context, *TagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemoveTags(context.Context, *TagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedMetadataServiceServer) PutMetadata(context.Context, *PutMetadataRequest) (*PutMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) ImportMetadata(MetadataService_ImportMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMetadata not implemented")
+}
+func (UnimplementedMetadataServiceServer) ImportSignatures(context.Context, *ImportSignaturesRequest) (*ImportSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSignatures not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedMetadataServiceServer) Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListDecodeMisses(context.Context, *ListDecodeMissesRequest) (*ListDecodeMissesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDecodeMisses not implemented")
+}
+func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
+
+// UnsafeMetadataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetadataServiceServer will
+// result in compilation errors.
+type UnsafeMetadataServiceServer interface {
+	mustEmbedUnimplementedMetadataServiceServer()
+}
+
+func RegisterMetadataServiceServer(s grpc.ServiceRegistrar, srv MetadataServiceServer) {
+	s.RegisterService(&MetadataService_ServiceDesc, srv)
+}
+
+func _MetadataService_Hello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Hello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/Hello",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Hello(ctx, req.(*HelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SubscribeOnMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOnMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceServer).SubscribeOnMetadata(m, &metadataServiceSubscribeOnMetadataServer{stream})
+}
+
+type MetadataService_SubscribeOnMetadataServer interface {
+	Send(*SubscriptionMetadata) error
+	grpc.ServerStream
+}
+
+type metadataServiceSubscribeOnMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataServiceSubscribeOnMetadataServer) Send(m *SubscriptionMetadata) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetadataService_UnsubscribeFromMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UnsubscribeFromMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/UnsubscribeFromMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UnsubscribeFromMetadata(ctx, req.(*pb.UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CommitOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CommitOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/CommitOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CommitOffset(ctx, req.(*CommitOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListMetadata(ctx, req.(*ListMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByMethodSinature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByMethodSinatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByMethodSinature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByMethodSinature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByMethodSinature(ctx, req.(*GetMetadataByMethodSinatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByTopic(ctx, req.(*GetMetadataByTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByTopicsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByTopicsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByTopicsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByTopicsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByTopicsBatch(ctx, req.(*GetMetadataByTopicsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataBySelectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataBySelectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataBySelectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataBySelectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataBySelectors(ctx, req.(*GetMetadataBySelectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByErrorSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByErrorSelectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByErrorSelector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByErrorSelector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByErrorSelector(ctx, req.(*GetMetadataByErrorSelectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByBytecodeHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByBytecodeHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByBytecodeHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByBytecodeHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByBytecodeHash(ctx, req.(*GetMetadataByBytecodeHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByCreator(ctx, req.(*GetMetadataByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListMetadataByBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataByBlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListMetadataByBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListMetadataByBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListMetadataByBlockRange(ctx, req.(*ListMetadataByBlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListIncompleteMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncompleteMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListIncompleteMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListIncompleteMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListIncompleteMetadata(ctx, req.(*ListIncompleteMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataByTag(ctx, req.(*GetMetadataByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListByFactory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByFactoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListByFactory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListByFactory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListByFactory(ctx, req.(*ListByFactoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListSelectorSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSelectorSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListSelectorSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListSelectorSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListSelectorSignatures(ctx, req.(*ListSelectorSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetCoverageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoverageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetCoverageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetCoverageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetCoverageStats(ctx, req.(*GetCoverageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SampleMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SampleMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/SampleMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SampleMetadata(ctx, req.(*SampleMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_FindSimilarContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).FindSimilarContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/FindSimilarContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).FindSimilarContracts(ctx, req.(*FindSimilarContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RefreshInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshInterfacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RefreshInterfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/RefreshInterfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RefreshInterfaces(ctx, req.(*RefreshInterfacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_AnalyzeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AnalyzeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/AnalyzeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AnalyzeToken(ctx, req.(*AnalyzeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DecodeConstructorArgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeConstructorArgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DecodeConstructorArgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/DecodeConstructorArgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DecodeConstructorArgs(ctx, req.(*DecodeConstructorArgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DecodeError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DecodeError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/DecodeError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DecodeError(ctx, req.(*DecodeErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetInputSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInputSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetInputSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetInputSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetInputSchema(ctx, req.(*GetInputSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetMetadataDecodeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataDecodeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadataDecodeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetMetadataDecodeTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadataDecodeTable(ctx, req.(*GetMetadataDecodeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetTypedDataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypedDataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetTypedDataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetTypedDataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetTypedDataSchema(ctx, req.(*GetTypedDataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_Keccak256_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Keccak256Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Keccak256(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/Keccak256",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Keccak256(ctx, req.(*Keccak256Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ParseSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ParseSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ParseSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ParseSignature(ctx, req.(*ParseSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_MatchTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).MatchTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/MatchTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).MatchTopic(ctx, req.(*MatchTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_PauseRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).PauseRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/PauseRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).PauseRefresh(ctx, req.(*RefreshStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ResumeRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ResumeRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ResumeRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ResumeRefresh(ctx, req.(*RefreshStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetRefreshStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetRefreshStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/GetRefreshStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetRefreshStatus(ctx, req.(*RefreshStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).InvalidateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/InvalidateCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).InvalidateCache(ctx, req.(*InvalidateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/AddTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AddTags(ctx, req.(*TagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/RemoveTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RemoveTags(ctx, req.(*TagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_PutMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).PutMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/PutMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).PutMetadata(ctx, req.(*PutMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ImportMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetadataServiceServer).ImportMetadata(&metadataServiceImportMetadataServer{stream})
+}
+
+type MetadataService_ImportMetadataServer interface {
+	Send(*ImportMetadataAck) error
+	Recv() (*ImportMetadataRequest, error)
+	grpc.ServerStream
+}
+
+type metadataServiceImportMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataServiceImportMetadataServer) Send(m *ImportMetadataAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metadataServiceImportMetadataServer) Recv() (*ImportMetadataRequest, error) {
+	m := new(ImportMetadataRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MetadataService_ImportSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ImportSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ImportSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ImportSignatures(ctx, req.(*ImportSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/Disconnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Disconnect(ctx, req.(*DisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListDecodeMisses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDecodeMissesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListDecodeMisses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataService/ListDecodeMisses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListDecodeMisses(ctx, req.(*ListDecodeMissesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // MetadataService_ServiceDesc is the grpc.ServiceDesc for MetadataService service.
@@ -282,10 +1592,22 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.MetadataService",
 	HandlerType: (*MetadataServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hello",
+			Handler:    _MetadataService_Hello_Handler,
+		},
 		{
 			MethodName: "UnsubscribeFromMetadata",
 			Handler:    _MetadataService_UnsubscribeFromMetadata_Handler,
 		},
+		{
+			MethodName: "CommitOffset",
+			Handler:    _MetadataService_CommitOffset_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _MetadataService_ListSubscriptions_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _MetadataService_GetMetadata_Handler,
@@ -302,6 +1624,150 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetadataByTopic",
 			Handler:    _MetadataService_GetMetadataByTopic_Handler,
 		},
+		{
+			MethodName: "GetMetadataByTopicsBatch",
+			Handler:    _MetadataService_GetMetadataByTopicsBatch_Handler,
+		},
+		{
+			MethodName: "GetMetadataBySelectors",
+			Handler:    _MetadataService_GetMetadataBySelectors_Handler,
+		},
+		{
+			MethodName: "GetMetadataByErrorSelector",
+			Handler:    _MetadataService_GetMetadataByErrorSelector_Handler,
+		},
+		{
+			MethodName: "GetMetadataByBytecodeHash",
+			Handler:    _MetadataService_GetMetadataByBytecodeHash_Handler,
+		},
+		{
+			MethodName: "GetMetadataByCreator",
+			Handler:    _MetadataService_GetMetadataByCreator_Handler,
+		},
+		{
+			MethodName: "ListMetadataByBlockRange",
+			Handler:    _MetadataService_ListMetadataByBlockRange_Handler,
+		},
+		{
+			MethodName: "ListIncompleteMetadata",
+			Handler:    _MetadataService_ListIncompleteMetadata_Handler,
+		},
+		{
+			MethodName: "GetMetadataByTag",
+			Handler:    _MetadataService_GetMetadataByTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _MetadataService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListByFactory",
+			Handler:    _MetadataService_ListByFactory_Handler,
+		},
+		{
+			MethodName: "ListSelectorSignatures",
+			Handler:    _MetadataService_ListSelectorSignatures_Handler,
+		},
+		{
+			MethodName: "GetCoverageStats",
+			Handler:    _MetadataService_GetCoverageStats_Handler,
+		},
+		{
+			MethodName: "SampleMetadata",
+			Handler:    _MetadataService_SampleMetadata_Handler,
+		},
+		{
+			MethodName: "FindSimilarContracts",
+			Handler:    _MetadataService_FindSimilarContracts_Handler,
+		},
+		{
+			MethodName: "RefreshInterfaces",
+			Handler:    _MetadataService_RefreshInterfaces_Handler,
+		},
+		{
+			MethodName: "AnalyzeToken",
+			Handler:    _MetadataService_AnalyzeToken_Handler,
+		},
+		{
+			MethodName: "DecodeConstructorArgs",
+			Handler:    _MetadataService_DecodeConstructorArgs_Handler,
+		},
+		{
+			MethodName: "DecodeError",
+			Handler:    _MetadataService_DecodeError_Handler,
+		},
+		{
+			MethodName: "GetInputSchema",
+			Handler:    _MetadataService_GetInputSchema_Handler,
+		},
+		{
+			MethodName: "GetMetadataDecodeTable",
+			Handler:    _MetadataService_GetMetadataDecodeTable_Handler,
+		},
+		{
+			MethodName: "GetTypedDataSchema",
+			Handler:    _MetadataService_GetTypedDataSchema_Handler,
+		},
+		{
+			MethodName: "Keccak256",
+			Handler:    _MetadataService_Keccak256_Handler,
+		},
+		{
+			MethodName: "ParseSignature",
+			Handler:    _MetadataService_ParseSignature_Handler,
+		},
+		{
+			MethodName: "MatchTopic",
+			Handler:    _MetadataService_MatchTopic_Handler,
+		},
+		{
+			MethodName: "PauseRefresh",
+			Handler:    _MetadataService_PauseRefresh_Handler,
+		},
+		{
+			MethodName: "ResumeRefresh",
+			Handler:    _MetadataService_ResumeRefresh_Handler,
+		},
+		{
+			MethodName: "GetRefreshStatus",
+			Handler:    _MetadataService_GetRefreshStatus_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _MetadataService_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "InvalidateCache",
+			Handler:    _MetadataService_InvalidateCache_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _MetadataService_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _MetadataService_RemoveTags_Handler,
+		},
+		{
+			MethodName: "PutMetadata",
+			Handler:    _MetadataService_PutMetadata_Handler,
+		},
+		{
+			MethodName: "ImportSignatures",
+			Handler:    _MetadataService_ImportSignatures_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _MetadataService_ListClients_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _MetadataService_Disconnect_Handler,
+		},
+		{
+			MethodName: "ListDecodeMisses",
+			Handler:    _MetadataService_ListDecodeMisses_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -309,6 +1775,12 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MetadataService_SubscribeOnMetadata_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImportMetadata",
+			Handler:       _MetadataService_ImportMetadata_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "github.com/dipdup-net/abi-indexer/pkg/modules/grpc/proto/metadata.proto",
 }
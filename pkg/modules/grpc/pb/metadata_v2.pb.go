@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.13.0
+// source: github.com/dipdup-net/abi-indexer/pkg/modules/grpc/proto/metadata_v2.proto
+
+package pb
+
+import (
+	pb "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMetadataV2Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	FollowFactory bool                   `protobuf:"varint,3,opt,name=follow_factory,json=followFactory,proto3" json:"follow_factory,omitempty"`
+	IfNoneMatch   string                 `protobuf:"bytes,4,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+}
+
+func (x *GetMetadataV2Request) Reset() {
+	*x = GetMetadataV2Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataV2Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataV2Request) ProtoMessage() {}
+
+func (x *GetMetadataV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataV2Request.ProtoReflect.Descriptor instead.
+func (*GetMetadataV2Request) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMetadataV2Request) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetMetadataV2Request) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *GetMetadataV2Request) GetFollowFactory() bool {
+	if x != nil {
+		return x.FollowFactory
+	}
+	return false
+}
+
+func (x *GetMetadataV2Request) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+type MetadataEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain    string    `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Metadata *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MetadataEnvelope) Reset() {
+	*x = MetadataEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataEnvelope) ProtoMessage() {}
+
+func (x *MetadataEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataEnvelope.ProtoReflect.Descriptor instead.
+func (*MetadataEnvelope) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MetadataEnvelope) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *MetadataEnvelope) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListMetadataV2Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit        uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order        pb.SortOrder           `protobuf:"varint,2,opt,name=order,proto3,enum=proto.SortOrder" json:"order,omitempty"`
+	Cursor       string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	OnlyComplete bool                   `protobuf:"varint,4,opt,name=only_complete,json=onlyComplete,proto3" json:"only_complete,omitempty"`
+	UpdatedSince int64                  `protobuf:"varint,5,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	FieldMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	Proxy        ProxyFilter            `protobuf:"varint,7,opt,name=proxy,proto3,enum=proto.ProxyFilter" json:"proxy,omitempty"`
+}
+
+func (x *ListMetadataV2Request) Reset() {
+	*x = ListMetadataV2Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetadataV2Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataV2Request) ProtoMessage() {}
+
+func (x *ListMetadataV2Request) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataV2Request.ProtoReflect.Descriptor instead.
+func (*ListMetadataV2Request) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMetadataV2Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMetadataV2Request) GetOrder() pb.SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return pb.SortOrder(0)
+}
+
+func (x *ListMetadataV2Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMetadataV2Request) GetOnlyComplete() bool {
+	if x != nil {
+		return x.OnlyComplete
+	}
+	return false
+}
+
+func (x *ListMetadataV2Request) GetUpdatedSince() int64 {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return 0
+}
+
+func (x *ListMetadataV2Request) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *ListMetadataV2Request) GetProxy() ProxyFilter {
+	if x != nil {
+		return x.Proxy
+	}
+	return ProxyFilter_WITH_PROXIES
+}
+
+type ListMetadataV2Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain      string      `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Metadata   []*Metadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	NextCursor string      `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListMetadataV2Response) Reset() {
+	*x = ListMetadataV2Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMetadataV2Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataV2Response) ProtoMessage() {}
+
+func (x *ListMetadataV2Response) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataV2Response.ProtoReflect.Descriptor instead.
+func (*ListMetadataV2Response) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMetadataV2Response) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ListMetadataV2Response) GetMetadata() []*Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ListMetadataV2Response) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type MetadataEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain          string    `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	SubscriptionId uint64    `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Metadata       *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SentAt         int64     `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	EventId        uint64    `protobuf:"varint,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (x *MetadataEvent) Reset() {
+	*x = MetadataEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataEvent) ProtoMessage() {}
+
+func (x *MetadataEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataEvent.ProtoReflect.Descriptor instead.
+func (*MetadataEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MetadataEvent) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *MetadataEvent) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *MetadataEvent) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MetadataEvent) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+func (x *MetadataEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+var File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto protoreflect.FileDescriptor
+
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDesc = []byte{
+	0x0a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70,
+	0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x69, 0x70, 0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x47, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x70, 0x64, 0x75, 0x70, 0x2d, 0x6e,
+	0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x55, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f,
+	0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x7c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x32, 0xc9, 0x02, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x50, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x50, 0x0a,
+	0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x69, 0x70, 0x64, 0x75, 0x70, 0x2d, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x62, 0x69, 0x2d, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescOnce sync.Once
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescData = file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDesc
+)
+
+func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescGZIP() []byte {
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescOnce.Do(func() {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescData)
+	})
+	return file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDescData
+}
+
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_goTypes = []interface{}{
+	(*GetMetadataV2Request)(nil),       // 0: proto.GetMetadataV2Request
+	(*MetadataEnvelope)(nil),           // 1: proto.MetadataEnvelope
+	(*ListMetadataV2Request)(nil),      // 2: proto.ListMetadataV2Request
+	(*ListMetadataV2Response)(nil),     // 3: proto.ListMetadataV2Response
+	(*MetadataEvent)(nil),              // 4: proto.MetadataEvent
+	(*fieldmaskpb.FieldMask)(nil),      // 5: google.protobuf.FieldMask
+	(*Metadata)(nil),                   // 6: proto.Metadata
+	(pb.SortOrder)(0),                  // 7: proto.SortOrder
+	(ProxyFilter)(0),                   // 8: proto.ProxyFilter
+	(*SubscribeOnMetadataRequest)(nil), // 9: proto.SubscribeOnMetadataRequest
+	(*pb.UnsubscribeRequest)(nil),      // 10: proto.UnsubscribeRequest
+	(*pb.UnsubscribeResponse)(nil),     // 11: proto.UnsubscribeResponse
+}
+var file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_depIdxs = []int32{
+	5,  // 0: proto.GetMetadataV2Request.field_mask:type_name -> google.protobuf.FieldMask
+	6,  // 1: proto.MetadataEnvelope.metadata:type_name -> proto.Metadata
+	7,  // 2: proto.ListMetadataV2Request.order:type_name -> proto.SortOrder
+	5,  // 3: proto.ListMetadataV2Request.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 4: proto.ListMetadataV2Request.proxy:type_name -> proto.ProxyFilter
+	6,  // 5: proto.ListMetadataV2Response.metadata:type_name -> proto.Metadata
+	6,  // 6: proto.MetadataEvent.metadata:type_name -> proto.Metadata
+	9,  // 7: proto.MetadataServiceV2.SubscribeOnMetadata:input_type -> proto.SubscribeOnMetadataRequest
+	10, // 8: proto.MetadataServiceV2.UnsubscribeFromMetadata:input_type -> proto.UnsubscribeRequest
+	0,  // 9: proto.MetadataServiceV2.GetMetadata:input_type -> proto.GetMetadataV2Request
+	2,  // 10: proto.MetadataServiceV2.ListMetadata:input_type -> proto.ListMetadataV2Request
+	4,  // 11: proto.MetadataServiceV2.SubscribeOnMetadata:output_type -> proto.MetadataEvent
+	11, // 12: proto.MetadataServiceV2.UnsubscribeFromMetadata:output_type -> proto.UnsubscribeResponse
+	1,  // 13: proto.MetadataServiceV2.GetMetadata:output_type -> proto.MetadataEnvelope
+	3,  // 14: proto.MetadataServiceV2.ListMetadata:output_type -> proto.ListMetadataV2Response
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_init() }
+func file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_init() {
+	if File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto != nil {
+		return
+	}
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataV2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataV2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMetadataV2Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_goTypes,
+		DependencyIndexes: file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_depIdxs,
+		MessageInfos:      file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_msgTypes,
+	}.Build()
+	File_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto = out.File
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_rawDesc = nil
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_goTypes = nil
+	file_github_com_dipdup_net_abi_indexer_pkg_modules_grpc_proto_metadata_v2_proto_depIdxs = nil
+}
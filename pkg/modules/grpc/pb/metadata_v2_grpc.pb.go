@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.13.0
+// source: github.com/dipdup-net/abi-indexer/pkg/modules/grpc/proto/metadata_v2.proto
+
+package pb
+
+import (
+	context "context"
+	pb "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MetadataServiceV2Client is the client API for MetadataServiceV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetadataServiceV2Client interface {
+	SubscribeOnMetadata(ctx context.Context, in *SubscribeOnMetadataRequest, opts ...grpc.CallOption) (MetadataServiceV2_SubscribeOnMetadataClient, error)
+	UnsubscribeFromMetadata(ctx context.Context, in *pb.UnsubscribeRequest, opts ...grpc.CallOption) (*pb.UnsubscribeResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataV2Request, opts ...grpc.CallOption) (*MetadataEnvelope, error)
+	ListMetadata(ctx context.Context, in *ListMetadataV2Request, opts ...grpc.CallOption) (*ListMetadataV2Response, error)
+}
+
+type metadataServiceV2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetadataServiceV2Client(cc grpc.ClientConnInterface) MetadataServiceV2Client {
+	return &metadataServiceV2Client{cc}
+}
+
+func (c *metadataServiceV2Client) SubscribeOnMetadata(ctx context.Context, in *SubscribeOnMetadataRequest, opts ...grpc.CallOption) (MetadataServiceV2_SubscribeOnMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MetadataServiceV2_ServiceDesc.Streams[0], "/proto.MetadataServiceV2/SubscribeOnMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metadataServiceV2SubscribeOnMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MetadataServiceV2_SubscribeOnMetadataClient interface {
+	Recv() (*MetadataEvent, error)
+	grpc.ClientStream
+}
+
+type metadataServiceV2SubscribeOnMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *metadataServiceV2SubscribeOnMetadataClient) Recv() (*MetadataEvent, error) {
+	m := new(MetadataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metadataServiceV2Client) UnsubscribeFromMetadata(ctx context.Context, in *pb.UnsubscribeRequest, opts ...grpc.CallOption) (*pb.UnsubscribeResponse, error) {
+	out := new(pb.UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/proto.MetadataServiceV2/UnsubscribeFromMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceV2Client) GetMetadata(ctx context.Context, in *GetMetadataV2Request, opts ...grpc.CallOption) (*MetadataEnvelope, error) {
+	out := new(MetadataEnvelope)
+	err := c.cc.Invoke(ctx, "/proto.MetadataServiceV2/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceV2Client) ListMetadata(ctx context.Context, in *ListMetadataV2Request, opts ...grpc.CallOption) (*ListMetadataV2Response, error) {
+	out := new(ListMetadataV2Response)
+	err := c.cc.Invoke(ctx, "/proto.MetadataServiceV2/ListMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetadataServiceV2Server is the server API for MetadataServiceV2 service.
+// All implementations must embed UnimplementedMetadataServiceV2Server
+// for forward compatibility
+type MetadataServiceV2Server interface {
+	SubscribeOnMetadata(*SubscribeOnMetadataRequest, MetadataServiceV2_SubscribeOnMetadataServer) error
+	UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error)
+	GetMetadata(context.Context, *GetMetadataV2Request) (*MetadataEnvelope, error)
+	ListMetadata(context.Context, *ListMetadataV2Request) (*ListMetadataV2Response, error)
+	mustEmbedUnimplementedMetadataServiceV2Server()
+}
+
+// UnimplementedMetadataServiceV2Server must be embedded to have forward compatible implementations.
+type UnimplementedMetadataServiceV2Server struct {
+}
+
+func (UnimplementedMetadataServiceV2Server) SubscribeOnMetadata(*SubscribeOnMetadataRequest, MetadataServiceV2_SubscribeOnMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOnMetadata not implemented")
+}
+func (UnimplementedMetadataServiceV2Server) UnsubscribeFromMetadata(context.Context, *pb.UnsubscribeRequest) (*pb.UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeFromMetadata not implemented")
+}
+func (UnimplementedMetadataServiceV2Server) GetMetadata(context.Context, *GetMetadataV2Request) (*MetadataEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedMetadataServiceV2Server) ListMetadata(context.Context, *ListMetadataV2Request) (*ListMetadataV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+}
+func (UnimplementedMetadataServiceV2Server) mustEmbedUnimplementedMetadataServiceV2Server() {}
+
+// UnsafeMetadataServiceV2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetadataServiceV2Server will
+// result in compilation errors.
+type UnsafeMetadataServiceV2Server interface {
+	mustEmbedUnimplementedMetadataServiceV2Server()
+}
+
+func RegisterMetadataServiceV2Server(s grpc.ServiceRegistrar, srv MetadataServiceV2Server) {
+	s.RegisterService(&MetadataServiceV2_ServiceDesc, srv)
+}
+
+func _MetadataServiceV2_SubscribeOnMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOnMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServiceV2Server).SubscribeOnMetadata(m, &metadataServiceV2SubscribeOnMetadataServer{stream})
+}
+
+type MetadataServiceV2_SubscribeOnMetadataServer interface {
+	Send(*MetadataEvent) error
+	grpc.ServerStream
+}
+
+type metadataServiceV2SubscribeOnMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataServiceV2SubscribeOnMetadataServer) Send(m *MetadataEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetadataServiceV2_UnsubscribeFromMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceV2Server).UnsubscribeFromMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataServiceV2/UnsubscribeFromMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceV2Server).UnsubscribeFromMetadata(ctx, req.(*pb.UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataServiceV2_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceV2Server).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataServiceV2/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceV2Server).GetMetadata(ctx, req.(*GetMetadataV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataServiceV2_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceV2Server).ListMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.MetadataServiceV2/ListMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceV2Server).ListMetadata(ctx, req.(*ListMetadataV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetadataServiceV2_ServiceDesc is the grpc.ServiceDesc for MetadataServiceV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetadataServiceV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.MetadataServiceV2",
+	HandlerType: (*MetadataServiceV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UnsubscribeFromMetadata",
+			Handler:    _MetadataServiceV2_UnsubscribeFromMetadata_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _MetadataServiceV2_GetMetadata_Handler,
+		},
+		{
+			MethodName: "ListMetadata",
+			Handler:    _MetadataServiceV2_ListMetadata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOnMetadata",
+			Handler:       _MetadataServiceV2_SubscribeOnMetadata_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/dipdup-net/abi-indexer/pkg/modules/grpc/proto/metadata_v2.proto",
+}
@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"github.com/dipdup-net/go-lib/prometheus"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// metric names
+const (
+	MetricResultCacheLookups = "abi_indexer_result_cache_lookups_total"
+)
+
+// resultCacheSize - maximum count of cached responses of one method. New responses aren't cached if all entries are alive.
+const resultCacheSize = 1024
+
+type cachedResult struct {
+	response  proto.Message
+	expiresAt time.Time
+}
+
+// methodResults - cached responses of one method keyed by serialized request
+type methodResults struct {
+	ttl     time.Duration
+	entries map[string]cachedResult
+	mx      *sync.Mutex
+}
+
+func (r *methodResults) get(key string, now time.Time) (proto.Message, bool) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(entry.expiresAt) {
+		delete(r.entries, key)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (r *methodResults) set(key string, response proto.Message, now time.Time) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if len(r.entries) >= resultCacheSize {
+		for k, entry := range r.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(r.entries, k)
+			}
+		}
+		if len(r.entries) >= resultCacheSize {
+			return
+		}
+	}
+	r.entries[key] = cachedResult{response, now.Add(r.ttl)}
+}
+
+// resultCacheInterceptor - serves repeated identical requests of expensive methods from memory during TTL of the method. Key is full name of method with deterministic serialization of request, so requests differing by any field, e.g. by page, are cached separately. Responses are shared by all clients.
+type resultCacheInterceptor struct {
+	methods map[string]*methodResults
+	metrics *prometheus.Service
+}
+
+func newResultCacheInterceptor(ttls map[string]int, metrics *prometheus.Service) (*resultCacheInterceptor, error) {
+	unary := make(map[string]struct{})
+	for _, method := range pb.MetadataService_ServiceDesc.Methods {
+		unary[method.MethodName] = struct{}{}
+	}
+
+	interceptor := &resultCacheInterceptor{
+		methods: make(map[string]*methodResults, len(ttls)),
+		metrics: metrics,
+	}
+	for method, ttl := range ttls {
+		if _, ok := unary[method]; !ok {
+			return nil, errors.Errorf("result of unknown or stream method can't be cached: %s", method)
+		}
+		if _, ok := adminMethods[method]; ok {
+			return nil, errors.Errorf("result of admin method can't be cached: %s", method)
+		}
+		if method == "Hello" {
+			return nil, errors.New("result of Hello method can't be cached")
+		}
+		interceptor.methods[method] = &methodResults{
+			ttl:     time.Duration(ttl) * time.Second,
+			entries: make(map[string]cachedResult),
+			mx:      new(sync.Mutex),
+		}
+	}
+
+	if metrics != nil && len(interceptor.methods) > 0 {
+		metrics.RegisterCounter(MetricResultCacheLookups, "count of lookups of cached responses", "method", "result")
+	}
+	return interceptor, nil
+}
+
+// Unary -
+func (interceptor *resultCacheInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := methodName(info.FullMethod)
+	results, ok := interceptor.methods[method]
+	// response read from primary shouldn't be older than TTL of cached one
+	if !ok || isPrimaryRead(ctx) {
+		return handler(ctx, req)
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return handler(ctx, req)
+	}
+	// services have methods with the same names, so full name is a part of key
+	key := info.FullMethod + "\x00" + string(data)
+
+	if response, ok := results.get(key, time.Now()); ok {
+		interceptor.count(method, "hit")
+		return proto.Clone(response), nil
+	}
+	interceptor.count(method, "miss")
+
+	response, err := handler(ctx, req)
+	if err != nil {
+		return response, err
+	}
+	if cacheable, ok := response.(proto.Message); ok {
+		results.set(key, proto.Clone(cacheable), time.Now())
+	}
+	return response, nil
+}
+
+func (interceptor *resultCacheInterceptor) count(method, result string) {
+	if interceptor.metrics != nil {
+		interceptor.metrics.IncrementCounter(MetricResultCacheLookups, map[string]string{
+			"method": method,
+			"result": result,
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// recentWrites - addresses which were written to primary storage recently. Reads of such addresses are routed to primary because replica may lag.
+type recentWrites struct {
+	window    time.Duration
+	addresses map[string]time.Time
+	mx        sync.Mutex
+}
+
+func newRecentWrites(windowSeconds int) *recentWrites {
+	return &recentWrites{
+		window:    time.Duration(windowSeconds) * time.Second,
+		addresses: make(map[string]time.Time),
+	}
+}
+
+// Add -
+func (rw *recentWrites) Add(address string) {
+	if rw.window == 0 {
+		return
+	}
+
+	rw.mx.Lock()
+	defer rw.mx.Unlock()
+
+	now := time.Now()
+	for addr, writtenAt := range rw.addresses {
+		if now.Sub(writtenAt) > rw.window {
+			delete(rw.addresses, addr)
+		}
+	}
+	rw.addresses[strings.ToLower(address)] = now
+}
+
+// Contains - returns true if address was written during the window
+func (rw *recentWrites) Contains(address string) bool {
+	if rw.window == 0 {
+		return false
+	}
+
+	rw.mx.Lock()
+	defer rw.mx.Unlock()
+
+	writtenAt, ok := rw.addresses[strings.ToLower(address)]
+	return ok && time.Since(writtenAt) <= rw.window
+}
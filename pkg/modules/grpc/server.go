@@ -1,53 +1,286 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/dipdup-net/abi-indexer/internal/cache"
+	"github.com/dipdup-net/abi-indexer/internal/publisher"
 	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/metadata"
+	"github.com/dipdup-net/go-lib/prometheus"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/grpc"
 	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	sdkStorage "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	maxSelectorsCount          = 100
+	maxTagsCount               = 16
+	maxTopicsCount             = 100
+	defaultTopicEventsLimit    = 10
+	maxTopicEventsLimit        = 100
+	defaultSelectorSignatures  = 100
+	maxSelectorSignaturesLimit = 1000
+	defaultSampleSize          = 10
+	defaultGetMetadataTimeout  = 10 * time.Second
+	maxSampleSize              = 100
+	defaultMinSimilarity       = 0.8
+	defaultSimilarLimit        = 10
+	maxSimilarLimit            = 100
+	maxImportSignatures        = 10000
+	defaultMaxOffset           = 100000
+)
+
+// Indexer - interface of metadata indexer which can be managed by server
+type Indexer interface {
+	RefreshInterfaces(ctx context.Context, address string) ([]string, error)
+	AnalyzeToken(ctx context.Context, address string) (*evm.TokenAnalysis, error)
+	DecodeConstructorArgs(ctx context.Context, repo storage.IMetadata, address string, data []byte) ([]evm.Argument, error)
+	DecodeError(ctx context.Context, repo storage.IMetadata, address string, data []byte) (*evm.DecodedError, error)
+	InputSchema(ctx context.Context, repo storage.IMetadata, address string, selector []byte) (*evm.MethodSchema, error)
+	PutMetadata(ctx context.Context, address string, data []byte, merge bool, ifMatch []byte) (*metadata.PutResult, error)
+	ImportSignatures(ctx context.Context, lines []string, kind storage.SignatureKind) (metadata.ImportResult, error)
+	ResolveFactory(ctx context.Context, address string) (string, error)
+	TrackQuery(address string)
+	PauseRefresh() error
+	ResumeRefresh() error
+	RefreshStatus() metadata.RefreshStatus
+	CodeCheckStatus() metadata.CodeCheckStatus
+	SetMaintenance(enabled, shedWrites bool) metadata.Maintenance
+	Maintenance() metadata.Maintenance
+	Indexed(index metadata.Index) bool
+	Candidates(ctx context.Context, address string) ([]*storage.Candidate, error)
+}
+
 // Server -
 type Server struct {
-	*grpc.Server
 	pb.UnimplementedMetadataServiceServer
 
-	input *modules.Input
+	bind   string
+	server *gogrpc.Server
+	input  *modules.Input
 
 	metadata              storage.IMetadata
+	methods               storage.IMethod
+	events                storage.IEvent
+	primary               storage.IMetadata
+	recentWrites          *recentWrites
 	metadataSubscriptions *grpc.Subscriptions[*storage.Metadata, *pb.SubscriptionMetadata]
+	activeSubscriptions   *activeSubscriptions
+	connections           *connections
+	journal               *eventJournal
+	offsets               *committedOffsets
+	publisher             *publisher.Async
+	cache                 *cachedMetadata
+	standby               *standbyMetadata
+	freshness             *freshness
+	readiness             *readiness
+	coverage              *coverage
+	excludedSelectors     *excludedSelectors
+	decodeMisses          *decodeMisses
+	v2                    *serverV2
+	indexer               Indexer
+	admin                 *adminInterceptor
+	disabled              *disabledInterceptor
+	consistency           *consistencyInterceptor
+	sampleMethod          storage.SampleMethod
+	getMetadataTimeout    time.Duration
+	pageLimits            *pageLimits
+	maxOffset             uint64
+	defaultOrder          sdkStorage.SortOrder
+	replayOverflow        ReplayOverflowPolicy
+	metrics               *prometheus.Service
 
 	wg *sync.WaitGroup
 }
 
 // NewServer -
 func NewServer(
-	cfg *grpc.ServerConfig,
+	cfg *ServerConfig,
 	metadataRepo storage.IMetadata,
+	methodsRepo storage.IMethod,
+	eventsRepo storage.IEvent,
+	primaryRepo storage.IMetadata,
+	indexer Indexer,
+	metrics *prometheus.Service,
 ) (*Server, error) {
 	if cfg == nil {
 		return nil, errors.New("configuration structure of gRPC server is nil")
 	}
 
-	server, err := grpc.NewServer(cfg)
+	logs, err := newLogInterceptor(cfg.Log)
+	if err != nil {
+		return nil, err
+	}
+	admin := newAdminInterceptor(cfg.AdminToken)
+	disabled, err := newDisabledInterceptor(cfg.DisabledMethods)
+	if err != nil {
+		return nil, err
+	}
+	results, err := newResultCacheInterceptor(cfg.ResultCache, metrics)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := newExcludedSelectors(cfg.ExcludedMethods)
+	if err != nil {
+		return nil, err
+	}
+	pageLimits, err := newPageLimits(cfg.MaxLimit, cfg.MaxLimits)
 	if err != nil {
 		return nil, err
 	}
+	maintenance := newMaintenanceInterceptor(indexer)
+	consistency, err := newConsistencyInterceptor(cfg.PrimaryReads, primaryRepo != nil && primaryRepo != metadataRepo && cfg.Standby == nil, metrics)
+	if err != nil {
+		return nil, err
+	}
+	unary := []gogrpc.UnaryServerInterceptor{methodUnaryInterceptor, logs.Unary, disabled.Unary, admin.Unary, maintenance.Unary, consistency.Unary, results.Unary}
+	stream := []gogrpc.StreamServerInterceptor{methodStreamInterceptor, logs.Stream, disabled.Stream, admin.Stream, maintenance.Stream}
+	if cfg.MaxInFlight > 0 || metrics != nil {
+		unary = append(unary, newInFlightLimiter(cfg.MaxInFlight, metrics).Unary)
+	}
+	if metrics != nil {
+		durations := newDurationInterceptor()
+		unary = append(unary, durations.Unary)
+		stream = append(stream, durations.Stream)
+	}
 
-	return &Server{
-		Server:                server,
+	module := &Server{
+		bind: cfg.Bind,
+		server: gogrpc.NewServer(
+			gogrpc.KeepaliveParams(
+				keepalive.ServerParameters{
+					Time:    20 * time.Second,
+					Timeout: 10 * time.Second,
+				},
+			),
+			gogrpc.KeepaliveEnforcementPolicy(
+				keepalive.EnforcementPolicy{
+					MinTime:             10 * time.Second,
+					PermitWithoutStream: true,
+				},
+			),
+			gogrpc.ChainUnaryInterceptor(unary...),
+			gogrpc.ChainStreamInterceptor(stream...),
+		),
 		input:                 modules.NewInput(metadata.OutputMetadata),
 		metadataSubscriptions: grpc.NewSubscriptions[*storage.Metadata, *pb.SubscriptionMetadata](),
+		activeSubscriptions:   newActiveSubscriptions(),
+		connections:           newConnections(),
+		journal:               newEventJournal(journalSize),
+		offsets:               newCommittedOffsets(),
 		metadata:              metadataRepo,
+		methods:               methodsRepo,
+		events:                eventsRepo,
+		primary:               primaryRepo,
+		recentWrites:          newRecentWrites(cfg.ReadAfterWriteWindow),
+		coverage:              newCoverage(methodsRepo),
+		excludedSelectors:     excluded,
+		decodeMisses:          newDecodeMisses(cfg.DecodeMisses),
+		indexer:               indexer,
+		admin:                 admin,
+		disabled:              disabled,
+		consistency:           consistency,
+		sampleMethod:          storage.SampleApproximate,
+		getMetadataTimeout:    defaultGetMetadataTimeout,
+		pageLimits:            pageLimits,
+		maxOffset:             defaultMaxOffset,
+		defaultOrder:          sdkStorage.SortOrderAsc,
+		replayOverflow:        ReplayOverflowThrottle,
+		metrics:               metrics,
 		wg:                    new(sync.WaitGroup),
-	}, nil
+	}
+
+	if metrics != nil {
+		module.registerMetrics()
+	}
+
+	// latest write is read from primary, so lag of replica isn't reported as lag of ingestion
+	if primaryRepo != nil {
+		module.freshness = newFreshness(primaryRepo, metrics)
+	} else {
+		module.freshness = newFreshness(metadataRepo, metrics)
+	}
+
+	if cfg.GetMetadataTimeout > 0 {
+		module.getMetadataTimeout = time.Duration(cfg.GetMetadataTimeout) * time.Millisecond
+	}
+
+	if cfg.MaxOffset > 0 {
+		module.maxOffset = cfg.MaxOffset
+	}
+
+	if cfg.DefaultOrder != "" {
+		module.defaultOrder = cfg.DefaultOrder
+	}
+
+	if cfg.ReplayOverflow != "" {
+		module.replayOverflow = cfg.ReplayOverflow
+	}
+
+	if cfg.SampleMethod != "" {
+		module.sampleMethod = cfg.SampleMethod
+	}
+
+	if cfg.Standby != nil {
+		module.standby = newStandbyMetadata(metadataRepo, *cfg.Standby, metrics)
+		module.metadata = module.standby
+	}
+
+	// storage is pinged directly: primary is checked if it's set, because writes of indexer go to it
+	if primaryRepo != nil {
+		module.readiness = newReadiness(primaryRepo, module.standby, cfg.Health, metrics)
+	} else {
+		module.readiness = newReadiness(metadataRepo, module.standby, cfg.Health, metrics)
+	}
+
+	if cfg.Cache != nil && (cfg.Cache.Memory != nil || cfg.Cache.Redis != nil) {
+		var (
+			memory *cache.Memory
+			shared *cache.Resilient
+		)
+		if cfg.Cache.Memory != nil {
+			memory = cache.NewMemory(*cfg.Cache.Memory)
+		}
+		if cfg.Cache.Redis != nil {
+			shared = cache.NewResilient(cache.NewRedis(*cfg.Cache.Redis), "redis", *cfg.Cache, metrics)
+		}
+		module.cache = newCachedMetadata(module.metadata, *cfg.Cache, memory, shared)
+		module.metadata = module.cache
+	}
+
+	if cfg.V2 != nil {
+		module.v2 = newServerV2(module, *cfg.V2)
+	}
+
+	if cfg.Publisher != nil {
+		pub, err := publisher.Factory(*cfg.Publisher)
+		if err != nil {
+			return nil, err
+		}
+		module.publisher = publisher.NewAsync(pub, cfg.Publisher.BufferSize, metrics)
+	}
+
+	return module, nil
 }
 
 // Name -
@@ -75,12 +308,69 @@ func (server *Server) AttachTo(name string, input *modules.Input) error {
 
 // Start -
 func (server *Server) Start(ctx context.Context) {
-	pb.RegisterMetadataServiceServer(server.Server.Server(), server)
+	pb.RegisterMetadataServiceServer(server.server, server)
+	healthpb.RegisterHealthServer(server.server, server.readiness.health)
+	if server.v2 != nil {
+		pb.RegisterMetadataServiceV2Server(server.server, server.v2)
+	}
 
-	server.Server.Start(ctx)
+	if server.publisher != nil {
+		server.publisher.Start(ctx)
+	}
+
+	server.wg.Add(1)
+	go server.serve()
 
 	server.wg.Add(1)
 	go server.listen(ctx)
+
+	server.wg.Add(1)
+	go func() {
+		defer server.wg.Done()
+		server.freshness.run(ctx)
+	}()
+
+	server.wg.Add(1)
+	go func() {
+		defer server.wg.Done()
+		server.readiness.run(ctx)
+	}()
+
+	if server.cache != nil {
+		server.wg.Add(1)
+		go func() {
+			defer server.wg.Done()
+			server.cache.listen(ctx)
+		}()
+	}
+
+	if server.standby != nil {
+		server.wg.Add(1)
+		go func() {
+			defer server.wg.Done()
+			server.standby.sync(ctx)
+		}()
+	}
+
+	if server.metrics != nil {
+		server.wg.Add(1)
+		go server.reportSubscriptions(ctx)
+	}
+}
+
+func (server *Server) serve() {
+	defer server.wg.Done()
+
+	log.Info().Str("bind", server.bind).Msg("running grpc...")
+
+	listener, err := net.Listen("tcp", server.bind)
+	if err != nil {
+		log.Err(err).Msg("net.Listen")
+		return
+	}
+	if err := server.server.Serve(server.connections.Listener(listener)); err != nil {
+		log.Err(err).Msg("grpcServer.Serve")
+	}
 }
 
 func (server *Server) listen(ctx context.Context) {
@@ -94,35 +384,111 @@ func (server *Server) listen(ctx context.Context) {
 			if !ok {
 				return
 			}
-			model, ok := msg.(storage.Metadata)
-			if !ok {
-				continue
+			switch typ := msg.(type) {
+			case *storage.Metadata:
+				server.notify(ctx, typ, publisher.EventTypeCreate)
+			case *metadata.Updated:
+				server.notify(ctx, typ.Metadata, publisher.EventTypeUpdate)
 			}
-			server.metadataSubscriptions.NotifyAll(&model, SubscriptionMetadata)
 		}
 	}
 }
 
+func (server *Server) notify(ctx context.Context, model *storage.Metadata, eventType publisher.EventType) {
+	server.recentWrites.Add(model.Contract)
+	server.freshness.Observe(model.UpdatedAt)
+	if server.standby != nil {
+		server.standby.Put(model)
+	}
+	if server.cache != nil {
+		server.cache.Invalidate(ctx, model.Contract)
+	}
+	server.publish(model)
+
+	if server.publisher != nil {
+		server.publisher.Publish(publisher.Event{
+			Type:       eventType,
+			Address:    model.Contract,
+			Metadata:   model.Metadata,
+			JSONSchema: model.JSONSchema,
+		})
+	}
+}
+
 // Close -
 func (server *Server) Close() error {
 	if err := server.input.Close(); err != nil {
 		return err
 	}
-	return server.Server.Close()
+	server.readiness.Shutdown()
+	server.server.Stop()
+	server.wg.Wait()
+
+	if server.cache != nil {
+		if err := server.cache.Close(); err != nil {
+			return err
+		}
+	}
+
+	if server.publisher != nil {
+		return server.publisher.Close()
+	}
+	return nil
+}
+
+// reader - returns storage which should be used to read the contract. Recently written contracts are put to standby snapshot on write, so they aren't routed to primary in standby mode.
+func (server *Server) reader(ctx context.Context, address string) storage.IMetadata {
+	if server.standby == nil && server.primary != nil && server.recentWrites.Contains(address) {
+		return server.primary
+	}
+	return server.store(ctx)
 }
 
 ////////////////////////////////////////////////
 //////////////    HANDLERS    //////////////////
 ////////////////////////////////////////////////
 
+// Hello - issues identifier which client should pass in `x-client-id` metadata of the next requests and describes session: server version, supported methods, enabled features, limits and authenticated principal
+func (server *Server) Hello(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
+	id := req.ClientId
+	if id != "" {
+		if err := server.claimClientID(ctx, id); err != nil {
+			return nil, err
+		}
+	} else {
+		generated, err := newClientID(req.Name)
+		if err != nil {
+			log.Err(err).Str("peer", peerAddress(ctx)).Msg("client id isn't issued")
+			return nil, status.Error(codes.Unavailable, "client id can't be generated")
+		}
+		id = generated
+	}
+	return &pb.HelloResponse{
+		ClientId:      id,
+		ServerTime:    time.Now().Unix(),
+		ServerVersion: Version,
+		Methods:       server.enabledMethods(),
+		Features:      server.features(),
+		Limits:        server.limits(),
+		Principal:     server.principal(ctx),
+		SnapshotAge:   server.snapshotAge(),
+		LatestUpdate:  server.latestUpdate(),
+		IngestionLag:  int64(server.freshness.Lag().Seconds()),
+		Maintenance:   MaintenanceMode(server.indexer.Maintenance()),
+	}, nil
+}
+
 // SubscribeOnMetadata -
-func (server *Server) SubscribeOnMetadata(req *generalPB.DefaultRequest, stream pb.MetadataService_SubscribeOnMetadataServer) error {
-	return grpc.DefaultSubscribeOn[*storage.Metadata, *pb.SubscriptionMetadata](
-		stream,
-		server.metadataSubscriptions,
-		NewMetadataSubscription(),
-		nil,
-	)
+func (server *Server) SubscribeOnMetadata(req *pb.SubscribeOnMetadataRequest, stream pb.MetadataService_SubscribeOnMetadataServer) error {
+	iface, err := interfaceName(req.Interface)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.Name) > maxSubscriptionName {
+		return status.Errorf(codes.InvalidArgument, "subscription name should be at most %d characters", maxSubscriptionName)
+	}
+	subscription := NewMetadataSubscription(clientID(stream.Context()), peerAddress(stream.Context()), iface)
+	return server.subscribeOn(stream, subscription, req)
 }
 
 // UnsubscribeFromMetadata -
@@ -130,51 +496,1159 @@ func (server *Server) UnsubscribeFromMetadata(ctx context.Context, req *generalP
 	return grpc.DefaultUnsubscribe(ctx, server.metadataSubscriptions, req.Id)
 }
 
+// CommitOffset - saves id of the latest event which is processed by consumer of named subscription. Offset belongs to client which is identified by `x-client-id`, so commit without it is rejected.
+func (server *Server) CommitOffset(ctx context.Context, req *pb.CommitOffsetRequest) (*pb.CommitOffsetResponse, error) {
+	id := passedClientID(ctx)
+	switch {
+	case id == "":
+		return nil, status.Errorf(codes.InvalidArgument, "%s metadata is required to commit offset", clientIDHeader)
+	case req.Name == "":
+		return nil, status.Error(codes.InvalidArgument, "empty subscription name")
+	case len(req.Name) > maxSubscriptionName:
+		return nil, status.Errorf(codes.InvalidArgument, "subscription name should be at most %d characters", maxSubscriptionName)
+	case req.EventId == 0:
+		return nil, status.Error(codes.InvalidArgument, "empty event id")
+	}
+	if lastID := server.journal.LastID(); req.EventId > lastID {
+		return nil, status.Errorf(codes.InvalidArgument, "event %d isn't issued yet: the latest event is %d", req.EventId, lastID)
+	}
+	return &pb.CommitOffsetResponse{
+		EventId: server.offsets.Commit(id, req.Name, req.EventId),
+	}, nil
+}
+
+// ListSubscriptions -
+func (server *Server) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	return ListSubscriptionsResponse(server.activeSubscriptions.Stats()), nil
+}
+
+// ListClients -
+func (server *Server) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	return ListClientsResponse(server.connectedClients()), nil
+}
+
+// ListDecodeMisses - returns selectors which weren't found in stored ABI by decode requests, the most frequent first
+func (server *Server) ListDecodeMisses(ctx context.Context, req *pb.ListDecodeMissesRequest) (*pb.ListDecodeMissesResponse, error) {
+	if req.Address != "" && !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+	}
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = defaultDecodeMissesLimit
+	case limit > maxDecodeMissesLimit:
+		limit = maxDecodeMissesLimit
+	}
+	return server.decodeMisses.List(req.Address, int(limit)), nil
+}
+
+// Disconnect -
+func (server *Server) Disconnect(ctx context.Context, req *pb.DisconnectRequest) (*pb.DisconnectResponse, error) {
+	if req.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty client id")
+	}
+
+	result, err := server.disconnect(req.ClientId)
+	if err != nil {
+		log.Err(err).Str("client", req.ClientId).Msg("closing client connection")
+	}
+	if result.Subscriptions == 0 {
+		return nil, status.Errorf(codes.NotFound, "client %s doesn't have active subscriptions", req.ClientId)
+	}
+
+	log.Warn().
+		Str("client", req.ClientId).
+		Str("by", clientID(ctx)).
+		Int("subscriptions", result.Subscriptions).
+		Int("connections", result.Connections).
+		Msg("client was disconnected by admin")
+
+	return &pb.DisconnectResponse{
+		Subscriptions: uint64(result.Subscriptions),
+		Connections:   uint64(result.Connections),
+	}, nil
+}
+
 // GetMetadata -
 func (server *Server) GetMetadata(ctx context.Context, req *pb.GetMetadataRequest) (*pb.Metadata, error) {
-	reqCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+	mask, err := newFieldMask(req.FieldMask)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if mask.Has(selectorsField) {
+		if err := server.requireIndex(metadata.IndexMethods); err != nil {
+			return nil, err
+		}
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout(ctx, server.getMetadataTimeout))
 	defer cancel()
 
-	metadata, err := server.metadata.GetByAddress(reqCtx, req.Address)
+	metadata, err := server.reader(reqCtx, req.Address).GetByAddress(reqCtx, req.Address, mask.Columns()...)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, storageError(err)
+	}
+
+	var inheritedFrom string
+	if req.FollowFactory && (metadata == nil || !metadata.IsComplete) {
+		template, inheritErr := server.factoryTemplate(reqCtx, req.Address, metadata)
+		if inheritErr != nil {
+			return nil, storageError(inheritErr)
+		}
+		if template != nil {
+			metadata = template
+			inheritedFrom = template.Contract
+		}
+	}
+	if metadata == nil {
+		return nil, storageError(err)
+	}
+	server.indexer.TrackQuery(metadata.Contract)
+
+	var response *pb.Metadata
+	if notModified(metadata, req) {
+		response = NotModifiedMetadata(metadata)
+	} else {
+		response = Metadata(metadata)
+	}
+	if inheritedFrom != "" {
+		response.Address = checksum(req.Address)
+		response.InheritedFrom = checksum(inheritedFrom)
+	}
+
+	if req.Format == pb.AbiFormat_ABIGEN && len(response.Metadata) > 0 {
+		bindABI, err := evm.BindABI(response.Metadata)
+		if err != nil {
+			log.Err(err).Str("address", metadata.Contract).Msg("converting ABI to abigen format")
+			return nil, status.Error(codes.Internal, "ABI can't be converted to abigen format")
+		}
+		response.Metadata = bindABI
+	}
+
+	if mask.Has(selectorsField) && !response.NotModified {
+		selectors, err := server.selectors(reqCtx, []*storage.Metadata{metadata})
+		if err != nil {
+			return nil, storageError(err)
+		}
+		response.Selectors = selectors[metadata.ID]
+	}
+	if mask.Has(candidatesField) && !response.NotModified && inheritedFrom == "" {
+		candidates, err := server.indexer.Candidates(reqCtx, metadata.Contract)
+		if err != nil {
+			return nil, candidatesError(err)
+		}
+		response.Candidates = AbiCandidates(candidates)
+	}
+	mask.Apply(response)
+
+	return response, nil
+}
+
+// selectors - returns hex-encoded method selectors of each metadata by its id
+func (server *Server) selectors(ctx context.Context, metadata []*storage.Metadata) (map[uint64][]string, error) {
+	ids := make([]uint64, len(metadata))
+	for i := range metadata {
+		ids[i] = metadata[i].ID
+	}
+	methods, err := server.methods.SelectorsByMetadata(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	return Metadata(metadata), nil
+	selectors := make(map[uint64][]string, len(metadata))
+	for i := range methods {
+		selectors[methods[i].MetadataID] = append(selectors[methods[i].MetadataID], hexutil.Encode(methods[i].SignatureID))
+	}
+	return selectors, nil
+}
+
+// factoryTemplate - returns the latest complete metadata of contract deployed by the same factory. Nil is returned if factory is unknown or it has no such children.
+func (server *Server) factoryTemplate(ctx context.Context, address string, metadata *storage.Metadata) (*storage.Metadata, error) {
+	var factory string
+	if metadata != nil && metadata.Factory != "" {
+		factory = metadata.Factory
+	} else {
+		resolved, err := server.indexer.ResolveFactory(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		factory = resolved
+	}
+	if factory == "" {
+		return nil, nil
+	}
+
+	children, err := server.store(ctx).ListByFactory(ctx, factory, storage.MetadataFilter{OnlyComplete: true}, 1, 0, sdkStorage.SortOrderDesc)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) == 0 {
+		return nil, nil
+	}
+	return children[0], nil
 }
 
 // ListMetadata -
 func (server *Server) ListMetadata(ctx context.Context, req *pb.ListMetadataRequest) (*pb.ListMetadataResponse, error) {
-	p := newPage(req.GetPage())
+	mask, err := newFieldMask(req.FieldMask)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if mask.Has(selectorsField) {
+		if err := server.requireIndex(metadata.IndexMethods); err != nil {
+			return nil, err
+		}
+	}
+	if mask.Has(candidatesField) {
+		return nil, status.Error(codes.InvalidArgument, "candidates are returned by GetMetadata only")
+	}
 
-	metadata, err := server.metadata.List(ctx, p.limit, p.offset, p.order)
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		CompilerVersion:  req.CompilerVersion,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+	if req.UpdatedSince > 0 {
+		filter.UpdatedSince = time.Unix(req.UpdatedSince, 0)
+	}
+
+	var cursor *listCursor
+	if req.Consistent || req.Cursor != "" {
+		c, err := server.listCursor(ctx, req.Cursor, p)
+		if err != nil {
+			return nil, err
+		}
+		if c.snapshot == 0 {
+			return ListMetadataResponse(nil, p), nil
+		}
+		c.Apply(&filter)
+		cursor = &c
+	}
+
+	metadata, err := server.store(ctx).ListByFilter(ctx, filter, p.limit, p.offset, p.order, mask.Columns()...)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	response := ListMetadataResponse(metadata, p)
+	if cursor != nil && uint64(len(metadata)) == p.limit {
+		cursor.lastID = metadata[len(metadata)-1].ID
+		response.NextCursor = cursor.String()
+	}
+	if mask.Has(selectorsField) {
+		selectors, err := server.selectors(ctx, metadata)
+		if err != nil {
+			return nil, storageError(err)
+		}
+		for i := range metadata {
+			response.Metadata[i].Selectors = selectors[metadata[i].ID]
+		}
+	}
+	for i := range response.Metadata {
+		mask.Apply(response.Metadata[i])
+	}
+	return response, nil
+}
+
+// listCursor - returns cursor of consistent pagination. If cursor isn't passed it's the first page and the snapshot id is taken from storage. Offset is ignored and order is taken from cursor.
+func (server *Server) listCursor(ctx context.Context, value string, p *page) (listCursor, error) {
+	p.offset = 0
+
+	if value == "" {
+		snapshot, err := server.store(ctx).LastID(ctx)
+		if err != nil {
+			return listCursor{}, storageError(err)
+		}
+		return listCursor{order: p.order, snapshot: snapshot}, nil
 	}
 
-	return ListMetadataResponse(metadata), nil
+	cursor, err := parseListCursor(value)
+	if err != nil {
+		return cursor, status.Error(codes.InvalidArgument, err.Error())
+	}
+	p.order = cursor.order
+	return cursor, nil
 }
 
 // GetMetadataByMethodSinature -
 func (server *Server) GetMetadataByMethodSinature(ctx context.Context, req *pb.GetMetadataByMethodSinatureRequest) (*pb.ListMetadataResponse, error) {
-	p := newPage(req.GetPage())
+	if err := server.requireIndex(metadata.IndexMethods); err != nil {
+		return nil, err
+	}
 
-	metadata, err := server.metadata.GetByMethod(ctx, req.Signature, p.limit, p.offset, p.order)
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByMethod(ctx, req.Signature, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
 	}
 
-	return ListMetadataResponse(metadata), nil
+	return ListMetadataResponse(metadata, p), nil
 }
 
 // GetMetadataByTopic -
 func (server *Server) GetMetadataByTopic(ctx context.Context, req *pb.GetMetadataByTopicRequest) (*pb.ListMetadataResponse, error) {
-	p := newPage(req.GetPage())
+	if err := server.requireIndex(metadata.IndexEvents); err != nil {
+		return nil, err
+	}
+
+	indexed, err := indexedTopics(req.Indexed)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByTopic(ctx, req.Topic, indexed, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// GetMetadataByErrorSelector -
+func (server *Server) GetMetadataByErrorSelector(ctx context.Context, req *pb.GetMetadataByErrorSelectorRequest) (*pb.ListMetadataResponse, error) {
+	if err := server.requireIndex(metadata.IndexErrors); err != nil {
+		return nil, err
+	}
+
+	selector, err := hexutil.Decode(req.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector %s: %s", req.Selector, err.Error())
+	}
+	if len(selector) != 4 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector %s: it should be 4 bytes", req.Selector)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByErrorSelector(ctx, selector, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// GetMetadataByBytecodeHash -
+func (server *Server) GetMetadataByBytecodeHash(ctx context.Context, req *pb.GetMetadataByBytecodeHashRequest) (*pb.ListMetadataResponse, error) {
+	hash, err := hexutil.Decode(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bytecode hash %s: %s", req.Hash, err.Error())
+	}
+	if len(hash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bytecode hash %s: it should be 32 bytes", req.Hash)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByCodeHash(ctx, hash, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// GetMetadataByTopicsBatch -
+func (server *Server) GetMetadataByTopicsBatch(ctx context.Context, req *pb.GetMetadataByTopicsBatchRequest) (*pb.GetMetadataByTopicsBatchResponse, error) {
+	if err := server.requireIndex(metadata.IndexEvents); err != nil {
+		return nil, err
+	}
+
+	if len(req.Topics) == 0 || len(req.Topics) > maxTopicsCount {
+		return nil, status.Errorf(codes.InvalidArgument, "topics count should be between 1 and %d", maxTopicsCount)
+	}
+
+	topics := make([][]byte, 0, len(req.Topics))
+	unique := make(map[string]struct{}, len(req.Topics))
+	for i := range req.Topics {
+		topic, err := hexutil.Decode(req.Topics[i])
+		if err != nil || len(topic) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid topic: %s", req.Topics[i])
+		}
+		if _, ok := unique[string(topic)]; ok {
+			continue
+		}
+		unique[string(topic)] = struct{}{}
+		topics = append(topics, topic)
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = defaultTopicEventsLimit
+	case limit > maxTopicEventsLimit:
+		limit = maxTopicEventsLimit
+	}
 
-	metadata, err := server.metadata.GetByTopic(ctx, req.Topic, p.limit, p.offset, p.order)
+	events, err := server.events.GetByTopics(ctx, topics, limit)
 	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return GetMetadataByTopicsBatchResponse(topics, events), nil
+}
+
+// GetMetadataBySelectors -
+func (server *Server) GetMetadataBySelectors(ctx context.Context, req *pb.GetMetadataBySelectorsRequest) (*pb.ListMetadataResponse, error) {
+	if err := server.requireIndex(metadata.IndexMethods); err != nil {
 		return nil, err
 	}
 
-	return ListMetadataResponse(metadata), nil
+	if len(req.Selectors) == 0 || len(req.Selectors) > maxSelectorsCount {
+		return nil, status.Errorf(codes.InvalidArgument, "selectors count should be between 1 and %d", maxSelectorsCount)
+	}
+
+	selectors := make([][]byte, 0, len(req.Selectors))
+	unique := make(map[string]struct{}, len(req.Selectors))
+	for i := range req.Selectors {
+		selector, err := hexutil.Decode(req.Selectors[i])
+		if err != nil || len(selector) != 4 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid selector: %s", req.Selectors[i])
+		}
+		if _, ok := unique[string(selector)]; ok {
+			continue
+		}
+		unique[string(selector)] = struct{}{}
+		selectors = append(selectors, selector)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetBySelectors(ctx, selectors, matchType(req.Match), filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// GetMetadataByCreator -
+func (server *Server) GetMetadataByCreator(ctx context.Context, req *pb.GetMetadataByCreatorRequest) (*pb.ListMetadataResponse, error) {
+	if !common.IsHexAddress(req.Creator) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", req.Creator)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByCreator(ctx, req.Creator, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// ListMetadataByBlockRange -
+func (server *Server) ListMetadataByBlockRange(ctx context.Context, req *pb.ListMetadataByBlockRangeRequest) (*pb.ListMetadataResponse, error) {
+	if req.ToBlock > 0 && req.FromBlock > req.ToBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block range: %d-%d", req.FromBlock, req.ToBlock)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).ListByBlockRange(ctx, req.FromBlock, req.ToBlock, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// ListIncompleteMetadata - lists metadata without full ABI ordered by time of the last fetch attempt
+func (server *Server) ListIncompleteMetadata(ctx context.Context, req *pb.ListIncompleteMetadataRequest) (*pb.ListMetadataResponse, error) {
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := server.store(ctx).ListIncomplete(ctx, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// GetMetadataByTag -
+func (server *Server) GetMetadataByTag(ctx context.Context, req *pb.GetMetadataByTagRequest) (*pb.ListMetadataResponse, error) {
+	tags, err := tagNames(req.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).GetByTags(ctx, tags, matchType(req.Match), filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// ListTags -
+func (server *Server) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	tags, err := server.store(ctx).ListTags(ctx)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return ListTagsResponse(tags), nil
+}
+
+// ListByFactory -
+func (server *Server) ListByFactory(ctx context.Context, req *pb.ListByFactoryRequest) (*pb.ListMetadataResponse, error) {
+	if !common.IsHexAddress(req.Factory) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid factory address: %s", req.Factory)
+	}
+
+	p, err := newPage(req.GetPage(), req.GetOrderSet(), server.pageLimits.FromContext(ctx), server.maxOffset, server.defaultOrder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := storage.MetadataFilter{
+		OnlyComplete:     req.OnlyComplete,
+		IsProxy:          proxyFilter(req.Proxy),
+		WithoutDestroyed: req.ExcludeDestroyed,
+	}
+
+	metadata, err := server.store(ctx).ListByFactory(ctx, req.Factory, filter, p.limit, p.offset, p.order)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return ListMetadataResponse(metadata, p), nil
+}
+
+// RefreshInterfaces -
+func (server *Server) RefreshInterfaces(ctx context.Context, req *pb.RefreshInterfacesRequest) (*pb.RefreshInterfacesResponse, error) {
+	interfaces, err := server.indexer.RefreshInterfaces(ctx, req.Address)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.RefreshInterfacesResponse{
+		Interfaces: interfaces,
+	}, nil
+}
+
+// AnalyzeToken -
+func (server *Server) AnalyzeToken(ctx context.Context, req *pb.AnalyzeTokenRequest) (*pb.AnalyzeTokenResponse, error) {
+	analysis, err := server.indexer.AnalyzeToken(ctx, req.Address)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return AnalyzeTokenResponse(analysis), nil
+}
+
+// DecodeConstructorArgs -
+func (server *Server) DecodeConstructorArgs(ctx context.Context, req *pb.DecodeConstructorArgsRequest) (*pb.DecodeConstructorArgsResponse, error) {
+	var data []byte
+	if req.Data != "" && req.Data != "0x" {
+		decoded, err := hexutil.Decode(req.Data)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid data: %s", err.Error())
+		}
+		data = decoded
+	}
+
+	args, err := server.indexer.DecodeConstructorArgs(ctx, server.reader(ctx, req.Address), req.Address, data)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	response, err := DecodeConstructorArgsResponse(args)
+	if err != nil {
+		return nil, err
+	}
+	response.CodeStatus = server.codeStatus(ctx, req.Address)
+	return response, nil
+}
+
+// DecodeError -
+func (server *Server) DecodeError(ctx context.Context, req *pb.DecodeErrorRequest) (*pb.DecodeErrorResponse, error) {
+	data, err := hexutil.Decode(req.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid data: %s", err.Error())
+	}
+
+	decoded, err := server.indexer.DecodeError(ctx, server.reader(ctx, req.Address), req.Address, data)
+	if err != nil {
+		if errors.Is(err, evm.ErrUnknownSelector) && len(data) >= 4 {
+			server.decodeMisses.Record(req.Address, data[:4], pb.SignatureKind_ERROR)
+		}
+		return nil, decodeError(err)
+	}
+
+	response, err := DecodeErrorResponse(decoded)
+	if err != nil {
+		return nil, err
+	}
+	response.CodeStatus = server.codeStatus(ctx, req.Address)
+	return response, nil
+}
+
+// GetInputSchema -
+func (server *Server) GetInputSchema(ctx context.Context, req *pb.GetInputSchemaRequest) (*pb.GetInputSchemaResponse, error) {
+	selector, err := hexutil.Decode(req.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector: %s", err.Error())
+	}
+	if len(selector) != 4 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector length: %s", req.Selector)
+	}
+
+	schema, err := server.indexer.InputSchema(ctx, server.reader(ctx, req.Address), req.Address, selector)
+	if err != nil {
+		if errors.Is(err, evm.ErrUnknownSelector) {
+			server.decodeMisses.Record(req.Address, selector, pb.SignatureKind_FUNCTION)
+		}
+		return nil, decodeError(err)
+	}
+
+	return &pb.GetInputSchemaResponse{
+		Name:       schema.Name,
+		Signature:  schema.Signature,
+		Schema:     schema.Schema,
+		CodeStatus: server.codeStatus(ctx, req.Address),
+	}, nil
+}
+
+// codeStatus - returns result of the last code check of decoded contract, so clients can be warned that it doesn't have code anymore. Status isn't read if code check is disabled or address is empty, e.g. for standard errors. Read failure isn't an error of decoding, so status is unchecked then.
+func (server *Server) codeStatus(ctx context.Context, address string) pb.CodeStatus {
+	if address == "" || !server.indexer.CodeCheckStatus().Enabled {
+		return pb.CodeStatus_UNCHECKED
+	}
+	model, err := server.reader(ctx, address).GetByAddress(ctx, address, storage.MetadataLightColumns...)
+	if err != nil {
+		return pb.CodeStatus_UNCHECKED
+	}
+	return CodeStatus(model.CodeStatus)
+}
+
+// GetMetadataDecodeTable - returns stored ABI of the contract with its entries keyed by selectors and topics
+func (server *Server) GetMetadataDecodeTable(ctx context.Context, req *pb.GetMetadataDecodeTableRequest) (*pb.GetMetadataDecodeTableResponse, error) {
+	metadata, err := server.reader(ctx, req.Address).GetByAddress(ctx, req.Address)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	server.indexer.TrackQuery(metadata.Contract)
+
+	response := &pb.GetMetadataDecodeTableResponse{
+		Address:   checksum(metadata.Contract),
+		Metadata:  metadata.Metadata,
+		Hash:      hash(metadata),
+		Functions: make(map[string]*pb.DecodeTableEntry),
+		Events:    make(map[string]*pb.DecodeTableEntry),
+		Errors:    make(map[string]*pb.DecodeTableEntry),
+	}
+	if len(metadata.Metadata) == 0 {
+		return response, nil
+	}
+
+	table, err := evm.NewDecodeTable(metadata.Metadata)
+	if err != nil {
+		log.Err(err).Str("address", metadata.Contract).Msg("building decode table")
+		return nil, status.Error(codes.Internal, "decode table of ABI can't be built")
+	}
+	response.Functions = DecodeTableEntries(table.Functions)
+	response.Events = DecodeTableEntries(table.Events)
+	response.Errors = DecodeTableEntries(table.Errors)
+	return response, nil
+}
+
+// GetTypedDataSchema - returns struct types of stored ABI of the contract in the format of EIP-712
+func (server *Server) GetTypedDataSchema(ctx context.Context, req *pb.GetTypedDataSchemaRequest) (*pb.GetTypedDataSchemaResponse, error) {
+	metadata, err := server.reader(ctx, req.Address).GetByAddress(ctx, req.Address)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	server.indexer.TrackQuery(metadata.Contract)
+
+	response := &pb.GetTypedDataSchemaResponse{
+		Address:   checksum(metadata.Contract),
+		Types:     make(map[string]*pb.TypedDataStruct),
+		TypesJson: []byte("{}"),
+	}
+	if len(metadata.Metadata) == 0 {
+		return response, nil
+	}
+
+	schema, err := evm.NewTypedDataSchema(metadata.Metadata)
+	if err != nil {
+		log.Err(err).Str("address", metadata.Contract).Msg("building typed data schema")
+		return nil, status.Error(codes.Internal, "typed data schema of ABI can't be built")
+	}
+	typesJSON, err := json.Marshal(schema.Types)
+	if err != nil {
+		log.Err(err).Str("address", metadata.Contract).Msg("encoding typed data schema")
+		return nil, status.Error(codes.Internal, "typed data schema of ABI can't be built")
+	}
+	response.Types = TypedDataStructs(schema.Types)
+	response.TypesJson = typesJSON
+	response.DomainGetter = schema.DomainGetter
+	response.Warnings = schema.Warnings
+	return response, nil
+}
+
+// Keccak256 - returns keccak256 hash of arbitrary text
+func (server *Server) Keccak256(ctx context.Context, req *pb.Keccak256Request) (*pb.Keccak256Response, error) {
+	if req.Text == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty text")
+	}
+	return &pb.Keccak256Response{
+		Hash: hexutil.Encode(evm.Keccak256([]byte(req.Text))),
+	}, nil
+}
+
+// ParseSignature - parses human-readable signature to JSON ABI entry
+func (server *Server) ParseSignature(ctx context.Context, req *pb.ParseSignatureRequest) (*pb.ParseSignatureResponse, error) {
+	if req.Signature == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty signature")
+	}
+	fragment, err := evm.ParseFragment(req.Signature)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &pb.ParseSignatureResponse{
+		Type:      fragment.Type,
+		Name:      fragment.Name,
+		Signature: fragment.Signature.Text,
+		Fragment:  fragment.Entry,
+	}
+	if fragment.Type == evm.FragmentEvent {
+		response.Topic = hexutil.Encode(fragment.Signature.Topic)
+	} else {
+		response.Selector = hexutil.Encode(fragment.Signature.Selector)
+	}
+	return response, nil
+}
+
+// MatchTopic - compares topic with topic of event signature. If signature is empty, event signatures with the topic are looked up in indexed contracts.
+func (server *Server) MatchTopic(ctx context.Context, req *pb.MatchTopicRequest) (*pb.MatchTopicResponse, error) {
+	topic, err := hexutil.Decode(req.Topic)
+	if err != nil || len(topic) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid topic: %s", req.Topic)
+	}
+
+	if req.Signature != "" {
+		fragment, err := evm.ParseFragment(req.Signature)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &pb.MatchTopicResponse{
+			Matched:       bytes.Equal(fragment.Signature.Topic, topic),
+			ComputedTopic: hexutil.Encode(fragment.Signature.Topic),
+			Signature:     fragment.Signature.Text,
+		}, nil
+	}
+
+	if err := server.requireIndex(metadata.IndexEvents); err != nil {
+		return nil, err
+	}
+	events, err := server.events.GetByTopics(ctx, [][]byte{topic}, maxTopicEventsLimit)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	unique := make(map[string]struct{})
+	signatures := make([]string, 0)
+	for i := range events {
+		// logs of anonymous events don't have signature topic
+		if events[i].Anonymous {
+			continue
+		}
+		if _, ok := unique[events[i].Signature]; ok {
+			continue
+		}
+		unique[events[i].Signature] = struct{}{}
+		signatures = append(signatures, events[i].Signature)
+	}
+	sort.Strings(signatures)
+
+	return &pb.MatchTopicResponse{
+		Matched:         len(signatures) > 0,
+		KnownSignatures: signatures,
+	}, nil
+}
+
+// ListSelectorSignatures -
+func (server *Server) ListSelectorSignatures(ctx context.Context, req *pb.ListSelectorSignaturesRequest) (*pb.ListSelectorSignaturesResponse, error) {
+	if err := server.requireIndex(metadata.IndexMethods); err != nil {
+		return nil, err
+	}
+
+	cursor, err := parseSelectorCursor(req.Cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	maxLimit := server.pageLimits.FromContext(ctx)
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSelectorSignatures
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	items, err := server.methods.ListSelectorSignatures(ctx, cursor.selector, cursor.signature, cursor.kind, limit)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	response := ListSelectorSignaturesResponse(items, limit)
+	response.MaxLimit = maxLimit
+	if req.ExcludeSystem {
+		response.Items = server.excludedSelectors.Filter(response.Items)
+		response.ExcludedSelectors = server.excludedSelectors.list
+	}
+	return response, nil
+}
+
+// GetCoverageStats - returns size of reverse lookup corpus. Stats are computed by full scan of lookup tables, so they're cached for a minute.
+func (server *Server) GetCoverageStats(ctx context.Context, req *pb.GetCoverageStatsRequest) (*pb.GetCoverageStatsResponse, error) {
+	stats, computedAt, err := server.coverage.Get(ctx)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return GetCoverageStatsResponse(stats, computedAt), nil
+}
+
+// SampleMetadata -
+func (server *Server) SampleMetadata(ctx context.Context, req *pb.SampleMetadataRequest) (*pb.SampleMetadataResponse, error) {
+	count := req.Count
+	switch {
+	case count == 0:
+		count = defaultSampleSize
+	case count > maxSampleSize:
+		count = maxSampleSize
+	}
+
+	metadata, err := server.store(ctx).Sample(ctx, count, server.sampleMethod)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return SampleMetadataResponse(metadata), nil
+}
+
+// FindSimilarContracts -
+func (server *Server) FindSimilarContracts(ctx context.Context, req *pb.FindSimilarContractsRequest) (*pb.FindSimilarContractsResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+	}
+
+	minSimilarity := req.MinSimilarity
+	switch {
+	case minSimilarity == 0:
+		minSimilarity = defaultMinSimilarity
+	case minSimilarity < 0 || minSimilarity > 1:
+		return nil, status.Errorf(codes.InvalidArgument, "min similarity should be between 0 and 1: %v", minSimilarity)
+	}
+	if minSimilarity < 1 {
+		if err := server.requireIndex(metadata.IndexMethods, metadata.IndexEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = defaultSimilarLimit
+	case limit > maxSimilarLimit:
+		limit = maxSimilarLimit
+	}
+
+	metadata, err := server.store(ctx).GetByAddress(ctx, req.Address, storage.MetadataLightColumns...)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	similar, err := server.store(ctx).FindSimilar(ctx, metadata, minSimilarity, limit)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return FindSimilarContractsResponse(metadata.Fingerprint, similar), nil
+}
+
+// PauseRefresh -
+func (server *Server) PauseRefresh(ctx context.Context, req *pb.RefreshStatusRequest) (*pb.RefreshStatus, error) {
+	if err := server.indexer.PauseRefresh(); err != nil {
+		return nil, refreshError(err)
+	}
+	return RefreshStatus(server.indexer.RefreshStatus()), nil
+}
+
+// ResumeRefresh -
+func (server *Server) ResumeRefresh(ctx context.Context, req *pb.RefreshStatusRequest) (*pb.RefreshStatus, error) {
+	if err := server.indexer.ResumeRefresh(); err != nil {
+		return nil, refreshError(err)
+	}
+	return RefreshStatus(server.indexer.RefreshStatus()), nil
+}
+
+// GetRefreshStatus -
+func (server *Server) GetRefreshStatus(ctx context.Context, req *pb.RefreshStatusRequest) (*pb.RefreshStatus, error) {
+	return RefreshStatus(server.indexer.RefreshStatus()), nil
+}
+
+// SetMaintenanceMode - enables or disables maintenance mode of indexer
+func (server *Server) SetMaintenanceMode(ctx context.Context, req *pb.SetMaintenanceModeRequest) (*pb.MaintenanceMode, error) {
+	return MaintenanceMode(server.indexer.SetMaintenance(req.Enabled, req.ShedWrites)), nil
+}
+
+// InvalidateCache - evicts the contract or all contracts if address is empty from cache
+func (server *Server) InvalidateCache(ctx context.Context, req *pb.InvalidateCacheRequest) (*pb.InvalidateCacheResponse, error) {
+	if server.cache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "cache is disabled")
+	}
+
+	if req.Address != "" {
+		evicted := server.cache.Invalidate(ctx, req.Address)
+		return &pb.InvalidateCacheResponse{Evicted: uint64(evicted)}, nil
+	}
+
+	evicted, err := server.cache.InvalidateAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "shared cache is not purged: %s", err.Error())
+	}
+	log.Info().Int("evicted", evicted).Msg("cache is purged")
+	return &pb.InvalidateCacheResponse{Evicted: uint64(evicted)}, nil
+}
+
+// AddTags - adds curated tags to the contract
+func (server *Server) AddTags(ctx context.Context, req *pb.TagsRequest) (*pb.TagsResponse, error) {
+	tags, err := tagNames(req.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := server.metadata.AddTags(ctx, req.Address, tags)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	server.tagsChanged(ctx, req.Address)
+
+	return &pb.TagsResponse{Tags: result}, nil
+}
+
+// RemoveTags - removes curated tags from the contract
+func (server *Server) RemoveTags(ctx context.Context, req *pb.TagsRequest) (*pb.TagsResponse, error) {
+	tags, err := tagNames(req.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := server.metadata.RemoveTags(ctx, req.Address, tags)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	server.tagsChanged(ctx, req.Address)
+
+	return &pb.TagsResponse{Tags: result}, nil
+}
+
+// PutMetadata - saves ABI of the contract passed by caller. ABI is overwritten or merged with stored one if `merge` is set. If `if_match` is set, ABI is saved only if stored metadata has this hash.
+func (server *Server) PutMetadata(ctx context.Context, req *pb.PutMetadataRequest) (*pb.PutMetadataResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+	}
+	if len(req.Metadata) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty metadata")
+	}
+	var ifMatch []byte
+	if req.IfMatch != "" {
+		decoded, err := hexutil.Decode(req.IfMatch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid if_match: %s", req.IfMatch)
+		}
+		ifMatch = decoded
+	}
+
+	result, err := server.indexer.PutMetadata(ctx, req.Address, req.Metadata, req.Merge, ifMatch)
+	if err != nil {
+		return nil, putError(err)
+	}
+	server.decodeMisses.Forget(result.Metadata.Contract)
+
+	return &pb.PutMetadataResponse{
+		Metadata:  Metadata(result.Metadata),
+		Conflicts: result.Conflicts,
+		Unchanged: result.Unchanged,
+	}, nil
+}
+
+// ImportMetadata - saves stream of ABI and acknowledges each of them after it's written. Records are processed one by one, so client which doesn't wait for acks is slowed down by flow control of the stream.
+func (server *Server) ImportMetadata(stream pb.MetadataService_ImportMetadataServer) error {
+	ctx := stream.Context()
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		ack := server.importRecord(ctx, req)
+		if ctx.Err() != nil {
+			return storageError(ctx.Err())
+		}
+		if err := stream.Send(ack); err != nil {
+			return err
+		}
+	}
+}
+
+func (server *Server) importRecord(ctx context.Context, req *pb.ImportMetadataRequest) *pb.ImportMetadataAck {
+	ack := &pb.ImportMetadataAck{
+		Sequence: req.Sequence,
+		Address:  req.Address,
+		Status:   pb.ImportStatus_FAILED,
+	}
+	if !common.IsHexAddress(req.Address) {
+		ack.Error = fmt.Sprintf("invalid address: %s", req.Address)
+		return ack
+	}
+	if len(req.Metadata) == 0 {
+		ack.Error = "empty metadata"
+		return ack
+	}
+
+	result, err := server.indexer.PutMetadata(ctx, req.Address, req.Metadata, req.Merge, nil)
+	if err != nil {
+		ack.Error = err.Error()
+		return ack
+	}
+	server.decodeMisses.Forget(result.Metadata.Contract)
+
+	ack.Address = checksum(result.Metadata.Contract)
+	ack.Conflicts = result.Conflicts
+	switch {
+	case result.Created:
+		ack.Status = pb.ImportStatus_INSERTED
+	case result.Unchanged:
+		ack.Status = pb.ImportStatus_UNCHANGED
+	default:
+		ack.Status = pb.ImportStatus_UPDATED
+	}
+	return ack
+}
+
+// ImportSignatures - stores textual signatures for reverse lookup of selectors. Signatures which are known already are skipped.
+func (server *Server) ImportSignatures(ctx context.Context, req *pb.ImportSignaturesRequest) (*pb.ImportSignaturesResponse, error) {
+	if len(req.Signatures) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty signatures")
+	}
+	if len(req.Signatures) > maxImportSignatures {
+		return nil, status.Errorf(codes.InvalidArgument, "too many signatures: %d > %d", len(req.Signatures), maxImportSignatures)
+	}
+
+	result, err := server.indexer.ImportSignatures(ctx, req.Signatures, signatureKind(req.Kind))
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.ImportSignaturesResponse{
+		Total:    uint64(result.Total),
+		Inserted: uint64(result.Inserted),
+		Existing: uint64(result.Existing),
+		Invalid:  uint64(result.Invalid),
+		Errors:   result.Errors,
+	}, nil
+}
+
+// tagsChanged - evicts the contract from cache and reloads it to standby snapshot, so changed tags are returned without waiting for expiration or the next sync
+func (server *Server) tagsChanged(ctx context.Context, address string) {
+	if server.cache != nil {
+		server.cache.Invalidate(ctx, address)
+	}
+	if server.standby != nil {
+		if err := server.standby.Reload(ctx, address); err != nil {
+			log.Err(err).Str("address", address).Msg("reloading contract to standby snapshot")
+		}
+	}
 }
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"sort"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Version - version of server which is returned by `Hello`. It's set at build time by `-ldflags "-X github.com/dipdup-net/abi-indexer/pkg/modules/grpc.Version=<version>"`.
+var Version = "dev"
+
+// optional features which can be enabled in config
+const (
+	FeatureRefresh      = "refresh"
+	FeaturePublisher    = "publisher"
+	FeatureAdmin        = "admin"
+	FeatureStandby      = "standby"
+	FeatureV2           = "v2"
+	FeaturePrimaryReads = "primary_reads"
+	FeatureCodeCheck    = "code_check"
+)
+
+// principals
+const (
+	PrincipalAnonymous = "anonymous"
+	PrincipalAdmin     = "admin"
+)
+
+// stableClientID - format of identifier requested by client. It's used as label of metrics, so it's short and printable.
+var stableClientID = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._:-]{0,63}$`)
+
+// claimClientID - validates stable identifier requested by client in `Hello`. Identifier can be reused by reconnecting client, but it's rejected while active subscriptions with it are served over connections from another host, so two clients can't share identity by mistake.
+func (server *Server) claimClientID(ctx context.Context, id string) error {
+	if !stableClientID.MatchString(id) {
+		return status.Errorf(codes.InvalidArgument, "invalid client id %q: it should be up to 64 letters, digits, '.', '_', ':' or '-' starting with letter or digit", id)
+	}
+
+	host := peerHost(peerAddress(ctx))
+	for _, sub := range server.activeSubscriptions.ByClient(id) {
+		if other := peerHost(sub.peer); other != host {
+			return status.Errorf(codes.AlreadyExists, "client id %s is used by connection from another host", id)
+		}
+	}
+	return nil
+}
+
+// peerHost - returns host of remote address without port
+func peerHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+// supportedMethods - returns sorted names of all methods of the service
+func supportedMethods() []string {
+	desc := pb.MetadataService_ServiceDesc
+	methods := make([]string, 0, len(desc.Methods)+len(desc.Streams))
+	for i := range desc.Methods {
+		methods = append(methods, desc.Methods[i].MethodName)
+	}
+	for i := range desc.Streams {
+		methods = append(methods, desc.Streams[i].StreamName)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// enabledMethods - returns sorted names of methods which aren't disabled in config
+func (server *Server) enabledMethods() []string {
+	methods := supportedMethods()
+	enabled := methods[:0]
+	for i := range methods {
+		if !server.disabled.disabled(methods[i]) {
+			enabled = append(enabled, methods[i])
+		}
+	}
+	return enabled
+}
+
+// features - returns optional features enabled on server
+func (server *Server) features() []string {
+	features := make([]string, 0)
+	if server.indexer.RefreshStatus().Enabled {
+		features = append(features, FeatureRefresh)
+	}
+	if server.publisher != nil {
+		features = append(features, FeaturePublisher)
+	}
+	if server.admin.enabled() {
+		features = append(features, FeatureAdmin)
+	}
+	if server.standby != nil {
+		features = append(features, FeatureStandby)
+	}
+	if server.v2 != nil {
+		features = append(features, FeatureV2)
+	}
+	if server.consistency.available {
+		features = append(features, FeaturePrimaryReads)
+	}
+	if server.indexer.CodeCheckStatus().Enabled {
+		features = append(features, FeatureCodeCheck)
+	}
+	return features
+}
+
+// snapshotAge - returns count of seconds since the last sync of standby snapshot. Zero is returned if standby mode is disabled or snapshot was never synced.
+func (server *Server) snapshotAge() int64 {
+	if server.standby == nil {
+		return 0
+	}
+	return int64(server.standby.Age().Seconds())
+}
+
+// latestUpdate - returns unix time of write of the most recently ingested metadata. Zero is returned if it's unknown.
+func (server *Server) latestUpdate() int64 {
+	latest := server.freshness.Latest()
+	if latest.IsZero() {
+		return 0
+	}
+	return latest.Unix()
+}
+
+// limits - returns limits of request parameters. Larger values are truncated to limits by server.
+func (server *Server) limits() *pb.Limits {
+	return &pb.Limits{
+		MaxSelectors:          maxSelectorsCount,
+		MaxTopics:             maxTopicsCount,
+		MaxTopicEvents:        maxTopicEventsLimit,
+		MaxSelectorSignatures: server.pageLimits.Get("ListSelectorSignatures"),
+		MaxSampleSize:         maxSampleSize,
+		GetMetadataTimeout:    server.getMetadataTimeout.Milliseconds(),
+		MaxImportSignatures:   maxImportSignatures,
+		MaxOffset:             server.maxOffset,
+		DefaultOrder:          protoSortOrder(server.defaultOrder),
+		MaxLimit:              server.pageLimits.fallback,
+		MaxLimits:             server.pageLimits.Overrides(),
+	}
+}
+
+// principal - returns who is authenticated by request metadata
+func (server *Server) principal(ctx context.Context) string {
+	if server.admin.authenticate(ctx) == nil {
+		return PrincipalAdmin
+	}
+	return PrincipalAnonymous
+}
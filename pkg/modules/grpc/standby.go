@@ -0,0 +1,247 @@
+package grpc
+
+import (
+	"context"
+	"encoding/gob"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/prometheus"
+	sdkStorage "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricStandbySnapshotAge = "abi_indexer_standby_snapshot_age_seconds"
+)
+
+const (
+	defaultStandbyInterval = 300
+	standbyBatchSize       = 1000
+	// standbyOverlap - delta is requested since the previous sync minus overlap to not miss rows written while previous sync was running
+	standbyOverlap = time.Minute
+)
+
+// StandbyConfig - settings of warm-standby mode. Server keeps local snapshot of metadata and serves `GetMetadata` from it, so reads don't fail on database outage.
+type StandbyConfig struct {
+	// Path - file of local snapshot. It's loaded on start, so snapshot survives restarts.
+	Path string `yaml:"path" validate:"required"`
+	// Interval - count of seconds between delta syncs with database. Default: 300.
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// OnlyComplete - if it's true only complete metadata are stored in snapshot
+	OnlyComplete bool `yaml:"only_complete"`
+}
+
+// snapshot - content of local snapshot file
+type snapshot struct {
+	SyncedAt time.Time
+	Metadata map[string]*storage.Metadata
+}
+
+// standbyMetadata - metadata storage which serves contracts by address from local snapshot. Contracts missing in snapshot are read from database. Other requests aren't changed.
+type standbyMetadata struct {
+	storage.IMetadata
+
+	path         string
+	interval     time.Duration
+	onlyComplete bool
+	metrics      *prometheus.Service
+
+	snapshot snapshot
+	mx       sync.RWMutex
+}
+
+func newStandbyMetadata(repo storage.IMetadata, cfg StandbyConfig, metrics *prometheus.Service) *standbyMetadata {
+	m := &standbyMetadata{
+		IMetadata:    repo,
+		path:         cfg.Path,
+		interval:     time.Duration(defaultStandbyInterval) * time.Second,
+		onlyComplete: cfg.OnlyComplete,
+		metrics:      metrics,
+		snapshot: snapshot{
+			Metadata: make(map[string]*storage.Metadata),
+		},
+	}
+	if cfg.Interval > 0 {
+		m.interval = time.Duration(cfg.Interval) * time.Second
+	}
+	if metrics != nil {
+		metrics.RegisterGauge(MetricStandbySnapshotAge, "seconds since the last successful sync of local snapshot with database")
+	}
+	return m
+}
+
+// GetByAddress -
+func (m *standbyMetadata) GetByAddress(ctx context.Context, address string, columns ...string) (*storage.Metadata, error) {
+	m.mx.RLock()
+	metadata, ok := m.snapshot.Metadata[strings.ToLower(address)]
+	m.mx.RUnlock()
+	if ok {
+		return metadata, nil
+	}
+	return m.IMetadata.GetByAddress(ctx, address, columns...)
+}
+
+// Put - stores written contract to snapshot without waiting for the next sync
+func (m *standbyMetadata) Put(metadata *storage.Metadata) {
+	if m.onlyComplete && !metadata.IsComplete {
+		return
+	}
+	m.mx.Lock()
+	m.snapshot.Metadata[metadata.Contract] = metadata
+	m.mx.Unlock()
+}
+
+// Reload - reads the contract from database and puts it to snapshot
+func (m *standbyMetadata) Reload(ctx context.Context, address string) error {
+	metadata, err := m.IMetadata.GetByAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+	m.Put(metadata)
+	return nil
+}
+
+// Age - returns time since the last successful sync. Zero is returned if snapshot was never synced.
+func (m *standbyMetadata) Age() time.Duration {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	if m.snapshot.SyncedAt.IsZero() {
+		return 0
+	}
+	return time.Since(m.snapshot.SyncedAt)
+}
+
+// sync - loads snapshot from file and periodically receives metadata written since the previous sync. Sync errors are logged and retried on the next iteration, reads are served from the existing snapshot meanwhile.
+func (m *standbyMetadata) sync(ctx context.Context) {
+	if err := m.load(); err != nil {
+		log.Err(err).Str("path", m.path).Msg("loading standby snapshot")
+	}
+
+	m.syncDelta(ctx)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	report := time.NewTicker(time.Second * 5)
+	defer report.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.syncDelta(ctx)
+		case <-report.C:
+			if m.metrics != nil {
+				m.metrics.SetGaugeValue(MetricStandbySnapshotAge, nil, m.Age().Seconds())
+			}
+		}
+	}
+}
+
+func (m *standbyMetadata) syncDelta(ctx context.Context) {
+	m.mx.RLock()
+	since := m.snapshot.SyncedAt
+	m.mx.RUnlock()
+
+	start := time.Now().UTC()
+	count, err := m.receive(ctx, since)
+	if err != nil {
+		log.Err(err).Msg("syncing standby snapshot")
+		return
+	}
+
+	m.mx.Lock()
+	m.snapshot.SyncedAt = start
+	m.mx.Unlock()
+
+	if err := m.save(); err != nil {
+		log.Err(err).Str("path", m.path).Msg("saving standby snapshot")
+		return
+	}
+	log.Info().Int("count", count).Msg("standby snapshot is synced")
+}
+
+// receive - reads metadata written since the time by batches ordered by id and puts them to snapshot. It returns count of received metadata.
+func (m *standbyMetadata) receive(ctx context.Context, since time.Time) (int, error) {
+	filter := storage.MetadataFilter{
+		OnlyComplete: m.onlyComplete,
+	}
+	if !since.IsZero() {
+		filter.UpdatedSince = since.Add(-standbyOverlap)
+	}
+
+	var count int
+	for {
+		batch, err := m.IMetadata.ListByFilter(ctx, filter, standbyBatchSize, 0, sdkStorage.SortOrderAsc)
+		if err != nil {
+			return count, err
+		}
+
+		m.mx.Lock()
+		for i := range batch {
+			m.snapshot.Metadata[batch[i].Contract] = batch[i]
+		}
+		m.mx.Unlock()
+
+		count += len(batch)
+		if len(batch) < standbyBatchSize {
+			return count, nil
+		}
+		filter.AfterID = batch[len(batch)-1].ID
+	}
+}
+
+func (m *standbyMetadata) load() error {
+	f, err := os.Open(m.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	var loaded snapshot
+	if err := gob.NewDecoder(f).Decode(&loaded); err != nil {
+		return err
+	}
+	if loaded.Metadata == nil {
+		loaded.Metadata = make(map[string]*storage.Metadata)
+	}
+
+	m.mx.Lock()
+	m.snapshot = loaded
+	m.mx.Unlock()
+
+	log.Info().Int("count", len(loaded.Metadata)).Time("synced_at", loaded.SyncedAt).Msg("standby snapshot is loaded")
+	return nil
+}
+
+// save - writes snapshot to temporary file and renames it, so snapshot file is never partially written
+func (m *standbyMetadata) save() error {
+	tmp := m.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	m.mx.RLock()
+	err = gob.NewEncoder(f).Encode(&m.snapshot)
+	m.mx.RUnlock()
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
@@ -1,39 +1,261 @@
 package grpc
 
 import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/dipdup-net/abi-indexer/internal/storage"
 	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
 )
 
-// MetadataSubscription -
+const subscriptionBufferSize = 1024
+
+type queuedMetadata struct {
+	msg      *pb.SubscriptionMetadata
+	queuedAt time.Time
+	replayed bool
+}
+
+// MetadataSubscription - subscription on new metadata. Messages are buffered, if subscriber doesn't read them in time and buffer is full new messages are dropped.
 type MetadataSubscription struct {
-	data chan *pb.SubscriptionMetadata
+	id        uint64
+	clientID  string
+	createdAt time.Time
+	// peer - remote address of connection of the subscriber
+	peer string
+	// iface - name of standard interface which metadata should implement to be sent. Empty means all metadata.
+	iface string
+
+	queue chan queuedMetadata
+	data  chan *pb.SubscriptionMetadata
+	stop  chan struct{}
+	once  *sync.Once
+	// evicted - subscription was closed by admin
+	evicted *atomic.Bool
+	// replaying - count of queued replayed messages which aren't delivered yet
+	replaying *atomic.Int64
+	// overflowed - subscription was closed because buffer was full before replayed messages were delivered
+	overflowed *atomic.Bool
+
+	sent    *atomic.Uint64
+	dropped *atomic.Uint64
+	// oldest - queue time in nanoseconds of the message which is waiting for delivery. Zero if there is no such message.
+	oldest *atomic.Int64
 }
 
 // NewMetadataSubscription -
-func NewMetadataSubscription() *MetadataSubscription {
-	return &MetadataSubscription{
-		data: make(chan *pb.SubscriptionMetadata, 1024),
+func NewMetadataSubscription(clientID, peer, iface string) *MetadataSubscription {
+	sub := &MetadataSubscription{
+		clientID:   clientID,
+		createdAt:  time.Now(),
+		peer:       peer,
+		iface:      iface,
+		queue:      make(chan queuedMetadata, subscriptionBufferSize),
+		data:       make(chan *pb.SubscriptionMetadata),
+		stop:       make(chan struct{}),
+		once:       new(sync.Once),
+		evicted:    new(atomic.Bool),
+		replaying:  new(atomic.Int64),
+		overflowed: new(atomic.Bool),
+		sent:       new(atomic.Uint64),
+		dropped:    new(atomic.Uint64),
+		oldest:     new(atomic.Int64),
+	}
+	go sub.forward()
+	return sub
+}
+
+func (m *MetadataSubscription) forward() {
+	defer close(m.data)
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case item := <-m.queue:
+			m.oldest.Store(item.queuedAt.UnixNano())
+			select {
+			case <-m.stop:
+				return
+			case m.data <- item.msg:
+				m.sent.Add(1)
+				m.oldest.Store(0)
+				if item.replayed {
+					m.replaying.Add(-1)
+				}
+			}
+		}
 	}
 }
 
-// Filter -
-func (m *MetadataSubscription) Filter(*storage.Metadata) bool {
-	return true
+// Filter - checks that metadata implements interface of subscription
+func (m *MetadataSubscription) Filter(metadata *storage.Metadata) bool {
+	if m.iface == "" {
+		return true
+	}
+	for i := range metadata.Interfaces {
+		if strings.EqualFold(metadata.Interfaces[i], m.iface) {
+			return true
+		}
+	}
+	return false
 }
 
-// Send -
+// Send - enqueues message. It never blocks. If buffer is full the message is dropped, but while replayed messages are queued the subscription is closed instead (see Replay).
 func (m *MetadataSubscription) Send(data *pb.SubscriptionMetadata) {
-	m.data <- data
+	m.enqueue(queuedMetadata{data, time.Now(), false})
+}
+
+// Replay - enqueues replayed message. Replay phase lasts until all replayed messages are delivered: overflow of buffer during it closes subscription, so resuming client doesn't lose events silently.
+func (m *MetadataSubscription) Replay(data *pb.SubscriptionMetadata) {
+	m.replaying.Add(1)
+	m.enqueue(queuedMetadata{data, time.Now(), true})
+}
+
+func (m *MetadataSubscription) enqueue(item queuedMetadata) {
+	select {
+	case m.queue <- item:
+	default:
+		m.dropped.Add(1)
+		if item.replayed {
+			m.replaying.Add(-1)
+		} else if m.replaying.Load() == 0 {
+			return
+		}
+		m.overflowed.Store(true)
+		m.Close()
+	}
 }
 
 // Close -
 func (m *MetadataSubscription) Close() error {
-	close(m.data)
+	m.once.Do(func() {
+		close(m.stop)
+	})
 	return nil
 }
 
+// Evict - closes subscription on behalf of admin. Stream of subscription is finished with `Aborted` status.
+func (m *MetadataSubscription) Evict() {
+	m.evicted.Store(true)
+	m.Close()
+}
+
+// Evicted - checks if subscription was closed by admin
+func (m *MetadataSubscription) Evicted() bool {
+	return m.evicted.Load()
+}
+
+// Overflowed - checks if subscription was closed because buffer overflowed during replay
+func (m *MetadataSubscription) Overflowed() bool {
+	return m.overflowed.Load()
+}
+
+// Closed - checks if subscription is closed
+func (m *MetadataSubscription) Closed() bool {
+	select {
+	case <-m.stop:
+		return true
+	default:
+		return false
+	}
+}
+
 // Listen -
 func (m *MetadataSubscription) Listen() <-chan *pb.SubscriptionMetadata {
 	return m.data
 }
+
+// SubscriptionStats -
+type SubscriptionStats struct {
+	ID        uint64
+	ClientID  string
+	CreatedAt time.Time
+	Sent      uint64
+	Dropped   uint64
+	Queued    uint64
+	// Lag - age of the oldest undelivered message
+	Lag time.Duration
+}
+
+// Stats -
+func (m *MetadataSubscription) Stats() SubscriptionStats {
+	stats := SubscriptionStats{
+		ID:        m.id,
+		ClientID:  m.clientID,
+		CreatedAt: m.createdAt,
+		Sent:      m.sent.Load(),
+		Dropped:   m.dropped.Load(),
+		Queued:    uint64(len(m.queue)),
+	}
+	if oldest := m.oldest.Load(); oldest > 0 {
+		stats.Queued++
+		stats.Lag = time.Since(time.Unix(0, oldest))
+	}
+	return stats
+}
+
+// activeSubscriptions - registry of active metadata subscriptions which is used for stats reporting
+type activeSubscriptions struct {
+	m  map[uint64]*MetadataSubscription
+	mx *sync.RWMutex
+}
+
+func newActiveSubscriptions() *activeSubscriptions {
+	return &activeSubscriptions{
+		m:  make(map[uint64]*MetadataSubscription),
+		mx: new(sync.RWMutex),
+	}
+}
+
+func (a *activeSubscriptions) Add(id uint64, sub *MetadataSubscription) {
+	a.mx.Lock()
+	sub.id = id
+	a.m[id] = sub
+	a.mx.Unlock()
+}
+
+func (a *activeSubscriptions) Remove(id uint64) {
+	a.mx.Lock()
+	delete(a.m, id)
+	a.mx.Unlock()
+}
+
+func (a *activeSubscriptions) Stats() []SubscriptionStats {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	stats := make([]SubscriptionStats, 0, len(a.m))
+	for _, sub := range a.m {
+		stats = append(stats, sub.Stats())
+	}
+	return stats
+}
+
+// ByClient - returns active subscriptions of the client
+func (a *activeSubscriptions) ByClient(clientID string) []*MetadataSubscription {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	subs := make([]*MetadataSubscription, 0)
+	for _, sub := range a.m {
+		if sub.clientID == clientID {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// All - returns all active subscriptions
+func (a *activeSubscriptions) All() []*MetadataSubscription {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	subs := make([]*MetadataSubscription, 0, len(a.m))
+	for _, sub := range a.m {
+		subs = append(subs, sub)
+	}
+	return subs
+}
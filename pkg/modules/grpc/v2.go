@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/pkg/modules/grpc/pb"
+	generalPB "github.com/dipdup-net/indexer-sdk/pkg/modules/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// V2Config - settings of experimental `MetadataServiceV2`. The service is registered next to `MetadataService` only if the section is set.
+type V2Config struct {
+	// Chain - name of the chain which is returned in envelopes of responses, e.g. `mainnet`
+	Chain string `yaml:"chain" validate:"required"`
+}
+
+// serverV2 - adapter of `MetadataServiceV2` to handlers of `MetadataService`. Requests are converted to v1 shapes, so both services share handler logic.
+type serverV2 struct {
+	pb.UnimplementedMetadataServiceV2Server
+
+	v1    *Server
+	chain string
+}
+
+func newServerV2(v1 *Server, cfg V2Config) *serverV2 {
+	return &serverV2{
+		v1:    v1,
+		chain: cfg.Chain,
+	}
+}
+
+// SubscribeOnMetadata - subscribes on metadata events. Batching isn't supported, because event envelope contains single metadata.
+func (server *serverV2) SubscribeOnMetadata(req *pb.SubscribeOnMetadataRequest, stream pb.MetadataServiceV2_SubscribeOnMetadataServer) error {
+	if req.BatchSize > 1 {
+		return status.Error(codes.InvalidArgument, "batching of subscription isn't supported by v2 service")
+	}
+	return server.v1.SubscribeOnMetadata(req, &subscriptionStreamV2{
+		MetadataServiceV2_SubscribeOnMetadataServer: stream,
+		chain: server.chain,
+	})
+}
+
+// UnsubscribeFromMetadata -
+func (server *serverV2) UnsubscribeFromMetadata(ctx context.Context, req *generalPB.UnsubscribeRequest) (*generalPB.UnsubscribeResponse, error) {
+	return server.v1.UnsubscribeFromMetadata(ctx, req)
+}
+
+// GetMetadata -
+func (server *serverV2) GetMetadata(ctx context.Context, req *pb.GetMetadataV2Request) (*pb.MetadataEnvelope, error) {
+	metadata, err := server.v1.GetMetadata(ctx, &pb.GetMetadataRequest{
+		Address:       req.Address,
+		FieldMask:     req.FieldMask,
+		FollowFactory: req.FollowFactory,
+		IfNoneMatch:   req.IfNoneMatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MetadataEnvelope{
+		Chain:    server.chain,
+		Metadata: metadata,
+	}, nil
+}
+
+// ListMetadata - lists metadata by consistent cursor only. Offset pagination is not supported.
+func (server *serverV2) ListMetadata(ctx context.Context, req *pb.ListMetadataV2Request) (*pb.ListMetadataV2Response, error) {
+	response, err := server.v1.ListMetadata(ctx, &pb.ListMetadataRequest{
+		Page: &generalPB.Page{
+			Limit: req.Limit,
+			Order: req.Order,
+		},
+		OnlyComplete: req.OnlyComplete,
+		Proxy:        req.Proxy,
+		UpdatedSince: req.UpdatedSince,
+		FieldMask:    req.FieldMask,
+		Consistent:   true,
+		Cursor:       req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListMetadataV2Response{
+		Chain:      server.chain,
+		Metadata:   response.Metadata,
+		NextCursor: response.NextCursor,
+	}, nil
+}
+
+// subscriptionStreamV2 - v1 subscription stream which wraps messages to v2 events
+type subscriptionStreamV2 struct {
+	pb.MetadataServiceV2_SubscribeOnMetadataServer
+
+	chain string
+}
+
+// Send -
+func (stream *subscriptionStreamV2) Send(msg *pb.SubscriptionMetadata) error {
+	return stream.MetadataServiceV2_SubscribeOnMetadataServer.Send(&pb.MetadataEvent{
+		Chain:          stream.chain,
+		SubscriptionId: msg.GetSubscription().GetId(),
+		Metadata:       msg.Metadata,
+		SentAt:         time.Now().Unix(),
+		EventId:        msg.EventId,
+	})
+}
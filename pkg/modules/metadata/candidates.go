@@ -0,0 +1,170 @@
+package metadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dipdup-net/abi-indexer/internal/sources"
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// errors
+var (
+	ErrCandidatesDisabled = errors.New("ABI candidates are disabled")
+)
+
+// fetch - receives ABI of the contract from sources. If candidates are disabled, only ABI of the first source which has it is returned. Otherwise all sources are requested and valid ABI of each of them is returned in order of sources, so the first one is preferred. Duplicate entries are removed from received ABI.
+func (metadata *Metadata) fetch(ctx context.Context, address string) ([]sources.Result, error) {
+	if metadata.candidates == nil {
+		result, err := metadata.source.Find(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		result.Metadata = metadata.dedup(address, result.Metadata)
+		return []sources.Result{result}, nil
+	}
+
+	results, err := metadata.source.FindAll(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+	valid := make([]sources.Result, 0, len(results))
+	for i := range results {
+		if err := metadata.validate(address, results[i].Metadata); err != nil {
+			log.Warn().Err(err).Str("address", address).Str("source", string(results[i].Source)).Msg("ABI candidate is skipped")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		results[i].Metadata = metadata.dedup(address, results[i].Metadata)
+		valid = append(valid, results[i])
+	}
+	if len(valid) == 0 {
+		return nil, firstErr
+	}
+	return valid, nil
+}
+
+func (metadata *Metadata) validate(address string, data []byte) error {
+	if err := metadata.CheckSize(address, data); err != nil {
+		return err
+	}
+	if _, err := vm.Factory(metadata.vmType, data); err != nil {
+		return errors.Wrap(ErrInvalidABI, err.Error())
+	}
+	return nil
+}
+
+// dedup - removes entries declared several times from ABI, e.g. the same function listed twice, so stored ABI and lookup tables contain each entry once. Invalid ABI is returned as is: it's rejected by parsing.
+func (metadata *Metadata) dedup(address string, data []byte) []byte {
+	deduped, removed, err := vm.DedupABI(metadata.vmType, data)
+	if err != nil {
+		return data
+	}
+	if removed > 0 {
+		log.Info().Str("address", address).Int("removed", removed).Msg("duplicate ABI entries were removed")
+	}
+	return deduped
+}
+
+// saveCandidates - stores received ABI as candidates of the contract. It does nothing if candidates are disabled.
+func (metadata *Metadata) saveCandidates(ctx context.Context, address string, results []sources.Result) error {
+	if metadata.candidates == nil {
+		return nil
+	}
+	candidates := make([]*models.Candidate, len(results))
+	for i := range results {
+		candidates[i] = &models.Candidate{
+			Contract: address,
+			Source:   string(results[i].Source),
+			Metadata: results[i].Metadata,
+		}
+	}
+	return metadata.candidates.Upsert(ctx, candidates...)
+}
+
+// Candidates - returns all stored ABI of the contract in order of priority: ABI put by API, then ABI of sources in order of config, then ABI of sources which were removed from config.
+func (metadata *Metadata) Candidates(ctx context.Context, address string) ([]*models.Candidate, error) {
+	if metadata.candidates == nil {
+		return nil, ErrCandidatesDisabled
+	}
+	candidates, err := metadata.candidates.GetByContract(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return metadata.priority(candidates[i].Source) < metadata.priority(candidates[j].Source)
+	})
+	return candidates, nil
+}
+
+// priority - returns position of the source in preference order. Less is preferred.
+func (metadata *Metadata) priority(source string) int {
+	if source == string(sources.ManualType) {
+		return -1
+	}
+	types := metadata.source.Types()
+	for i := range types {
+		if string(types[i]) == source {
+			return i
+		}
+	}
+	return len(types)
+}
+
+// abis - returns ABI of the contract which should be tried by decoders in order. It's stored ABI read from repo if candidates are disabled or the contract doesn't have them, complete flag is of stored ABI then. Candidates are treated as complete.
+func (metadata *Metadata) abis(ctx context.Context, repo models.IMetadata, address string) ([][]byte, bool, error) {
+	if metadata.candidates != nil {
+		candidates, err := metadata.Candidates(ctx, address)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(candidates) > 0 {
+			result := make([][]byte, len(candidates))
+			for i := range candidates {
+				result[i] = candidates[i].Metadata
+			}
+			return result, true, nil
+		}
+	}
+
+	model, err := repo.GetByAddress(ctx, address, models.ColumnMetadata, "is_complete")
+	if err != nil {
+		return nil, false, err
+	}
+	return [][]byte{model.Metadata}, model.IsComplete, nil
+}
+
+// decode - tries ABI of the contract in order until decoder succeeds. Error of the first ABI is returned if all of them fail, it's IncompleteABIError if stored ABI is incomplete.
+func decode[T any](ctx context.Context, metadata *Metadata, repo models.IMetadata, address string, decoder func(machine vm.VirtualMachine) (T, error)) (T, error) {
+	var result T
+
+	abis, complete, err := metadata.abis(ctx, repo, address)
+	if err != nil {
+		return result, err
+	}
+
+	var firstErr error
+	for i := range abis {
+		machine, err := vm.Factory(metadata.vmType, abis[i])
+		if err == nil {
+			result, err = decoder(machine)
+			if err == nil {
+				return result, nil
+			}
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if !complete {
+		return result, &IncompleteABIError{Err: firstErr}
+	}
+	return result, firstErr
+}
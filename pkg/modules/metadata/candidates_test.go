@@ -0,0 +1,32 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/dipdup-net/abi-indexer/internal/vm"
+)
+
+func TestDedup(t *testing.T) {
+	const (
+		transfer = `{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}`
+		approval = `{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}`
+	)
+	metadata := &Metadata{vmType: vm.TypeEVM}
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"duplicates", "[" + transfer + "," + approval + "," + transfer + "," + approval + "]", "[" + transfer + "," + approval + "]"},
+		{"without duplicates", "[" + approval + "," + transfer + "]", "[" + approval + "," + transfer + "]"},
+		{"invalid ABI is returned as is", `{"abi":[]}`, `{"abi":[]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metadata.dedup("0x5fbdb2315678afecb367f032d93f642f64180aa3", []byte(tt.data)); string(got) != tt.want {
+				t.Fatalf("ABI = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
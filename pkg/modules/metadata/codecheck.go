@@ -0,0 +1,203 @@
+package metadata
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricCodeChecks = "abi_indexer_code_checks_total"
+)
+
+// CodeCheckConfig - settings of background check of contract code. Contracts which code is empty, e.g. self-destructed ones, are marked as destroyed.
+type CodeCheckConfig struct {
+	// NodeURL - JSON-RPC endpoint of node. Default: `node_url` of `creation` section
+	NodeURL string `yaml:"node_url" validate:"omitempty,url"`
+	// RecheckAfter - count of seconds after which code of the contract is checked again. Default: 86400
+	RecheckAfter int `yaml:"recheck_after" validate:"omitempty,min=1"`
+	// Interval - count of seconds between searches of unchecked contracts. Default: 60
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// RateLimit - maximum count of requests to node per second. Default: 5
+	RateLimit int `yaml:"rate_limit" validate:"omitempty,min=1"`
+	// BatchSize - maximum count of contracts checked in one iteration. Default: 100
+	BatchSize int `yaml:"batch_size" validate:"omitempty,min=1"`
+	// EIP6780Block - block since which SELFDESTRUCT removes code only of contracts created in the same transaction (Cancun upgrade on Ethereum). Contracts created at or after it can't lose code, so they aren't checked. 0 - SELFDESTRUCT always removes code.
+	EIP6780Block uint64 `yaml:"eip6780_block" validate:"omitempty"`
+}
+
+// CodeCheckStatus - state of background code check
+type CodeCheckStatus struct {
+	Enabled bool
+	LastRun time.Time
+	// Checked and Destroyed - count of contracts which code was requested from node and which were found without code since start
+	Checked   uint64
+	Destroyed uint64
+}
+
+// codeReader - node which returns code of accounts
+type codeReader interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	Close()
+}
+
+type codeChecker struct {
+	node         codeReader
+	recheckAfter time.Duration
+	interval     time.Duration
+	rateLimit    int
+	batchSize    int
+	eip6780Block uint64
+
+	lastRun   atomic.Int64
+	checked   atomic.Uint64
+	destroyed atomic.Uint64
+}
+
+func newCodeChecker(ctx context.Context, cfg CodeCheckConfig, creationNodeURL string) (*codeChecker, error) {
+	nodeURL := cfg.NodeURL
+	if nodeURL == "" {
+		nodeURL = creationNodeURL
+	}
+	if nodeURL == "" {
+		return nil, errors.New("code check requires node_url of code_check or creation section")
+	}
+	node, err := ethclient.DialContext(ctx, nodeURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "node connection of code check")
+	}
+
+	c := &codeChecker{
+		node:         node,
+		recheckAfter: 24 * time.Hour,
+		interval:     time.Minute,
+		rateLimit:    5,
+		batchSize:    100,
+		eip6780Block: cfg.EIP6780Block,
+	}
+	if cfg.RecheckAfter > 0 {
+		c.recheckAfter = time.Duration(cfg.RecheckAfter) * time.Second
+	}
+	if cfg.Interval > 0 {
+		c.interval = time.Duration(cfg.Interval) * time.Second
+	}
+	if cfg.RateLimit > 0 {
+		c.rateLimit = cfg.RateLimit
+	}
+	if cfg.BatchSize > 0 {
+		c.batchSize = cfg.BatchSize
+	}
+	return c, nil
+}
+
+// CodeCheckStatus - returns state of background code check
+func (metadata *Metadata) CodeCheckStatus() CodeCheckStatus {
+	c := metadata.codeChecker
+	if c == nil {
+		return CodeCheckStatus{}
+	}
+
+	status := CodeCheckStatus{
+		Enabled:   true,
+		Checked:   c.checked.Load(),
+		Destroyed: c.destroyed.Load(),
+	}
+	if lastRun := c.lastRun.Load(); lastRun > 0 {
+		status.LastRun = time.Unix(lastRun, 0).UTC()
+	}
+	return status
+}
+
+// checkCode - periodically requests code of contracts which weren't checked for `recheck_after` and marks contracts without code as destroyed
+func (metadata *Metadata) checkCode(ctx context.Context) {
+	defer metadata.wg.Done()
+
+	ticker := time.NewTicker(metadata.codeChecker.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if metadata.inMaintenance() {
+				continue
+			}
+			if err := metadata.checkCodeBatch(ctx); err != nil {
+				log.Err(err).Msg("checking code of contracts")
+			}
+		}
+	}
+}
+
+func (metadata *Metadata) checkCodeBatch(ctx context.Context) error {
+	c := metadata.codeChecker
+	c.lastRun.Store(time.Now().Unix())
+
+	batch, err := metadata.repo.ListCodeUnchecked(ctx, time.Now().Add(-c.recheckAfter), c.eip6780Block, uint64(c.batchSize))
+	if err != nil {
+		return err
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	limiter := time.NewTicker(time.Second / time.Duration(c.rateLimit))
+	defer limiter.Stop()
+
+	for i := range batch {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-limiter.C:
+		}
+		if metadata.inMaintenance() {
+			return nil
+		}
+
+		if err := metadata.checkContractCode(ctx, batch[i]); err != nil {
+			log.Err(err).Str("address", batch[i].Contract).Msg("checking contract code")
+			metadata.countCodeCheck("failed")
+		}
+	}
+	return nil
+}
+
+// checkContractCode - requests code of the contract at the latest block. Status is changed in both directions: contract which was redeployed to the same address by CREATE2 is marked as deployed again. Failed request doesn't change status, so contract is checked again by the next iteration.
+func (metadata *Metadata) checkContractCode(ctx context.Context, model *models.Metadata) error {
+	c := metadata.codeChecker
+
+	ctx, cancel := context.WithTimeout(ctx, metadata.taskTimeout)
+	defer cancel()
+
+	code, err := c.node.CodeAt(ctx, common.HexToAddress(model.Contract), nil)
+	if err != nil {
+		return err
+	}
+	c.checked.Add(1)
+
+	status := models.CodeStatusDeployed
+	if len(code) == 0 {
+		status = models.CodeStatusDestroyed
+		c.destroyed.Add(1)
+	}
+	if status != model.CodeStatus && model.CodeStatus != models.CodeStatusUnchecked {
+		log.Info().Str("address", model.Contract).Str("status", string(status)).Msg("code status of contract was changed")
+	}
+	metadata.countCodeCheck(string(status))
+	return metadata.repo.MarkCodeStatus(ctx, model.ID, status, time.Now().UTC())
+}
+
+func (metadata *Metadata) countCodeCheck(result string) {
+	if metadata.metrics != nil {
+		metadata.metrics.IncrementCounter(MetricCodeChecks, map[string]string{"result": result})
+	}
+}
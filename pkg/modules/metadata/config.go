@@ -1,15 +1,52 @@
 package metadata
 
 import (
+	"github.com/dipdup-net/abi-indexer/internal/creation"
 	"github.com/dipdup-net/abi-indexer/internal/sources"
 	"github.com/dipdup-net/abi-indexer/internal/vm"
 )
 
 // Config -
 type Config struct {
-	SourceType   sources.Type              `yaml:"source_type" validate:"required,oneof=fs sourcify"`
+	SourceType   sources.Type              `yaml:"source_type" validate:"omitempty,oneof=fs sourcify"`
 	ThreadsCount int                       `yaml:"threads_count" validate:"omitempty,min=1"`
 	VM           *vm.Config                `yaml:"vm"`
 	Sourcify     *sources.SourcifyConfig   `yaml:"sourcify"`
 	FS           *sources.FileSystemConfig `yaml:"fs"`
+	Creation     *creation.Config          `yaml:"creation" validate:"omitempty"`
+	Refresh      *RefreshConfig            `yaml:"refresh" validate:"omitempty"`
+	CodeCheck    *CodeCheckConfig          `yaml:"code_check" validate:"omitempty"`
+	Maintenance  *MaintenanceConfig        `yaml:"maintenance"`
+
+	// Sources - ABI sources in order of request. Metadata is received from the first source which has it. If it's empty `source_type` is used.
+	Sources []sources.Type `yaml:"sources" validate:"omitempty,dive,oneof=fs sourcify"`
+
+	BackfillInterfaces bool `yaml:"backfill_interfaces"`
+
+	// DisabledIndexes - auxiliary tables which aren't written on ingest to save write cost. Lookups by them are rejected. All indexes are maintained by default.
+	DisabledIndexes []Index `yaml:"disabled_indexes" validate:"omitempty,dive,oneof=methods events errors"`
+
+	// MaxABISize - maximum size of ABI JSON in bytes. Larger ABI is rejected. 0 - unlimited
+	MaxABISize int `yaml:"max_abi_size" validate:"omitempty,min=0"`
+
+	// Candidates - requests all sources and stores ABI of each of them as candidate. ABI of the first source in order of `sources` is preferred and stored in metadata, ABI put by API is preferred over sources. Decoders try candidates in order of preference.
+	Candidates bool `yaml:"candidates"`
+
+	// DedupWrites - skips update of stored ABI and its event if new ABI declares the same entries, e.g. if the same ABI is received from another source with different formatting
+	DedupWrites bool `yaml:"dedup_writes"`
+
+	// TaskTimeout - timeout of indexing or refresh of one contract in seconds. It bounds the whole sequence: fetching ABI from source, resolving creation and saving. Default: 60
+	TaskTimeout int `yaml:"task_timeout" validate:"omitempty,min=1"`
+}
+
+// RefreshConfig - settings of background refresh of stale metadata. Frequently queried contracts are refreshed first.
+type RefreshConfig struct {
+	// StaleAfter - count of seconds after which metadata is fetched from source again
+	StaleAfter int `yaml:"stale_after" validate:"required,min=1"`
+	// Interval - count of seconds between searches of stale metadata. Default: 60
+	Interval int `yaml:"interval" validate:"omitempty,min=1"`
+	// RateLimit - maximum count of requests to source per second. Default: 1
+	RateLimit int `yaml:"rate_limit" validate:"omitempty,min=1"`
+	// BatchSize - maximum count of contracts refreshed in one iteration. Default: 100
+	BatchSize int `yaml:"batch_size" validate:"omitempty,min=1"`
 }
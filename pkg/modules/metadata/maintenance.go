@@ -0,0 +1,93 @@
+package metadata
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricMaintenance = "abi_indexer_maintenance"
+)
+
+const maintenanceCheckInterval = time.Second
+
+// MaintenanceConfig - maintenance mode enabled on start
+type MaintenanceConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	ShedWrites bool `yaml:"shed_writes"`
+}
+
+// Maintenance - state of maintenance mode. Background workers are suspended while it's enabled. If ShedWrites is set, manual writes of API are rejected too.
+type Maintenance struct {
+	Enabled    bool
+	ShedWrites bool
+	// Since - time when mode was enabled. It's zero if mode is disabled.
+	Since time.Time
+}
+
+// SetMaintenance - enables or disables maintenance mode and returns its new state. Background indexing of sources, refresh and interfaces backfill are suspended until mode is disabled, tasks which are processed now are finished. Writes can be shed only while mode is enabled.
+func (metadata *Metadata) SetMaintenance(enabled, shedWrites bool) Maintenance {
+	state := Maintenance{
+		Enabled:    enabled,
+		ShedWrites: enabled && shedWrites,
+	}
+	if enabled {
+		state.Since = time.Now().UTC()
+		if prev := metadata.maintenance.Load(); prev.Enabled {
+			state.Since = prev.Since
+		}
+	}
+
+	prev := metadata.maintenance.Swap(&state)
+	switch {
+	case enabled && !prev.Enabled:
+		log.Warn().Bool("shed_writes", state.ShedWrites).Msg("maintenance mode is enabled: background workers are suspended")
+	case !enabled && prev.Enabled:
+		log.Info().Dur("duration", time.Since(prev.Since)).Msg("maintenance mode is disabled: background workers are resumed")
+	case enabled && prev.ShedWrites != state.ShedWrites:
+		log.Info().Bool("shed_writes", state.ShedWrites).Msg("shedding of writes in maintenance mode is changed")
+	}
+
+	if metadata.metrics != nil {
+		var value float64
+		if enabled {
+			value = 1
+		}
+		metadata.metrics.SetGaugeValue(MetricMaintenance, nil, value)
+	}
+	return state
+}
+
+// Maintenance - returns state of maintenance mode
+func (metadata *Metadata) Maintenance() Maintenance {
+	return *metadata.maintenance.Load()
+}
+
+// inMaintenance - checks that maintenance mode is enabled
+func (metadata *Metadata) inMaintenance() bool {
+	return metadata.maintenance.Load().Enabled
+}
+
+// waitMaintenance - blocks background worker while maintenance mode is enabled. It returns false if context was cancelled.
+func (metadata *Metadata) waitMaintenance(ctx context.Context) bool {
+	if !metadata.inMaintenance() {
+		return true
+	}
+
+	ticker := time.NewTicker(maintenanceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			if !metadata.inMaintenance() {
+				return true
+			}
+		}
+	}
+}
@@ -1,15 +1,23 @@
 package metadata
 
 import (
+	"bytes"
 	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/dipdup-net/abi-indexer/internal/creation"
 	"github.com/dipdup-net/abi-indexer/internal/sources"
 	models "github.com/dipdup-net/abi-indexer/internal/storage"
 
 	"github.com/dipdup-net/abi-indexer/internal/vm"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	"github.com/dipdup-net/go-lib/prometheus"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
-	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/dipdup-net/workerpool"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
@@ -19,6 +27,62 @@ var (
 	OutputMetadata = "metadata"
 )
 
+// metric names
+const (
+	MetricRejectedABI   = "abi_indexer_rejected_abi_total"
+	MetricDedupedWrites = "abi_indexer_deduped_writes_total"
+)
+
+const defaultTaskTimeout = time.Minute
+
+// Index - auxiliary table of ABI entries which is maintained on ingest for reverse lookups
+type Index string
+
+// indexes
+const (
+	// IndexMethods - selectors and signatures of functions
+	IndexMethods Index = "methods"
+	// IndexEvents - topics and signatures of events
+	IndexEvents Index = "events"
+	// IndexErrors - selectors and signatures of custom errors
+	IndexErrors Index = "errors"
+)
+
+// errors
+var (
+	ErrTooLargeABI = errors.New("ABI is too large")
+	ErrInvalidABI  = errors.New("invalid ABI")
+	// ErrVersionConflict - metadata was changed after the version expected by writer
+	ErrVersionConflict = errors.New("metadata was changed")
+	// ErrIncompleteABI - decoding failed and stored ABI doesn't declare methods and events, see `models.Metadata.IsComplete`
+	ErrIncompleteABI = errors.New("ABI is incomplete")
+)
+
+// IncompleteABIError - decoding by incomplete ABI failed. It matches both ErrIncompleteABI and error of decoder, e.g. `evm.ErrUnknownSelector`.
+type IncompleteABIError struct {
+	Err error
+}
+
+// Error -
+func (e *IncompleteABIError) Error() string {
+	return ErrIncompleteABI.Error() + ": " + e.Err.Error()
+}
+
+// Is -
+func (e *IncompleteABIError) Is(target error) bool {
+	return target == ErrIncompleteABI
+}
+
+// Unwrap -
+func (e *IncompleteABIError) Unwrap() error {
+	return e.Err
+}
+
+// isComplete - rule of `is_complete` flag. Backfill migration of storage computes it the same way by stored methods and events.
+func isComplete(methods []models.Method, events []models.Event) bool {
+	return len(methods) > 0 || len(events) > 0
+}
+
 // Metadata -
 type Metadata struct {
 	output *modules.Output
@@ -26,12 +90,27 @@ type Metadata struct {
 	repo         models.IMetadata
 	events       models.IEvent
 	methods      models.IMethod
-	transactable storage.Transactable
+	candidates   models.ICandidate
+	signatures   models.ISignature
+	transactable models.Transactable
+
+	source    *sources.Registry
+	vmType    vm.Type
+	creation  creation.Resolver
+	refresher *refresher
 
-	source sources.Source
-	vmType vm.Type
+	codeChecker *codeChecker
+
+	backfillInterfaces bool
+	dedupWrites        bool
+	disabledIndexes    map[Index]struct{}
+	maxABISize         int
+	taskTimeout        time.Duration
+	maintenance        atomic.Pointer[Maintenance]
+	metrics            *prometheus.Service
 
 	pool *workerpool.TimedPool[string]
+	wg   *sync.WaitGroup
 }
 
 // NewMetadata -
@@ -40,9 +119,16 @@ func NewMetadata(
 	metadataRepo models.IMetadata,
 	events models.IEvent,
 	methods models.IMethod,
-	transactable storage.Transactable,
+	candidates models.ICandidate,
+	signatures models.ISignature,
+	transactable models.Transactable,
+	metrics *prometheus.Service,
 ) (*Metadata, error) {
-	src, err := sources.Factory(cfg.SourceType, sources.FactoryParams{
+	types := cfg.Sources
+	if len(types) == 0 && cfg.SourceType != "" {
+		types = []sources.Type{cfg.SourceType}
+	}
+	src, err := sources.NewRegistry(types, sources.FactoryParams{
 		Sourcify: cfg.Sourcify,
 		FS:       cfg.FS,
 	})
@@ -58,10 +144,76 @@ func NewMetadata(
 		repo:         metadataRepo,
 		methods:      methods,
 		events:       events,
+		signatures:   signatures,
 		transactable: transactable,
 		source:       src,
 		vmType:       cfg.VM.Type,
 		output:       modules.NewOutput(OutputMetadata),
+		wg:           new(sync.WaitGroup),
+
+		backfillInterfaces: cfg.BackfillInterfaces,
+		dedupWrites:        cfg.DedupWrites,
+		disabledIndexes:    make(map[Index]struct{}),
+		maxABISize:         cfg.MaxABISize,
+		taskTimeout:        defaultTaskTimeout,
+		metrics:            metrics,
+	}
+
+	if cfg.Candidates {
+		metadata.candidates = candidates
+	}
+
+	for _, index := range cfg.DisabledIndexes {
+		metadata.disabledIndexes[index] = struct{}{}
+	}
+
+	if cfg.TaskTimeout > 0 {
+		metadata.taskTimeout = time.Duration(cfg.TaskTimeout) * time.Second
+	}
+
+	if metrics != nil {
+		metrics.RegisterCounter(MetricRejectedABI, "count of ABI rejected on ingest", "reason")
+		metrics.RegisterGauge(MetricMaintenance, "1 if maintenance mode is enabled and background workers are suspended, 0 otherwise")
+		if cfg.DedupWrites {
+			metrics.RegisterCounter(MetricDedupedWrites, "count of skipped writes of ABI which is the same as stored one", "path")
+		}
+	}
+
+	if cfg.Refresh != nil {
+		metadata.refresher = newRefresher(*cfg.Refresh)
+		if metrics != nil {
+			metrics.RegisterCounter(MetricRefreshed, "count of stale metadata fetched from source again", "result")
+		}
+	}
+
+	metadata.maintenance.Store(new(Maintenance))
+	if cfg.Maintenance != nil {
+		metadata.SetMaintenance(cfg.Maintenance.Enabled, cfg.Maintenance.ShedWrites)
+	} else {
+		metadata.SetMaintenance(false, false)
+	}
+
+	if cfg.Creation != nil {
+		resolver, err := creation.NewEtherscan(context.Background(), *cfg.Creation)
+		if err != nil {
+			return nil, err
+		}
+		metadata.creation = resolver
+	}
+
+	if cfg.CodeCheck != nil {
+		var creationNodeURL string
+		if cfg.Creation != nil {
+			creationNodeURL = cfg.Creation.NodeURL
+		}
+		checker, err := newCodeChecker(context.Background(), *cfg.CodeCheck, creationNodeURL)
+		if err != nil {
+			return nil, err
+		}
+		metadata.codeChecker = checker
+		if metrics != nil {
+			metrics.RegisterCounter(MetricCodeChecks, "count of contract code requests by background code check", "result")
+		}
 	}
 
 	metadata.pool = workerpool.NewTimedPool(
@@ -77,6 +229,39 @@ func NewMetadata(
 // Start -
 func (metadata *Metadata) Start(ctx context.Context) {
 	metadata.pool.Start(ctx)
+
+	if metadata.backfillInterfaces {
+		metadata.wg.Add(1)
+		go metadata.backfill(ctx)
+	}
+
+	if metadata.refresher != nil {
+		metadata.wg.Add(1)
+		go metadata.refresh(ctx)
+	}
+
+	if metadata.codeChecker != nil {
+		metadata.wg.Add(1)
+		go metadata.checkCode(ctx)
+	}
+
+	if contracts := metadata.source.Watch(ctx); contracts != nil {
+		metadata.wg.Add(1)
+		go metadata.watch(ctx, contracts)
+	}
+}
+
+// watch - indexes new contracts of source as soon as they appear
+func (metadata *Metadata) watch(ctx context.Context, contracts <-chan string) {
+	defer metadata.wg.Done()
+
+	for contract := range contracts {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Debug().Str("address", contract).Msg("new contract in source")
+		metadata.pool.AddTask(contract)
+	}
 }
 
 // Name -
@@ -111,11 +296,22 @@ func (metadata *Metadata) errorHandler(ctx context.Context, err error) {
 	log.Err(err).Msg("error during fetching metadata")
 }
 
+// dispatcher - lists contracts of sources. Sources aren't listed in maintenance mode, their new contracts are received by the next listing.
 func (metadata *Metadata) dispatcher(ctx context.Context) ([]string, error) {
+	if metadata.inMaintenance() {
+		return nil, nil
+	}
 	return metadata.source.List(ctx)
 }
 
 func (metadata *Metadata) worker(ctx context.Context, task string) {
+	if !metadata.waitMaintenance(ctx) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, metadata.taskTimeout)
+	defer cancel()
+
 	if err := metadata.processData(ctx, task); err != nil {
 		log.Err(err).Msg("processing metadata error")
 	}
@@ -132,48 +328,121 @@ func (metadata *Metadata) processData(ctx context.Context, address string) error
 
 	log.Info().Str("address", address).Msg("new metadata was found")
 
-	data, err := metadata.source.Get(ctx, address)
+	fetchedAt := time.Now().UTC()
+	results, err := metadata.fetch(ctx, address)
 	if err != nil {
 		return errors.Wrap(err, address)
 	}
+	if err := metadata.CheckSize(address, results[0].Metadata); err != nil {
+		return err
+	}
 
 	model := models.Metadata{
-		Contract: address,
-		Metadata: data,
+		Contract: strings.ToLower(address),
+		Metadata: results[0].Metadata,
+		Source:   string(results[0].Source),
+
+		LastFetchAttempt: fetchedAt,
 	}
+	setCompilation(&model, results[0].Compilation)
 
-	machine, err := vm.Factory(metadata.vmType, model.Metadata)
+	parsed, err := metadata.build(&model)
 	if err != nil {
 		return err
 	}
 
+	metadata.resolveCreation(ctx, &model)
+
+	if err := metadata.save(ctx, &model, parsed); err != nil {
+		return err
+	}
+	if err := metadata.saveCandidates(ctx, model.Contract, results); err != nil {
+		log.Err(err).Str("address", model.Contract).Msg("saving ABI candidates")
+	}
+
+	metadata.output.Push(&model)
+
+	return nil
+}
+
+// content - entries parsed from ABI which are stored in separate tables
+type content struct {
+	methods []models.Method
+	events  []models.Event
+	errors  []models.Error
+}
+
+// build - parses ABI of the model and fills fields derived from it. Parsed methods, events and errors are returned except entries of disabled indexes.
+func (metadata *Metadata) build(model *models.Metadata) (*content, error) {
+	machine, err := vm.Factory(metadata.vmType, model.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	schema, err := machine.JSONSchema()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	model.JSONSchema = schema
 
 	methods, err := machine.Methods()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	events, err := machine.Events()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	model.IsComplete = isComplete(methods, events)
 
-	if err := metadata.save(ctx, model, methods, events); err != nil {
-		return err
+	selectors := make([][]byte, len(methods))
+	for i := range methods {
+		selectors[i] = methods[i].SignatureID
+	}
+	topics := make([][]byte, len(events))
+	for i := range events {
+		topics[i] = events[i].SignatureID
 	}
+	model.Fingerprint = models.Fingerprint(selectors, topics)
 
-	metadata.output.Push(&model)
+	abiErrors, err := machine.Errors()
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	interfaces, err := machine.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	model.Interfaces = interfaces
+	model.IsProxy = evm.DetectProxy(methods, events)
+
+	parsed := &content{
+		methods: methods,
+		events:  events,
+		errors:  abiErrors,
+	}
+	if !metadata.Indexed(IndexMethods) {
+		parsed.methods = nil
+	}
+	if !metadata.Indexed(IndexEvents) {
+		parsed.events = nil
+	}
+	if !metadata.Indexed(IndexErrors) {
+		parsed.errors = nil
+	}
+	return parsed, nil
 }
 
-func (metadata *Metadata) save(ctx context.Context, model models.Metadata, methods []models.Method, events []models.Event) error {
+// Indexed - checks if auxiliary table is written on ingest
+func (metadata *Metadata) Indexed(index Index) bool {
+	_, disabled := metadata.disabledIndexes[index]
+	return !disabled
+}
+
+func (metadata *Metadata) save(ctx context.Context, model *models.Metadata, parsed *content) error {
 	tx, err := metadata.transactable.BeginTransaction(ctx)
 	if err != nil {
 		return err
@@ -185,44 +454,401 @@ func (metadata *Metadata) save(ctx context.Context, model models.Metadata, metho
 		}
 	}()
 
-	if err := tx.Add(ctx, &model); err != nil {
+	if err := tx.Add(ctx, model); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
-	if len(methods) > 0 {
-		data := make([]any, len(methods))
-		for i := range methods {
-			methods[i].MetadataID = model.ID
-			data[i] = &methods[i]
+	if err := saveContent(ctx, tx, model.ID, parsed); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	return tx.Flush(ctx)
+}
+
+// saveContent - saves methods, events and errors of metadata in transaction
+func saveContent(ctx context.Context, tx models.Transaction, id uint64, parsed *content) error {
+	data := make([]any, 0, len(parsed.methods)+len(parsed.events)+len(parsed.errors))
+	for i := range parsed.methods {
+		parsed.methods[i].MetadataID = id
+		data = append(data, &parsed.methods[i])
+	}
+	if err := bulkSave(ctx, tx, data); err != nil {
+		return err
+	}
+
+	data = data[:0]
+	for i := range parsed.events {
+		parsed.events[i].MetadataID = id
+		data = append(data, &parsed.events[i])
+	}
+	if err := bulkSave(ctx, tx, data); err != nil {
+		return err
+	}
+
+	data = data[:0]
+	for i := range parsed.errors {
+		parsed.errors[i].MetadataID = id
+		data = append(data, &parsed.errors[i])
+	}
+	return bulkSave(ctx, tx, data)
+}
+
+func bulkSave(ctx context.Context, tx models.Transaction, data []any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return tx.BulkSave(ctx, data)
+}
+
+// resolveCreation - fills information about contract deployment. Contract is saved without it if creation can't be resolved.
+func (metadata *Metadata) resolveCreation(ctx context.Context, model *models.Metadata) {
+	if metadata.creation == nil {
+		return
+	}
+	info, err := metadata.creation.Resolve(ctx, model.Contract)
+	if err != nil {
+		log.Warn().Err(err).Str("address", model.Contract).Msg("resolving contract creation")
+		return
+	}
+	model.Creator = info.Creator
+	model.CreationTx = info.TxHash
+	model.CreatedAt = info.Timestamp
+	model.CreationBlock = info.Block
+	model.Factory = info.Factory
+	model.CodeHash = info.CodeHash
+}
+
+// ResolveFactory - returns address of factory which deployed the contract. Empty string is returned if contract wasn't deployed by factory or creation resolver isn't configured.
+func (metadata *Metadata) ResolveFactory(ctx context.Context, address string) (string, error) {
+	if metadata.creation == nil {
+		return "", nil
+	}
+	info, err := metadata.creation.Resolve(ctx, address)
+	if err != nil {
+		if errors.Is(err, creation.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return info.Factory, nil
+}
+
+// CheckSize - checks ABI doesn't exceed configured maximum size. Rejections are logged and counted.
+func (metadata *Metadata) CheckSize(address string, data []byte) error {
+	if metadata.maxABISize <= 0 || len(data) <= metadata.maxABISize {
+		return nil
+	}
+
+	log.Warn().Str("address", address).Int("size", len(data)).Int("max_size", metadata.maxABISize).Msg("ABI is rejected: too large")
+	if metadata.metrics != nil {
+		metadata.metrics.IncrementCounter(MetricRejectedABI, map[string]string{"reason": "too_large"})
+	}
+	return errors.Wrapf(ErrTooLargeABI, "%s: size is %d bytes, maximum is %d bytes", address, len(data), metadata.maxABISize)
+}
+
+// RefreshInterfaces - detects standard interfaces of the contract again and saves them
+func (metadata *Metadata) RefreshInterfaces(ctx context.Context, address string) ([]string, error) {
+	model, err := metadata.repo.GetByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := metadata.refreshInterfaces(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.Interfaces, nil
+}
+
+func (metadata *Metadata) refreshInterfaces(ctx context.Context, model *models.Metadata) error {
+	machine, err := vm.Factory(metadata.vmType, model.Metadata)
+	if err != nil {
+		return err
+	}
+	interfaces, err := machine.Interfaces()
+	if err != nil {
+		return err
+	}
+	isProxy, err := machine.IsProxy()
+	if err != nil {
+		return err
+	}
+	model.Interfaces = interfaces
+	model.IsProxy = isProxy
+	return metadata.repo.Update(ctx, model)
+}
+
+// AnalyzeToken - checks ABI of the contract against ERC20 standard
+func (metadata *Metadata) AnalyzeToken(ctx context.Context, address string) (*evm.TokenAnalysis, error) {
+	model, err := metadata.repo.GetByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	machine, err := vm.Factory(metadata.vmType, model.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return machine.AnalyzeToken()
+}
+
+// DecodeConstructorArgs - unpacks constructor arguments of the contract by its stored ABI which is read from repo, so server can pass replica or cached reader. If candidates are enabled, they are tried in order of preference.
+func (metadata *Metadata) DecodeConstructorArgs(ctx context.Context, repo models.IMetadata, address string, data []byte) ([]evm.Argument, error) {
+	return decode(ctx, metadata, repo, address, func(machine vm.VirtualMachine) ([]evm.Argument, error) {
+		return machine.DecodeConstructorArgs(data)
+	})
+}
+
+// DecodeError - decodes revert data by custom errors of the contract ABI. If candidates are enabled, they are tried in order of preference. Standard `Error(string)` and `Panic(uint256)` are decoded without ABI, so address can be empty for them.
+func (metadata *Metadata) DecodeError(ctx context.Context, repo models.IMetadata, address string, data []byte) (*evm.DecodedError, error) {
+	if evm.IsStandardError(data) {
+		return evm.DecodeStandardError(data)
+	}
+	return decode(ctx, metadata, repo, address, func(machine vm.VirtualMachine) (*evm.DecodedError, error) {
+		return machine.DecodeError(data)
+	})
+}
+
+// InputSchema - builds JSON schema of inputs of the contract method with the selector by ABI which is read from repo
+func (metadata *Metadata) InputSchema(ctx context.Context, repo models.IMetadata, address string, selector []byte) (*evm.MethodSchema, error) {
+	model, err := repo.GetByAddress(ctx, address, models.ColumnMetadata, "is_complete")
+	if err != nil {
+		return nil, err
+	}
+	machine, err := vm.Factory(metadata.vmType, model.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := machine.InputSchema(selector)
+	if err != nil && !model.IsComplete {
+		return nil, &IncompleteABIError{Err: err}
+	}
+	return schema, err
+}
+
+// PutResult - result of manual metadata write
+type PutResult struct {
+	Metadata *models.Metadata
+	// Created - true if the contract wasn't indexed before
+	Created bool
+	// Conflicts - signatures of entries which were skipped during merge because already stored entries with the same selector differ
+	Conflicts []string
+	// Unchanged - result ABI declares the same entries as stored one, so it wasn't written. It's set only if writes dedup is enabled.
+	Unchanged bool
+}
+
+// VersionConflictError - conditional write is rejected because stored metadata has another hash than expected. Current is hash of stored metadata, it's empty if the contract isn't indexed.
+type VersionConflictError struct {
+	Current []byte
+}
+
+// Error -
+func (e *VersionConflictError) Error() string {
+	if len(e.Current) == 0 {
+		return ErrVersionConflict.Error() + ": contract isn't indexed"
+	}
+	return ErrVersionConflict.Error() + ": current hash is " + hexutil.Encode(e.Current)
+}
+
+// Unwrap -
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// PutMetadata - saves ABI of the contract passed by caller. If the contract is already indexed, its ABI is overwritten or, if merge is set, entries absent in stored ABI are appended to it. Stored entries take precedence on merge, conflicting passed entries are skipped and returned. Update is pushed to output as on refresh.
+//
+// If ifMatch is set, write is conditional: it's applied only if hash of stored metadata is equal to ifMatch, otherwise *VersionConflictError with current hash is returned. Stored row is locked until write is committed, so concurrent writers which expect the same hash can't both succeed.
+func (metadata *Metadata) PutMetadata(ctx context.Context, address string, data []byte, merge bool, ifMatch []byte) (*PutResult, error) {
+	address = strings.ToLower(address)
+	if err := metadata.CheckSize(address, data); err != nil {
+		return nil, err
+	}
+	data = metadata.dedup(address, data)
+
+	model, err := metadata.repo.GetByAddress(ctx, address)
+	switch {
+	case err == nil:
+	case metadata.repo.IsNoRows(err):
+		if ifMatch != nil {
+			return nil, &VersionConflictError{}
 		}
+		return metadata.putNew(ctx, address, data)
+	default:
+		return nil, err
+	}
+	if ifMatch != nil && !bytes.Equal(model.Hash, ifMatch) {
+		return nil, &VersionConflictError{Current: model.Hash}
+	}
 
-		if err := tx.BulkSave(ctx, data); err != nil {
-			return tx.HandleError(ctx, err)
+	result := &PutResult{
+		Metadata:  model,
+		Conflicts: make([]string, 0),
+	}
+	if merge {
+		merged, err := evm.MergeABI(model.Metadata, data)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidABI, err.Error())
 		}
+		if err := metadata.CheckSize(address, merged.ABI); err != nil {
+			return nil, err
+		}
+		data = merged.ABI
+		result.Conflicts = merged.Conflicts
+	}
+
+	if metadata.isDuplicate(model, data, "put") {
+		result.Unchanged = true
+		return result, nil
 	}
 
-	if len(events) > 0 {
-		data := make([]any, len(events))
-		for i := range events {
-			events[i].MetadataID = model.ID
-			data[i] = &events[i]
+	model.Metadata = data
+	model.Source = string(sources.ManualType)
+	setCompilation(model, nil)
+	parsed, err := metadata.build(model)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidABI, err.Error())
+	}
+	if err := metadata.replace(ctx, model, parsed, ifMatch); err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, metadata.versionConflict(ctx, address)
 		}
+		return nil, err
+	}
+	if err := metadata.saveCandidates(ctx, address, []sources.Result{{Source: sources.ManualType, Metadata: data}}); err != nil {
+		log.Err(err).Str("address", address).Msg("saving ABI candidates")
+	}
 
-		if err := tx.BulkSave(ctx, data); err != nil {
-			return tx.HandleError(ctx, err)
+	log.Info().Str("address", address).Bool("merge", merge).Int("conflicts", len(result.Conflicts)).Msg("metadata was put manually")
+	metadata.output.Push(&Updated{model})
+	return result, nil
+}
+
+// setCompilation - sets compilation details received from source. They are cleared if source doesn't provide them, so details of previous ABI aren't kept.
+func setCompilation(model *models.Metadata, compilation *sources.Compilation) {
+	if compilation == nil {
+		compilation = &sources.Compilation{}
+	}
+	model.CompilerVersion = compilation.CompilerVersion
+	model.OptimizerEnabled = compilation.OptimizerEnabled
+	model.OptimizerRuns = compilation.OptimizerRuns
+	model.EVMVersion = compilation.EVMVersion
+	model.License = compilation.License
+}
+
+// versionConflict - returns conflict error with hash of metadata which was written concurrently
+func (metadata *Metadata) versionConflict(ctx context.Context, address string) error {
+	current, err := metadata.repo.GetByAddress(ctx, address)
+	if err != nil {
+		if metadata.repo.IsNoRows(err) {
+			return &VersionConflictError{}
 		}
+		return err
+	}
+	return &VersionConflictError{Current: current.Hash}
+}
+
+func (metadata *Metadata) putNew(ctx context.Context, address string, data []byte) (*PutResult, error) {
+	model := models.Metadata{
+		Contract: address,
+		Metadata: data,
+		Source:   string(sources.ManualType),
 	}
 
-	return tx.Flush(ctx)
+	parsed, err := metadata.build(&model)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidABI, err.Error())
+	}
+
+	metadata.resolveCreation(ctx, &model)
+
+	if err := metadata.save(ctx, &model, parsed); err != nil {
+		return nil, err
+	}
+	if err := metadata.saveCandidates(ctx, address, []sources.Result{{Source: sources.ManualType, Metadata: data}}); err != nil {
+		log.Err(err).Str("address", address).Msg("saving ABI candidates")
+	}
+
+	log.Info().Str("address", address).Msg("metadata was put manually")
+	metadata.output.Push(&model)
+	return &PutResult{
+		Metadata:  &model,
+		Created:   true,
+		Conflicts: make([]string, 0),
+	}, nil
+}
+
+// isDuplicate - checks if ABI declares the same entries as stored ABI of the model. It's always false if writes dedup is disabled. Skipped write is counted by path.
+func (metadata *Metadata) isDuplicate(model *models.Metadata, data []byte, path string) bool {
+	if !metadata.dedupWrites || len(model.Metadata) == 0 {
+		return false
+	}
+
+	stored, err := evm.ABIHash(model.Metadata)
+	if err != nil {
+		return false
+	}
+	received, err := evm.ABIHash(data)
+	if err != nil || !bytes.Equal(stored, received) {
+		return false
+	}
+
+	log.Debug().Str("address", model.Contract).Str("path", path).Msg("write of the same ABI was skipped")
+	if metadata.metrics != nil {
+		metadata.metrics.IncrementCounter(MetricDedupedWrites, map[string]string{"path": path})
+	}
+	return true
+}
+
+// backfill - detects interfaces of contracts which were indexed before interfaces detection was released
+func (metadata *Metadata) backfill(ctx context.Context) {
+	defer metadata.wg.Done()
+
+	var lastID uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		if !metadata.waitMaintenance(ctx) {
+			return
+		}
+
+		batch, err := metadata.repo.ListWithoutInterfaces(ctx, lastID, 100)
+		if err != nil {
+			log.Err(err).Msg("receiving metadata without interfaces")
+			return
+		}
+		if len(batch) == 0 {
+			log.Info().Msg("interfaces backfill is finished")
+			return
+		}
+
+		for i := range batch {
+			if err := metadata.refreshInterfaces(ctx, batch[i]); err != nil {
+				log.Err(err).Str("address", batch[i].Contract).Msg("interfaces backfill")
+			}
+			lastID = batch[i].ID
+		}
+	}
 }
 
 // Close -
 func (metadata *Metadata) Close() error {
 	log.Info().Msg("closing metadata indexer...")
 
+	metadata.wg.Wait()
+
 	if err := metadata.pool.Close(); err != nil {
 		return err
 	}
 
+	if metadata.creation != nil {
+		if err := metadata.creation.Close(); err != nil {
+			return err
+		}
+	}
+
+	if metadata.codeChecker != nil {
+		metadata.codeChecker.node.Close()
+	}
+
 	return nil
 }
@@ -0,0 +1,238 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/sources"
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/storage/memory"
+	"github.com/dipdup-net/abi-indexer/internal/vm"
+	"github.com/dipdup-net/abi-indexer/internal/vm/evm"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+)
+
+func TestSaveErrors(t *testing.T) {
+	const abi = `[
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+		{"type":"error","name":"Unauthorized","inputs":[]},
+		{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]}
+	]`
+	ctx := context.Background()
+	store := memory.New()
+	metadata := &Metadata{
+		vmType:       vm.TypeEVM,
+		transactable: store.Transactable,
+	}
+
+	model := &models.Metadata{Contract: "0x5fbdb2315678afecb367f032d93f642f64180aa3", Metadata: []byte(abi)}
+	parsed, err := metadata.build(model)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if err := metadata.save(ctx, model, parsed); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	for signature, selector := range map[string][]byte{
+		"InsufficientBalance(uint256,uint256)": {0xcf, 0x47, 0x91, 0x81},
+		"Unauthorized()":                       {0x82, 0xb4, 0x29, 0x00},
+	} {
+		response, err := store.Metadata.GetByErrorSelector(ctx, selector, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc)
+		if err != nil {
+			t.Fatalf("GetByErrorSelector: %v", err)
+		}
+		if len(response) != 1 || response[0].Contract != model.Contract {
+			t.Fatalf("%s: got %d contracts, want %s", signature, len(response), model.Contract)
+		}
+	}
+
+	// method selector isn't stored as error
+	response, err := store.Metadata.GetByErrorSelector(ctx, []byte{0xa9, 0x05, 0x9c, 0xbb}, models.MetadataFilter{}, 10, 0, storage.SortOrderAsc)
+	if err != nil {
+		t.Fatalf("GetByErrorSelector: %v", err)
+	}
+	if len(response) != 0 {
+		t.Fatalf("method selector is found as error of %d contracts", len(response))
+	}
+}
+
+// newStalledMetadata - returns module with memory storage which source doesn't respond until request is cancelled. Cancelled requests are sent to channel.
+func newStalledMetadata(t *testing.T, taskTimeout time.Duration) (*Metadata, *memory.Storage, <-chan struct{}) {
+	t.Helper()
+	cancelled := make(chan struct{}, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		cancelled <- struct{}{}
+	}))
+	t.Cleanup(server.Close)
+
+	source, err := sources.NewRegistry([]sources.Type{sources.SourcifyType}, sources.FactoryParams{
+		Sourcify: &sources.SourcifyConfig{BaseURL: server.URL, ChainID: 1},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	store := memory.New()
+	metadata := &Metadata{
+		repo:         store.Metadata,
+		transactable: store.Transactable,
+		source:       source,
+		vmType:       vm.TypeEVM,
+		refresher:    newRefresher(RefreshConfig{}),
+		taskTimeout:  taskTimeout,
+	}
+	metadata.maintenance.Store(&Maintenance{})
+	return metadata, store, cancelled
+}
+
+func TestWorkerTimeout(t *testing.T) {
+	metadata, store, cancelled := newStalledMetadata(t, 50*time.Millisecond)
+
+	start := time.Now()
+	metadata.worker(context.Background(), "0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("task took %s, timeout is %s", elapsed, metadata.taskTimeout)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("request to source isn't cancelled by task timeout")
+	}
+	if _, err := store.Metadata.GetByAddress(context.Background(), "0x5fbdb2315678afecb367f032d93f642f64180aa3"); !store.Metadata.IsNoRows(err) {
+		t.Fatalf("metadata is saved after timeout: %v", err)
+	}
+}
+
+func TestNewMetadataTaskTimeout(t *testing.T) {
+	for _, tt := range []struct {
+		taskTimeout int
+		want        time.Duration
+	}{
+		{0, defaultTaskTimeout},
+		{5, 5 * time.Second},
+	} {
+		store := memory.New()
+		metadata, err := NewMetadata(Config{
+			Sources:     []sources.Type{sources.SourcifyType},
+			Sourcify:    &sources.SourcifyConfig{BaseURL: "http://localhost", ChainID: 1},
+			VM:          &vm.Config{Type: vm.TypeEVM},
+			TaskTimeout: tt.taskTimeout,
+		}, store.Metadata, store.Events, store.Methods, store.Candidates, store.Signatures, store.Transactable, nil)
+		if err != nil {
+			t.Fatalf("NewMetadata: %v", err)
+		}
+		if metadata.taskTimeout != tt.want {
+			t.Fatalf("task timeout of %d seconds = %s, want %s", tt.taskTimeout, metadata.taskTimeout, tt.want)
+		}
+	}
+}
+
+func TestDecodeReadsGivenRepo(t *testing.T) {
+	const abi = `[
+		{"type":"error","name":"Unauthorized","inputs":[]},
+		{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]}
+	]`
+	const address = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	ctx := context.Background()
+	primary := memory.New()
+	replica := memory.New()
+	if err := replica.Metadata.Save(ctx, &models.Metadata{Contract: address, Metadata: []byte(abi)}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	// contract exists in the given repo only, so decoding fails if indexer reads its own repo
+	metadata := &Metadata{vmType: vm.TypeEVM, repo: primary.Metadata}
+
+	decoded, err := metadata.DecodeError(ctx, replica.Metadata, address, []byte{0x82, 0xb4, 0x29, 0x00})
+	if err != nil {
+		t.Fatalf("DecodeError: %v", err)
+	}
+	if decoded.Name != "Unauthorized" {
+		t.Fatalf("DecodeError name = %s, want Unauthorized", decoded.Name)
+	}
+	if _, err := metadata.DecodeConstructorArgs(ctx, replica.Metadata, address, nil); err != nil {
+		t.Fatalf("DecodeConstructorArgs: %v", err)
+	}
+	schema, err := metadata.InputSchema(ctx, replica.Metadata, address, []byte{0xa9, 0x05, 0x9c, 0xbb})
+	if err != nil {
+		t.Fatalf("InputSchema: %v", err)
+	}
+	if schema.Signature != "transfer(address,uint256)" {
+		t.Fatalf("InputSchema signature = %s, want transfer(address,uint256)", schema.Signature)
+	}
+	if _, err := metadata.InputSchema(ctx, primary.Metadata, address, []byte{0xa9, 0x05, 0x9c, 0xbb}); err == nil {
+		t.Fatal("InputSchema of contract missing in repo succeeded")
+	}
+}
+
+func TestBuildIsComplete(t *testing.T) {
+	tests := []struct {
+		name string
+		abi  string
+		want bool
+	}{
+		{"method", `[{"type":"function","name":"foo","stateMutability":"view","inputs":[],"outputs":[]}]`, true},
+		{"event", `[{"type":"event","name":"Foo","anonymous":false,"inputs":[]}]`, true},
+		{"constructor", `[{"type":"constructor","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"}]}]`, false},
+		{"fallback and errors", `[{"type":"fallback","stateMutability":"payable"},{"type":"error","name":"Unauthorized","inputs":[]}]`, false},
+	}
+	metadata := &Metadata{vmType: vm.TypeEVM}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &models.Metadata{Contract: "0x5fbdb2315678afecb367f032d93f642f64180aa3", Metadata: []byte(tt.abi)}
+			if _, err := metadata.build(model); err != nil {
+				t.Fatalf("build: %v", err)
+			}
+			if model.IsComplete != tt.want {
+				t.Fatalf("IsComplete = %v, want %v", model.IsComplete, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeIncompleteABI(t *testing.T) {
+	const (
+		incomplete = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+		complete   = "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"
+	)
+	ctx := context.Background()
+	store := memory.New()
+	metadata := &Metadata{vmType: vm.TypeEVM, repo: store.Metadata}
+	for address, abi := range map[string]string{
+		incomplete: `[{"type":"constructor","stateMutability":"nonpayable","inputs":[]},{"type":"error","name":"Unauthorized","inputs":[]}]`,
+		complete:   `[{"type":"function","name":"foo","stateMutability":"view","inputs":[],"outputs":[]}]`,
+	} {
+		model := &models.Metadata{Contract: address, Metadata: []byte(abi)}
+		if _, err := metadata.build(model); err != nil {
+			t.Fatalf("build: %v", err)
+		}
+		if err := store.Metadata.Save(ctx, model); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	// entries which incomplete ABI declares are still decoded
+	if _, err := metadata.DecodeError(ctx, store.Metadata, incomplete, []byte{0x82, 0xb4, 0x29, 0x00}); err != nil {
+		t.Fatalf("DecodeError of declared error: %v", err)
+	}
+	if _, err := metadata.DecodeConstructorArgs(ctx, store.Metadata, incomplete, nil); err != nil {
+		t.Fatalf("DecodeConstructorArgs: %v", err)
+	}
+
+	_, err := metadata.DecodeError(ctx, store.Metadata, incomplete, []byte{0xde, 0xad, 0xbe, 0xef})
+	if !errors.Is(err, ErrIncompleteABI) || !errors.Is(err, evm.ErrUnknownSelector) {
+		t.Fatalf("DecodeError by incomplete ABI: %v", err)
+	}
+	if _, err := metadata.InputSchema(ctx, store.Metadata, incomplete, []byte{0xc2, 0x98, 0x55, 0x78}); !errors.Is(err, ErrIncompleteABI) {
+		t.Fatalf("InputSchema by incomplete ABI: %v", err)
+	}
+
+	_, err = metadata.DecodeError(ctx, store.Metadata, complete, []byte{0xde, 0xad, 0xbe, 0xef})
+	if errors.Is(err, ErrIncompleteABI) || !errors.Is(err, evm.ErrUnknownSelector) {
+		t.Fatalf("DecodeError by complete ABI: %v", err)
+	}
+}
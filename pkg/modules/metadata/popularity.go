@@ -0,0 +1,56 @@
+package metadata
+
+import (
+	"sort"
+	"sync"
+)
+
+// maxTrackedContracts - maximum count of contracts which popularity is tracked between refresh iterations
+const maxTrackedContracts = 10000
+
+// popularity - counts queries of contracts. Counters are reset when the most popular contracts are taken.
+type popularity struct {
+	hits map[string]uint64
+	mx   sync.Mutex
+}
+
+func newPopularity() *popularity {
+	return &popularity{
+		hits: make(map[string]uint64),
+	}
+}
+
+// Hit - counts query of the contract. New contracts are ignored if limit of tracked contracts is reached.
+func (p *popularity) Hit(address string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if _, ok := p.hits[address]; !ok && len(p.hits) >= maxTrackedContracts {
+		return
+	}
+	p.hits[address]++
+}
+
+// Top - returns at most n the most queried contracts and resets counters
+func (p *popularity) Top(n int) []string {
+	p.mx.Lock()
+	hits := p.hits
+	p.hits = make(map[string]uint64)
+	p.mx.Unlock()
+
+	addresses := make([]string, 0, len(hits))
+	for address := range hits {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		if hits[addresses[i]] == hits[addresses[j]] {
+			return addresses[i] < addresses[j]
+		}
+		return hits[addresses[i]] > hits[addresses[j]]
+	})
+
+	if len(addresses) > n {
+		addresses = addresses[:n]
+	}
+	return addresses
+}
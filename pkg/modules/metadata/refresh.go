@@ -0,0 +1,330 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/sources"
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	MetricRefreshed = "abi_indexer_refreshed_total"
+)
+
+// errors
+var (
+	ErrRefreshDisabled = errors.New("background refresh is disabled")
+)
+
+// Updated - message which is pushed to output when ABI of already indexed contract was changed in source
+type Updated struct {
+	*models.Metadata
+}
+
+// RefreshStatus - state of background refresh
+type RefreshStatus struct {
+	Enabled bool
+	Paused  bool
+	LastRun time.Time
+	// Checked and Updated - count of contracts which were fetched from source and which ABI was changed since start
+	Checked uint64
+	Updated uint64
+}
+
+type refresher struct {
+	staleAfter time.Duration
+	interval   time.Duration
+	rateLimit  int
+	batchSize  int
+
+	popularity *popularity
+	paused     atomic.Bool
+	lastRun    atomic.Int64
+	checked    atomic.Uint64
+	updated    atomic.Uint64
+}
+
+func newRefresher(cfg RefreshConfig) *refresher {
+	r := &refresher{
+		staleAfter: time.Duration(cfg.StaleAfter) * time.Second,
+		interval:   time.Minute,
+		rateLimit:  1,
+		batchSize:  100,
+		popularity: newPopularity(),
+	}
+	if cfg.Interval > 0 {
+		r.interval = time.Duration(cfg.Interval) * time.Second
+	}
+	if cfg.RateLimit > 0 {
+		r.rateLimit = cfg.RateLimit
+	}
+	if cfg.BatchSize > 0 {
+		r.batchSize = cfg.BatchSize
+	}
+	return r
+}
+
+// TrackQuery - counts query of the contract to refresh frequently queried contracts first
+func (metadata *Metadata) TrackQuery(address string) {
+	if metadata.refresher == nil {
+		return
+	}
+	metadata.refresher.popularity.Hit(address)
+}
+
+// PauseRefresh - stops background refresh until it's resumed. Refresh of current contract is finished.
+func (metadata *Metadata) PauseRefresh() error {
+	if metadata.refresher == nil {
+		return ErrRefreshDisabled
+	}
+	if !metadata.refresher.paused.Swap(true) {
+		log.Info().Msg("background refresh is paused")
+	}
+	return nil
+}
+
+// ResumeRefresh - resumes paused background refresh
+func (metadata *Metadata) ResumeRefresh() error {
+	if metadata.refresher == nil {
+		return ErrRefreshDisabled
+	}
+	if metadata.refresher.paused.Swap(false) {
+		log.Info().Msg("background refresh is resumed")
+	}
+	return nil
+}
+
+// RefreshStatus - returns state of background refresh
+func (metadata *Metadata) RefreshStatus() RefreshStatus {
+	r := metadata.refresher
+	if r == nil {
+		return RefreshStatus{}
+	}
+
+	status := RefreshStatus{
+		Enabled: true,
+		Paused:  r.paused.Load(),
+		Checked: r.checked.Load(),
+		Updated: r.updated.Load(),
+	}
+	if lastRun := r.lastRun.Load(); lastRun > 0 {
+		status.LastRun = time.Unix(lastRun, 0).UTC()
+	}
+	return status
+}
+
+// refresh - periodically fetches stale metadata from source again and saves changed ABI
+func (metadata *Metadata) refresh(ctx context.Context) {
+	defer metadata.wg.Done()
+
+	ticker := time.NewTicker(metadata.refresher.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if metadata.refresher.paused.Load() || metadata.inMaintenance() {
+				continue
+			}
+			if err := metadata.refreshStale(ctx); err != nil {
+				log.Err(err).Msg("refreshing stale metadata")
+			}
+		}
+	}
+}
+
+func (metadata *Metadata) refreshStale(ctx context.Context) error {
+	r := metadata.refresher
+	r.lastRun.Store(time.Now().Unix())
+
+	batch, err := metadata.staleBatch(ctx, time.Now().Add(-r.staleAfter))
+	if err != nil {
+		return err
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	limiter := time.NewTicker(time.Second / time.Duration(r.rateLimit))
+	defer limiter.Stop()
+
+	for i := range batch {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-limiter.C:
+		}
+		if r.paused.Load() || metadata.inMaintenance() {
+			return nil
+		}
+
+		if err := metadata.refreshTask(ctx, batch[i]); err != nil {
+			log.Err(err).Str("address", batch[i].Contract).Msg("refreshing metadata")
+			metadata.countRefresh("failed")
+		}
+	}
+	return nil
+}
+
+// staleBatch - returns stale metadata to refresh. Frequently queried contracts go first, then the oldest ones.
+func (metadata *Metadata) staleBatch(ctx context.Context, before time.Time) ([]*models.Metadata, error) {
+	r := metadata.refresher
+
+	batch := make([]*models.Metadata, 0, r.batchSize)
+	ids := make(map[uint64]struct{})
+
+	for _, address := range r.popularity.Top(r.batchSize) {
+		model, err := metadata.repo.GetByAddress(ctx, address)
+		if err != nil {
+			if metadata.repo.IsNoRows(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !isStale(model, before) {
+			continue
+		}
+		batch = append(batch, model)
+		ids[model.ID] = struct{}{}
+	}
+
+	if len(batch) >= r.batchSize {
+		return batch, nil
+	}
+
+	oldest, err := metadata.repo.ListStale(ctx, before, uint64(r.batchSize))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(oldest) && len(batch) < r.batchSize; i++ {
+		if _, ok := ids[oldest[i].ID]; ok {
+			continue
+		}
+		batch = append(batch, oldest[i])
+	}
+	return batch, nil
+}
+
+func isStale(model *models.Metadata, before time.Time) bool {
+	checked := model.RefreshedAt
+	if checked.IsZero() {
+		checked = model.UpdatedAt
+	}
+	return checked.Before(before)
+}
+
+// refreshTask - refreshes the contract bounded by task timeout
+func (metadata *Metadata) refreshTask(ctx context.Context, model *models.Metadata) error {
+	ctx, cancel := context.WithTimeout(ctx, metadata.taskTimeout)
+	defer cancel()
+
+	return metadata.refreshContract(ctx, model)
+}
+
+// refreshContract - fetches ABI of the contract from source. If candidates are enabled, all candidates are fetched again and the preferred one is compared with stored ABI. If ABI was changed, methods, events and errors are replaced and update is pushed to output.
+func (metadata *Metadata) refreshContract(ctx context.Context, model *models.Metadata) error {
+	metadata.refresher.checked.Add(1)
+
+	now := time.Now().UTC()
+	results, err := metadata.fetch(ctx, model.Contract)
+	if err != nil {
+		// contract is marked as refreshed to not retry it on every iteration
+		if markErr := metadata.repo.MarkRefreshed(ctx, model.ID, now); markErr != nil {
+			return markErr
+		}
+		return errors.Wrap(err, model.Contract)
+	}
+	if err := metadata.saveCandidates(ctx, model.Contract, results); err != nil {
+		return err
+	}
+	// ABI put manually is preferred over candidates of sources
+	if metadata.candidates != nil && model.Source == string(sources.ManualType) {
+		metadata.countRefresh("unchanged")
+		return metadata.repo.MarkRefreshed(ctx, model.ID, now)
+	}
+	data := results[0].Metadata
+
+	if bytes.Equal(data, model.Metadata) || metadata.isDuplicate(model, data, "refresh") {
+		metadata.countRefresh("unchanged")
+		return metadata.repo.MarkRefreshed(ctx, model.ID, now)
+	}
+
+	if err := metadata.CheckSize(model.Contract, data); err != nil {
+		if markErr := metadata.repo.MarkRefreshed(ctx, model.ID, now); markErr != nil {
+			return markErr
+		}
+		return err
+	}
+
+	model.Metadata = data
+	model.Source = string(results[0].Source)
+	setCompilation(model, results[0].Compilation)
+	parsed, err := metadata.build(model)
+	if err != nil {
+		if markErr := metadata.repo.MarkRefreshed(ctx, model.ID, now); markErr != nil {
+			return markErr
+		}
+		return err
+	}
+	model.RefreshedAt = now
+	model.LastFetchAttempt = now
+
+	if err := metadata.replace(ctx, model, parsed, nil); err != nil {
+		return err
+	}
+
+	log.Info().Str("address", model.Contract).Msg("metadata was changed in source")
+	metadata.refresher.updated.Add(1)
+	metadata.countRefresh("updated")
+	metadata.output.Push(&Updated{model})
+	return nil
+}
+
+// replace - updates metadata and replaces its methods, events and errors in one transaction. If ifMatch is set, the row is locked first and ErrVersionConflict is returned if its hash differs.
+func (metadata *Metadata) replace(ctx context.Context, model *models.Metadata, parsed *content, ifMatch []byte) error {
+	tx, err := metadata.transactable.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Close(ctx); err != nil {
+			log.Err(err).Msg("closing postgres transaction error")
+		}
+	}()
+
+	if ifMatch != nil {
+		locked, err := tx.LockHash(ctx, model.ID, ifMatch)
+		if err != nil {
+			return tx.HandleError(ctx, err)
+		}
+		if !locked {
+			return tx.HandleError(ctx, ErrVersionConflict)
+		}
+	}
+	if err := tx.Update(ctx, model); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.DeleteContent(ctx, model.ID); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := saveContent(ctx, tx, model.ID, parsed); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	return tx.Flush(ctx)
+}
+
+func (metadata *Metadata) countRefresh(result string) {
+	if metadata.metrics != nil {
+		metadata.metrics.IncrementCounter(MetricRefreshed, map[string]string{"result": result})
+	}
+}
@@ -0,0 +1,98 @@
+package metadata
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dipdup-net/abi-indexer/internal/sources"
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/storage/memory"
+	"github.com/dipdup-net/abi-indexer/internal/vm"
+)
+
+func TestRefreshTaskTimeout(t *testing.T) {
+	metadata, store, cancelled := newStalledMetadata(t, 50*time.Millisecond)
+	model := &models.Metadata{Contract: "0x5fbdb2315678afecb367f032d93f642f64180aa3", Metadata: []byte(`[]`)}
+	if err := store.Metadata.Save(context.Background(), model); err != nil {
+		t.Fatalf("save metadata: %v", err)
+	}
+
+	start := time.Now()
+	if err := metadata.refreshTask(context.Background(), model); err == nil {
+		t.Fatal("error isn't returned by refresh of stalled source")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("task took %s, timeout is %s", elapsed, metadata.taskTimeout)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("request to source isn't cancelled by task timeout")
+	}
+	if checked := metadata.refresher.checked.Load(); checked != 1 {
+		t.Fatalf("checked = %d, want 1", checked)
+	}
+}
+
+func TestRefreshMarksAttempt(t *testing.T) {
+	const (
+		address = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+		stored  = `[{"type":"function","name":"foo","stateMutability":"view","inputs":[],"outputs":[]}]`
+	)
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{"source doesn't have ABI", ""},
+		{"too large ABI", `[` + string(make([]byte, 200)) + `]`},
+		{"invalid ABI", `{"abi":[]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.source != "" {
+				if err := os.WriteFile(filepath.Join(dir, address+".json"), []byte(tt.source), 0o600); err != nil {
+					t.Fatalf("write ABI: %v", err)
+				}
+			}
+			source, err := sources.NewRegistry([]sources.Type{sources.FSType}, sources.FactoryParams{
+				FS: &sources.FileSystemConfig{Dir: dir},
+			})
+			if err != nil {
+				t.Fatalf("NewRegistry: %v", err)
+			}
+			store := memory.New()
+			metadata := &Metadata{
+				repo:         store.Metadata,
+				transactable: store.Transactable,
+				source:       source,
+				vmType:       vm.TypeEVM,
+				refresher:    newRefresher(RefreshConfig{}),
+				maxABISize:   100,
+				taskTimeout:  time.Second,
+			}
+			model := &models.Metadata{Contract: address, Metadata: []byte(stored)}
+			if err := store.Metadata.Save(context.Background(), model); err != nil {
+				t.Fatalf("save metadata: %v", err)
+			}
+
+			before := time.Now().UTC()
+			if err := metadata.refreshContract(context.Background(), model); err == nil {
+				t.Fatal("error isn't returned")
+			}
+			refreshed, err := store.Metadata.GetByAddress(context.Background(), address)
+			if err != nil {
+				t.Fatalf("GetByAddress: %v", err)
+			}
+			if refreshed.LastFetchAttempt.Before(before) || refreshed.RefreshedAt.Before(before) {
+				t.Fatalf("attempt isn't recorded: last fetch attempt %s, refreshed at %s", refreshed.LastFetchAttempt, refreshed.RefreshedAt)
+			}
+			if string(refreshed.Metadata) != stored {
+				t.Fatalf("stored ABI is replaced by %s", refreshed.Metadata)
+			}
+		})
+	}
+}
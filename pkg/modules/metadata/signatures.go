@@ -0,0 +1,74 @@
+package metadata
+
+import (
+	"context"
+	"strings"
+
+	models "github.com/dipdup-net/abi-indexer/internal/storage"
+	"github.com/dipdup-net/abi-indexer/internal/vm"
+	"github.com/rs/zerolog/log"
+)
+
+// maxImportErrors - count of invalid lines which are reported by import. Others are only counted.
+const maxImportErrors = 100
+
+// ImportResult - counts of processed signatures
+type ImportResult struct {
+	// Total - count of signatures in the list. Blank lines and comments aren't counted.
+	Total int
+	// Inserted - count of new signatures
+	Inserted int
+	// Existing - count of signatures which are known already or repeated in the list
+	Existing int
+	// Invalid - count of lines which aren't valid signatures
+	Invalid int
+	// Errors - descriptions of invalid lines. Only the first of them are reported.
+	Errors []string
+}
+
+// ImportSignatures - parses textual signatures of functions or errors, one per line like 4byte directory dump, and stores ones which are unknown yet with the kind. Imported signatures are returned by reverse lookup of selectors. Blank lines and lines starting with `#` are skipped.
+func (metadata *Metadata) ImportSignatures(ctx context.Context, lines []string, kind models.SignatureKind) (ImportResult, error) {
+	var (
+		result     ImportResult
+		signatures = make([]*models.Signature, 0, len(lines))
+		unique     = make(map[string]struct{}, len(lines))
+	)
+
+	for i := range lines {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		result.Total++
+
+		signature, err := vm.ParseSignature(metadata.vmType, line)
+		if err != nil {
+			result.Invalid++
+			if len(result.Errors) < maxImportErrors {
+				result.Errors = append(result.Errors, err.Error())
+			}
+			continue
+		}
+		if _, ok := unique[signature.Text]; ok {
+			continue
+		}
+		unique[signature.Text] = struct{}{}
+
+		signatures = append(signatures, &models.Signature{
+			Signature: signature.Text,
+			Selector:  signature.Selector,
+			Topic:     signature.Topic,
+			Kind:      kind,
+		})
+	}
+
+	inserted, err := metadata.signatures.Import(ctx, signatures)
+	if err != nil {
+		return result, err
+	}
+	result.Inserted = inserted
+	result.Existing = result.Total - result.Invalid - inserted
+
+	log.Info().Int("total", result.Total).Int("inserted", result.Inserted).Int("existing", result.Existing).Int("invalid", result.Invalid).Str("kind", string(kind)).Msg("signatures are imported")
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package selectorindex
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Format of index file. All integers are big-endian.
+//
+//	header:
+//	  magic        [4]byte  "ABIS"
+//	  version      uint16   version of records format
+//	  extra_size   uint16   size of extra header fields which follow. Readers skip unknown fields.
+//	  extra        [extra_size]byte
+//	records until the end of file, sorted by selector:
+//	  selector     [4]byte
+//	  count        uvarint  count of signatures
+//	  signatures   count * (uvarint length, [length]byte signature)
+const (
+	Version = 1
+
+	magic        = "ABIS"
+	selectorSize = 4
+)
+
+// errors
+var (
+	ErrInvalidMagic       = errors.New("file is not a selector index")
+	ErrUnsupportedVersion = errors.New("unsupported version of selector index")
+	ErrInvalidSelector    = errors.New("selector should be 4 bytes")
+	ErrUnsorted           = errors.New("selectors should be added in ascending order")
+)
+
+// Writer - writes selector index. Pairs should be added ordered by selector.
+type Writer struct {
+	w *bufio.Writer
+
+	selector   []byte
+	signatures []string
+	buf        []byte
+}
+
+// NewWriter - creates writer and writes header of index
+func NewWriter(w io.Writer) (*Writer, error) {
+	writer := &Writer{
+		w:   bufio.NewWriter(w),
+		buf: make([]byte, binary.MaxVarintLen64),
+	}
+
+	header := make([]byte, 8)
+	copy(header, magic)
+	binary.BigEndian.PutUint16(header[4:], Version)
+	binary.BigEndian.PutUint16(header[6:], 0)
+	if _, err := writer.w.Write(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Add - adds signature of selector
+func (writer *Writer) Add(selector []byte, signature string) error {
+	if len(selector) != selectorSize {
+		return ErrInvalidSelector
+	}
+
+	if writer.selector != nil {
+		switch bytes.Compare(selector, writer.selector) {
+		case 0:
+			writer.signatures = append(writer.signatures, signature)
+			return nil
+		case -1:
+			return errors.Wrapf(ErrUnsorted, "%x after %x", selector, writer.selector)
+		}
+		if err := writer.writeRecord(); err != nil {
+			return err
+		}
+	}
+
+	writer.selector = append(writer.selector[:0], selector...)
+	writer.signatures = append(writer.signatures[:0], signature)
+	return nil
+}
+
+// Flush - writes buffered data. It should be called after the last pair was added.
+func (writer *Writer) Flush() error {
+	if writer.selector != nil {
+		if err := writer.writeRecord(); err != nil {
+			return err
+		}
+		writer.selector = nil
+		writer.signatures = writer.signatures[:0]
+	}
+	return writer.w.Flush()
+}
+
+func (writer *Writer) writeRecord() error {
+	if _, err := writer.w.Write(writer.selector); err != nil {
+		return err
+	}
+	if err := writer.writeUvarint(uint64(len(writer.signatures))); err != nil {
+		return err
+	}
+	for i := range writer.signatures {
+		if err := writer.writeUvarint(uint64(len(writer.signatures[i]))); err != nil {
+			return err
+		}
+		if _, err := writer.w.WriteString(writer.signatures[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (writer *Writer) writeUvarint(value uint64) error {
+	n := binary.PutUvarint(writer.buf, value)
+	_, err := writer.w.Write(writer.buf[:n])
+	return err
+}
+
+// Index - selector index loaded in memory
+type Index struct {
+	Version    uint16
+	signatures map[[selectorSize]byte][]string
+}
+
+// Read - reads selector index from reader
+func Read(r io.Reader) (*Index, error) {
+	reader := bufio.NewReader(r)
+
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, errors.Wrap(err, "reading header")
+	}
+	if string(header[:4]) != magic {
+		return nil, ErrInvalidMagic
+	}
+	index := &Index{
+		Version:    binary.BigEndian.Uint16(header[4:]),
+		signatures: make(map[[selectorSize]byte][]string),
+	}
+	if index.Version > Version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "%d", index.Version)
+	}
+	if extraSize := binary.BigEndian.Uint16(header[6:]); extraSize > 0 {
+		if _, err := reader.Discard(int(extraSize)); err != nil {
+			return nil, errors.Wrap(err, "reading header")
+		}
+	}
+
+	var selector [selectorSize]byte
+	for {
+		if _, err := io.ReadFull(reader, selector[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return index, nil
+			}
+			return nil, errors.Wrap(err, "reading selector")
+		}
+		count, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading signatures of %x", selector)
+		}
+		signatures := make([]string, count)
+		for i := range signatures {
+			length, err := binary.ReadUvarint(reader)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading signatures of %x", selector)
+			}
+			buf := make([]byte, length)
+			if _, err := io.ReadFull(reader, buf); err != nil {
+				return nil, errors.Wrapf(err, "reading signatures of %x", selector)
+			}
+			signatures[i] = string(buf)
+		}
+		index.signatures[selector] = signatures
+	}
+}
+
+// Lookup - returns signatures of selector. Calldata can be passed as well: only first 4 bytes are used. Nil is returned if selector is unknown.
+func (index *Index) Lookup(selector []byte) []string {
+	if len(selector) < selectorSize {
+		return nil
+	}
+	var key [selectorSize]byte
+	copy(key[:], selector)
+	return index.signatures[key]
+}
+
+// Len - returns count of selectors in index
+func (index *Index) Len() int {
+	return len(index.signatures)
+}